@@ -17,7 +17,7 @@ type ApkController struct {
 }
 
 func (c *ApkController) LoadRoutes() {
-	c.Group.GET("/update", handleCheckApkUpdate)
+	c.Group.GET("/update", common.CacheControl("public, max-age=300"), handleCheckApkUpdate)
 	c.Group.GET("/apk/download/:token", handleDownloadApk)
 }
 
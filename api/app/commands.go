@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+const (
+	commandPollDefaultWait = 25 * time.Second
+	commandPollMaxWait     = 55 * time.Second
+	// commandPollRecheck bounds the delay for commands queued by another
+	// API instance, whose broker notifications do not reach this one.
+	commandPollRecheck = 15 * time.Second
+	commandPollBatch   = 20
+)
+
+type CommandController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *CommandController) LoadRoutes() {
+	// Commands reach devices without an active subscription too, so an
+	// expired box can still be updated or reconfigured.
+	c.Group.GET("/commands", common.AppDeviceMiddleware(), common.CacheControl("no-store"), handlePollCommands)
+	c.Group.POST("/commands/:id/ack", common.AppDeviceMiddleware(), handleAckCommand)
+}
+
+// handlePollCommands long-polls the command queue of the device. It answers
+// as soon as commands are pending, or with an empty list once "wait"
+// seconds (25 by default, at most 55) pass without any. Returned commands
+// are marked delivered and must be acknowledged; unacknowledged ones are
+// returned again after models.DeviceCommandAckTimeout.
+func handlePollCommands(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	wait := commandPollDefaultWait
+	if waitParam := c.Query("wait"); waitParam != "" {
+		seconds, err := strconv.Atoi(waitParam)
+		if err != nil || seconds < 0 {
+			common.RespondError(c, http.StatusBadRequest, "wait must be a non-negative integer")
+			return
+		}
+		wait = min(time.Duration(seconds)*time.Second, commandPollMaxWait)
+	}
+
+	// Subscribe before the first claim so a command queued in between still
+	// wakes the poll up.
+	messages, unsubscribe := broker.Subscribe(16, broker.TopicDeviceCommand)
+	defer unsubscribe()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), wait)
+	defer cancel()
+
+	for {
+		commands, err := repository.ClaimDeviceCommands(device.ID, commandPollBatch)
+		if err != nil {
+			common.RespondError(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(commands) > 0 {
+			for _, command := range commands {
+				broker.Publish(broker.TopicDeviceCommand, command)
+			}
+			common.RespondProto(c, http.StatusOK, &pb.DeviceCommandList{Data: common.DeviceCommandsToProto(commands)})
+			return
+		}
+		if !waitForCommand(ctx, device.ID, messages) {
+			break
+		}
+	}
+
+	if c.Request.Context().Err() != nil {
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceCommandList{Data: []*pb.DeviceCommand{}})
+}
+
+// waitForCommand blocks until a command may be pending for the device and
+// reports false once ctx is done.
+func waitForCommand(ctx context.Context, deviceID uint, messages <-chan broker.Message) bool {
+	recheck := time.NewTimer(commandPollRecheck)
+	defer recheck.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-recheck.C:
+			return true
+		case msg, ok := <-messages:
+			if !ok {
+				return false
+			}
+			command, ok := msg.Payload.(models.DeviceCommand)
+			if ok && command.DeviceID == deviceID && command.Status == models.DeviceCommandPending {
+				return true
+			}
+		}
+	}
+}
+
+func handleAckCommand(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid command ID")
+		return
+	}
+	var req pb.DeviceCommandAck
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if len(req.Result) > models.DeviceCommandMaxResult {
+		common.RespondError(c, http.StatusRequestEntityTooLarge, "result is too large")
+		return
+	}
+
+	command, err := repository.CompleteDeviceCommand(device.ID, id, req.Success, req.Result)
+	if errors.Is(err, models.ErrDeviceCommandClosed) {
+		if _, lookupErr := repository.GetDeviceCommand(device.ID, id); lookupErr != nil {
+			common.RespondError(c, http.StatusNotFound, "command not found")
+			return
+		}
+		common.RespondError(c, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	broker.Publish(broker.TopicDeviceCommand, *command)
+	common.RespondProto(c, http.StatusOK, common.DeviceCommandToProto(*command))
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type ConfigController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *ConfigController) LoadRoutes() {
+	c.Group.GET("/config", common.AppDeviceMiddleware(), common.CacheControl("private, no-cache"), handleGetConfig)
+}
+
+// handleGetConfig serves the remote configuration of the device. The bundle
+// version doubles as its ETag, so polling with If-None-Match is cheap.
+func handleGetConfig(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	bundle, err := repository.GetDeviceConfig(device)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondVersionedProto(c, bundle.Version, common.ConfigBundleToProto(*bundle))
+}
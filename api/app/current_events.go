@@ -2,12 +2,12 @@ package app
 
 import (
 	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
-	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
 
@@ -16,22 +16,39 @@ type CurrentEventsController struct {
 }
 
 func (c *CurrentEventsController) LoadRoutes() {
-	c.Group.GET("/current-events", common.AppMiddleware(), handleGetCurrentEvents)
+	c.Group.GET("/current-events", common.CacheControl("private, max-age=5"), common.AppMiddleware(), handleGetCurrentEvents)
 }
 
 func handleGetCurrentEvents(c *gin.Context) {
 	device := c.MustGet("device").(models.Device)
-	limit := 6
+	settings, err := repository.GetFeedSettings(device)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	cursor, err := models.ParseFeedCursor(c.Query("cursor"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := settings.MaxItems
 	if limitParam := c.Query("limit"); limitParam != "" {
-		if parsedLimit, err := strconv.Atoi(limitParam); err == nil && parsedLimit > 0 && parsedLimit <= 6 {
+		if parsedLimit, err := strconv.Atoi(limitParam); err == nil && parsedLimit > 0 && parsedLimit <= settings.MaxItems {
 			limit = parsedLimit
 		}
 	}
 
-	events, err := repository.GetCurrentAndUpcomingEvents(device.ID, limit)
+	// Plans without live updates get neither the stream nor the live section.
+	plan := common.CurrentPlan(c)
+	live := plan == nil || plan.LiveUpdates
+	feed, err := repository.GetEventFeed(device.ID, settings, live, cursor, limit)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
-	common.RespondProto(c, http.StatusOK, &pb.EventsList{Data: common.EventsToProto(events)})
+	resp := common.EventFeedToProto(feed, limit)
+	common.Localize(c, slices.Concat(resp.Data, resp.Live, resp.Upcoming, resp.Recent), nil, nil)
+	common.RespondProto(c, http.StatusOK, resp)
 }
@@ -1,12 +1,17 @@
 package app
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/deviceauth"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/locale"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
@@ -19,9 +24,17 @@ type DeviceRegistrationController struct {
 func (c *DeviceRegistrationController) LoadRoutes() {
 	c.Group.POST("/devices", handleRegisterDevice)
 	c.Group.POST("/devices/viewing", common.AppMiddleware(), handleReportViewing)
+	c.Group.POST("/devices/heartbeat", common.AppDeviceMiddleware(), handleHeartbeat)
 	c.Group.GET("/devices/url/:packageName", common.AppMiddleware(), handleGetDomain)
+	c.Group.POST("/devices/rotate-secret", common.AppDeviceMiddleware(), handleRotateDeviceSecret)
+	c.Group.POST("/devices/enroll", common.AppDeviceMiddleware(), handleEnrollDevice)
+	c.Group.GET("/devices/subscription", common.AppDeviceMiddleware(), common.CacheControl("private, no-cache"), handleGetOwnSubscription)
 }
 
+// secretRotationGrace is how long the replaced secret keeps signing requests
+// after a rotation.
+const secretRotationGrace = 10 * time.Minute
+
 func handleRegisterDevice(c *gin.Context) {
 	var req pb.DeviceRegisterRequest
 	if err := common.ParseProtoBody(c, &req); err != nil || req.Token == "" {
@@ -29,50 +42,181 @@ func handleRegisterDevice(c *gin.Context) {
 		return
 	}
 
-	device, err := repository.RegisterDevice(nil, req.Token, req.Platform, req.Name, req.Version)
+	// A device that already holds a secret may only re-register with a
+	// request signed by it, otherwise the token alone would be enough to
+	// take over its registration.
+	existing, _ := repository.GetDeviceByToken(req.Token)
+	if existing != nil {
+		if existing.Revoked() {
+			common.RespondError(c, http.StatusForbidden, "device credentials have been revoked")
+			return
+		}
+		if existing.Blocked() {
+			common.RespondError(c, http.StatusForbidden, "device is blocked")
+			return
+		}
+		if existing.Secret != "" {
+			if err := common.VerifyDeviceRequest(c, existing); errors.Is(err, deviceauth.ErrMissingSignature) {
+				common.RespondError(c, http.StatusConflict, "device is already registered")
+				return
+			} else if err != nil {
+				common.RespondError(c, http.StatusUnauthorized, err.Error())
+				return
+			}
+		}
+	}
+
+	// A blocked box must not come back by registering a new token.
+	fingerprint := normalizeFingerprint(req.Fingerprint)
+	blocked, err := repository.FingerprintBlocked(fingerprint)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if blocked {
+		common.RespondError(c, http.StatusForbidden, "device is blocked")
+		return
+	}
+
+	// A reinstated device lost its secret, so it proves it is still wanted
+	// with an enrollment code before it is issued a new one.
+	if existing != nil && existing.PairingRequired {
+		if req.EnrollmentCode == "" {
+			common.RespondError(c, http.StatusForbidden, "device must be paired again with an enrollment code")
+			return
+		}
+		if !redeemEnrollmentCode(c, req.EnrollmentCode, existing) {
+			return
+		}
+	}
+
+	device, err := repository.RegisterDevice(nil, req.Token, req.Platform, req.Name, req.Version, strings.ToUpper(req.Country), req.PackageName, locale.Normalize(req.Locale), fingerprint)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
-	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*device))
+
+	// The secret is only ever sent in the response that issues it.
+	var secret string
+	if device.Secret == "" {
+		secret, err = repository.IssueDeviceSecret(device.ID)
+		if err != nil {
+			common.RespondError(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	_, started, err := repository.RecordHeartbeat(device, models.DeviceHeartbeat{Version: req.Version, PackageName: req.PackageName})
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if started {
+		broker.Publish(broker.TopicDeviceStatus, models.DeviceStatusChange{DeviceID: device.ID, Name: device.Name, Online: true, LastSeen: device.LastSeen})
+	}
+
+	resp := common.DeviceToProto(*device)
+	resp.Secret = secret
+	common.RespondProto(c, http.StatusOK, resp)
 }
 
-func handleReportViewing(c *gin.Context) {
-	var req pb.LogPlaybackRequest
-	if err := common.ParseProtoBody(c, &req); err != nil || req.DeviceToken == "" || req.Content == "" {
-		common.RespondError(c, http.StatusBadRequest, "device_token and content are required")
+// normalizeFingerprint trims the hardware fingerprint reported by the app
+// to what fits in the column.
+func normalizeFingerprint(fingerprint string) string {
+	return truncate(strings.TrimSpace(fingerprint), 128)
+}
+
+func handleRotateDeviceSecret(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	if device.Secret != "" && !common.DeviceSigned(c) {
+		common.RespondError(c, http.StatusUnauthorized, deviceauth.ErrMissingSignature.Error())
 		return
 	}
 
-	db, err := database.GetDB()
+	secret, err := repository.RotateDeviceSecret(&device, secretRotationGrace)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	var device models.Device
-	if err := db.Where("token = ?", req.DeviceToken).First(&device).Error; err != nil {
-		common.RespondError(c, http.StatusBadRequest, "device not found")
+	resp := common.DeviceToProto(device)
+	resp.Secret = secret
+	common.RespondProto(c, http.StatusOK, resp)
+}
+
+// handleReportViewing starts an untracked playback for apps that predate
+// the /playback endpoints and identify the device by the token in the body.
+// It still answers 201 with the stored playback, as those apps expect.
+func handleReportViewing(c *gin.Context) {
+	var req pb.LogPlaybackRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.DeviceToken == "" || req.Content == "" {
+		common.RespondError(c, http.StatusBadRequest, "device_token and content are required")
 		return
 	}
 
-	startedAt := req.StartedAt
-	if startedAt == 0 {
-		startedAt = time.Now().Unix()
+	// The token almost always names the signed-in device.
+	device := c.MustGet("device").(models.Device)
+	if req.DeviceToken != device.Token {
+		db, err := database.GetDB()
+		if err != nil {
+			common.RespondError(c, http.StatusInternalServerError, err.Error())
+			return
+		}
+		device = models.Device{}
+		if err := db.Where("token = ?", req.DeviceToken).First(&device).Error; err != nil {
+			common.RespondError(c, http.StatusBadRequest, "device not found")
+			return
+		}
 	}
 
-	playbackLog, err := repository.LogPlayback(device.ID, req.Content, startedAt)
+	playbackLog, err := beginPlayback(&device, req.Content, req.StartedAt, false)
+	if respondIngestError(c, err) {
+		return
+	}
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err := repository.UpdateDeviceLastSeen(req.DeviceToken); err != nil {
+	common.RespondProto(c, http.StatusCreated, common.PlaybackToProto(playbackLog))
+}
+
+// handleHeartbeat keeps the session of the device open. It is accepted
+// without an active subscription so the dashboard still sees expired boxes.
+func handleHeartbeat(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.HeartbeatRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	session, started, err := repository.RecordHeartbeat(&device, models.DeviceHeartbeat{
+		Version:     req.Version,
+		PackageName: req.PackageName,
+		NetworkType: truncate(req.NetworkType, 32),
+		Content:     truncate(req.Content, 255),
+	})
+	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if started {
+		broker.Publish(broker.TopicDeviceStatus, models.DeviceStatusChange{DeviceID: device.ID, Name: device.Name, Online: true, LastSeen: device.LastSeen})
+	}
 
-	common.RespondProto(c, http.StatusCreated, common.PlaybackToProto(playbackLog))
+	common.RespondProto(c, http.StatusOK, &pb.HeartbeatResponse{
+		SessionId:       uint32(session.ID),
+		ServerTime:      device.LastSeen,
+		IntervalSeconds: int32(models.DeviceHeartbeatInterval / time.Second),
+	})
+}
+
+func truncate(value string, size int) string {
+	if len(value) > size {
+		return value[:size]
+	}
+	return value
 }
 
 func handleGetDomain(c *gin.Context) {
@@ -90,3 +234,51 @@ func handleGetDomain(c *gin.Context) {
 
 	common.RespondProto(c, http.StatusOK, &pb.DeviceUrl{Url: apk.IPTVUrl})
 }
+
+func handleEnrollDevice(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.EnrollDeviceRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.Code == "" {
+		common.RespondError(c, http.StatusBadRequest, "code is required")
+		return
+	}
+
+	if !redeemEnrollmentCode(c, req.Code, &device) {
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceToProto(device))
+}
+
+// redeemEnrollmentCode pairs device with the manager of code and responds
+// when the code cannot be redeemed.
+func redeemEnrollmentCode(c *gin.Context, code string, device *models.Device) bool {
+	_, err := repository.RedeemEnrollmentCode(strings.ToUpper(strings.TrimSpace(code)), device)
+	if errors.Is(err, models.ErrEnrollmentCodeInvalid) {
+		common.RespondError(c, http.StatusBadRequest, err.Error())
+		return false
+	}
+	if errors.Is(err, models.ErrDeviceQuotaExceeded) || errors.Is(err, models.ErrDevicePairedElsewhere) {
+		common.RespondError(c, http.StatusConflict, err.Error())
+		return false
+	}
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return false
+	}
+	return true
+}
+
+func handleGetOwnSubscription(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	subscription, err := repository.GetDeviceSubscription(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if subscription == nil {
+		common.RespondError(c, http.StatusNotFound, "device has no subscription")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.DeviceSubscriptionToProto(*subscription))
+}
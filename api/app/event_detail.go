@@ -0,0 +1,39 @@
+package app
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type EventDetailController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *EventDetailController) LoadRoutes() {
+	c.Group.GET("/events/:id", common.CacheControl("private, max-age=15"), common.AppMiddleware(), handleGetEventDetail)
+}
+
+// handleGetEventDetail looks the event up by its SofaScore ID, the
+// sofa_score_event_id the app receives in the event lists.
+func handleGetEventDetail(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		common.RespondError(c, http.StatusBadRequest, "invalid event ID")
+		return
+	}
+
+	detail, err := repository.GetEventDetail(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "event not found")
+		return
+	}
+	resp := common.EventDetailToProto(detail)
+	common.Localize(c, slices.Concat([]*pb.SofaScoreEvent{resp.Event}, resp.HomeLastEvents, resp.AwayLastEvents, resp.HeadToHead), nil, nil)
+	common.RespondProto(c, http.StatusOK, resp)
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type FavoriteController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *FavoriteController) LoadRoutes() {
+	c.Group.GET("/favorites", common.CacheControl("private, no-cache"), common.AppMiddleware(), handleGetFavorites)
+	c.Group.POST("/favorites", common.AppMiddleware(), handleAddFavorite)
+	c.Group.PUT("/favorites", common.AppMiddleware(), handleSetFavorites)
+	c.Group.DELETE("/favorites/:teamId", common.AppMiddleware(), handleRemoveFavorite)
+}
+
+func handleGetFavorites(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	deviceTeams, err := repository.GetDeviceTeams(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceTeamList{DeviceTeams: common.DeviceTeamsToProto(deviceTeams)})
+}
+
+func handleAddFavorite(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.AssignTeamRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	exists, err := repository.TeamExists(req.TeamId)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !exists {
+		common.RespondError(c, http.StatusNotFound, "team not found")
+		return
+	}
+
+	deviceTeam, err := repository.AddTeamToDevice(device.ID, req.TeamId)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.DeviceTeamToProto(*deviceTeam))
+}
+
+func handleSetFavorites(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.SetTeamIdsRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if err := repository.SetDeviceTeams(device.ID, req.TeamIds); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "favorites updated"})
+}
+
+func handleRemoveFavorite(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	teamID, err := strconv.ParseInt(c.Param("teamId"), 10, 64)
+	if err != nil || teamID <= 0 {
+		common.RespondError(c, http.StatusBadRequest, "invalid team ID")
+		return
+	}
+
+	if err := repository.RemoveTeamFromDevice(device.ID, teamID); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "team removed from favorites"})
+}
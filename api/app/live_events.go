@@ -0,0 +1,117 @@
+package app
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const liveEventsKeepAlive = 25 * time.Second
+
+type LiveEventsController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *LiveEventsController) LoadRoutes() {
+	c.Group.GET("/live-events", common.AppMiddleware(), handleLiveEvents)
+}
+
+// handleLiveEvents streams score and status deltas of the events in the
+// device's tournaments as Server-Sent Events. Each "delta" event carries a
+// pb.EventDelta, base64-encoded protobuf by default or JSON with format=json.
+func handleLiveEvents(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	format := c.DefaultQuery("format", "proto")
+	if format != "proto" && format != "json" {
+		common.RespondError(c, http.StatusBadRequest, "format must be proto or json")
+		return
+	}
+
+	if plan := common.CurrentPlan(c); plan != nil && !plan.LiveUpdates {
+		common.RespondSubscriptionError(c, models.SubscriptionErrorUnavailable, "live updates are not included in the plan", 0)
+		return
+	}
+
+	tournaments, err := deviceTournamentSet(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	messages, unsubscribe := broker.Subscribe(64, broker.TopicEventChanged)
+	defer unsubscribe()
+
+	keepAlive := time.NewTicker(liveEventsKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case now := <-keepAlive.C:
+			// Pick up tournament assignment changes made while streaming.
+			if refreshed, err := deviceTournamentSet(device.ID); err == nil {
+				tournaments = refreshed
+			}
+			c.SSEvent("ping", strconv.FormatInt(now.Unix(), 10))
+			return true
+		case msg, ok := <-messages:
+			if !ok {
+				return false
+			}
+			change, ok := msg.Payload.(models.EventChange)
+			if !ok {
+				return true
+			}
+			if _, follows := tournaments[change.Event.LeagueId]; !follows {
+				return true
+			}
+			data, err := encodeDelta(common.EventChangeToDelta(change), format)
+			if err != nil {
+				return true
+			}
+			c.SSEvent("delta", data)
+			return true
+		}
+	})
+}
+
+func deviceTournamentSet(deviceID uint) (map[uint]struct{}, error) {
+	ids, err := repository.GetDeviceTournamentIDs(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set, nil
+}
+
+func encodeDelta(delta proto.Message, format string) (string, error) {
+	if format == "json" {
+		data, err := protojson.Marshal(delta)
+		return string(data), err
+	}
+	data, err := proto.Marshal(delta)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
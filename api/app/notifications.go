@@ -0,0 +1,77 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type NotificationController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *NotificationController) LoadRoutes() {
+	c.Group.GET("/notifications/subscriptions", common.CacheControl("private, no-cache"), common.AppMiddleware(), handleGetNotificationSubscriptions)
+	c.Group.POST("/notifications/subscriptions", common.AppMiddleware(), handleCreateNotificationSubscription)
+	c.Group.DELETE("/notifications/subscriptions/:type/:id", common.AppMiddleware(), handleDeleteNotificationSubscription)
+}
+
+func handleGetNotificationSubscriptions(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	subscriptions, err := repository.GetNotificationSubscriptions(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.NotificationSubscriptionList{Subscriptions: common.NotificationSubscriptionsToProto(subscriptions)})
+}
+
+func handleCreateNotificationSubscription(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.NotificationSubscriptionRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if !isNotificationTarget(req.TargetType) || req.TargetId <= 0 {
+		common.RespondError(c, http.StatusBadRequest, "target_type must be team or event and target_id is required")
+		return
+	}
+
+	subscription, err := repository.CreateNotificationSubscription(device.ID, req.TargetType, req.TargetId)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.NotificationSubscriptionToProto(*subscription))
+}
+
+func handleDeleteNotificationSubscription(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	targetType := c.Param("type")
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || !isNotificationTarget(targetType) {
+		common.RespondError(c, http.StatusBadRequest, "invalid subscription")
+		return
+	}
+
+	deleted, err := repository.DeleteNotificationSubscription(device.ID, targetType, targetID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !deleted {
+		common.RespondError(c, http.StatusNotFound, "subscription not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "subscription deleted"})
+}
+
+func isNotificationTarget(targetType string) bool {
+	return targetType == models.NotificationTargetTeam || targetType == models.NotificationTargetEvent
+}
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/ingest"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type PlaybackController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *PlaybackController) LoadRoutes() {
+	c.Group.POST("/playback/start", common.AppMiddleware(), handleStartPlayback)
+	c.Group.POST("/playback/:id/heartbeat", common.AppMiddleware(), handlePlaybackHeartbeat)
+	c.Group.POST("/playback/:id/stop", common.AppMiddleware(), handleStopPlayback)
+}
+
+// playbackTime turns a timestamp reported by the app into Unix seconds.
+// Zero or future values mean now, and older app versions send milliseconds.
+func playbackTime(ts int64) int64 {
+	now := time.Now().Unix()
+	if ts > 1e12 {
+		ts /= 1000
+	}
+	if ts <= 0 || ts > now {
+		return now
+	}
+	return ts
+}
+
+// beginPlayback opens a playback for the device, which also counts as a
+// device heartbeat. Tracked playbacks are kept open by their own heartbeats.
+// Devices already online are only marked as seen, which is batched; the
+// others get their session opened right away.
+func beginPlayback(device *models.Device, content string, startedAt int64, tracked bool) (*models.PlaybackLog, error) {
+	playback, err := repository.StartPlayback(device.ID, content, playbackTime(startedAt), tracked)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if device.LastSeen >= now.Add(-models.DeviceOfflineAfter).Unix() {
+		if err := repository.QueueDeviceSeen(device.ID, now.Unix()); err != nil && !errors.Is(err, ingest.ErrFull) {
+			return nil, err
+		}
+		return playback, nil
+	}
+	_, started, err := repository.RecordHeartbeat(device, models.DeviceHeartbeat{Content: truncate(content, 255)})
+	if err != nil {
+		return nil, err
+	}
+	if started {
+		broker.Publish(broker.TopicDeviceStatus, models.DeviceStatusChange{DeviceID: device.ID, Name: device.Name, Online: true, LastSeen: device.LastSeen})
+	}
+	return playback, nil
+}
+
+// respondIngestError answers 503 when the write buffers are full or
+// shutting down, asking the app to retry shortly.
+func respondIngestError(c *gin.Context, err error) bool {
+	if !errors.Is(err, ingest.ErrFull) && !errors.Is(err, ingest.ErrClosed) {
+		return false
+	}
+	c.Header("Retry-After", "1")
+	common.RespondError(c, http.StatusServiceUnavailable, err.Error())
+	return true
+}
+
+func playbackSession(playback *models.PlaybackLog) *pb.PlaybackSession {
+	return &pb.PlaybackSession{
+		Playback:                 common.PlaybackToProto(playback),
+		HeartbeatIntervalSeconds: int32(models.PlaybackHeartbeatInterval / time.Second),
+	}
+}
+
+// handleStartPlayback opens a playback and ends the previous one of the
+// device. The app then sends a heartbeat every heartbeat_interval_seconds
+// until it stops the playback; silent playbacks are closed by the server.
+func handleStartPlayback(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	var req pb.LogPlaybackRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.Content == "" {
+		common.RespondError(c, http.StatusBadRequest, "content is required")
+		return
+	}
+
+	playback, err := beginPlayback(&device, req.Content, req.StartedAt, true)
+	if respondIngestError(c, err) {
+		return
+	}
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, playbackSession(playback))
+}
+
+// respondPlaybackError answers 409 for playbacks that already ended and 404
+// when the device has no such playback.
+func respondPlaybackError(c *gin.Context, err error) {
+	if errors.Is(err, models.ErrPlaybackClosed) {
+		common.RespondError(c, http.StatusConflict, err.Error())
+		return
+	}
+	common.RespondError(c, http.StatusNotFound, "playback not found")
+}
+
+func handlePlaybackHeartbeat(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid playback ID")
+		return
+	}
+
+	playback, err := repository.HeartbeatPlayback(device.ID, id)
+	if respondIngestError(c, err) {
+		return
+	}
+	if err != nil {
+		respondPlaybackError(c, err)
+		return
+	}
+	common.RespondProto(c, http.StatusOK, playbackSession(playback))
+}
+
+func handleStopPlayback(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid playback ID")
+		return
+	}
+	var req pb.UpdatePlaybackRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	playback, err := repository.StopPlayback(device.ID, id, playbackTime(req.EndedAt))
+	if err != nil {
+		respondPlaybackError(c, err)
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.PlaybackToProto(playback))
+}
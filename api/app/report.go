@@ -2,6 +2,7 @@ package app
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
@@ -21,10 +22,11 @@ func crashReport(c *gin.Context) {
 		return
 	}
 
-	if err := repository.SaveCrashReport(report); err != nil {
+	if err := repository.SaveCrashReport(&report); err != nil {
 		c.JSON(500, gin.H{"error": "Failed to save crash report"})
 		return
 	}
+	broker.Publish(broker.TopicCrashReported, report)
 
 	c.JSON(200, gin.H{"message": "Crash report saved successfully"})
 }
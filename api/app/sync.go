@@ -0,0 +1,47 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type SyncController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *SyncController) LoadRoutes() {
+	c.Group.GET("/sync", common.CacheControl("private, no-cache"), common.AppMiddleware(), handleSync)
+}
+
+// handleSync returns what changed since the cursor so clients can keep a
+// local cache instead of downloading full events on every refresh. An empty
+// cursor starts a full sync.
+func handleSync(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	cursor, err := models.ParseSyncCursor(c.Query("cursor"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := 200
+	if limitParam := c.Query("limit"); limitParam != "" {
+		if parsedLimit, err := strconv.Atoi(limitParam); err == nil && parsedLimit > 0 && parsedLimit <= 500 {
+			limit = parsedLimit
+		}
+	}
+
+	changes, err := repository.GetSyncChanges(device.ID, cursor, limit)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	resp := common.SyncChangesToProto(changes)
+	common.Localize(c, resp.Events, resp.Teams, resp.Tournaments)
+	common.RespondProto(c, http.StatusOK, resp)
+}
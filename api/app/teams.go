@@ -17,7 +17,7 @@ type TeamController struct {
 }
 
 func (c *TeamController) LoadRoutes() {
-	c.Group.GET("/teams/logo/:teamId", handleGetTeamLogo)
+	c.Group.GET("/teams/logo/:teamId", common.CacheControl("public, max-age=31536000, immutable"), handleGetTeamLogo)
 }
 
 func handleGetTeamLogo(c *gin.Context) {
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type TrendingEventsController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *TrendingEventsController) LoadRoutes() {
+	c.Group.GET("/trending-events", common.CacheControl("private, max-age=60"), common.AppMiddleware(), handleGetTrendingEvents)
+}
+
+func handleGetTrendingEvents(c *gin.Context) {
+	device := c.MustGet("device").(models.Device)
+	country := strings.ToUpper(c.Query("country"))
+	if country == "" {
+		country = device.Country
+	}
+	if country == "" {
+		common.RespondError(c, http.StatusBadRequest, "country is required")
+		return
+	}
+
+	limit := 10
+	if limitParam := c.Query("limit"); limitParam != "" {
+		if parsedLimit, err := strconv.Atoi(limitParam); err == nil && parsedLimit > 0 && parsedLimit <= 50 {
+			limit = parsedLimit
+		}
+	}
+
+	trending, err := repository.GetTrendingEvents(country, limit)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := &pb.TrendingEventsList{CountryCode: country}
+	events := make([]models.SofaScoreEvent, 0, len(trending))
+	for _, t := range trending {
+		if t.Event == nil || t.Event.HomeTeamModel == nil || t.Event.AwayTeamModel == nil {
+			continue
+		}
+		resp.FetchedAt = t.FetchedAt
+		events = append(events, *t.Event)
+	}
+	resp.Data = common.EventsToProto(events)
+	common.Localize(c, resp.Data, nil, nil)
+
+	common.RespondProto(c, http.StatusOK, resp)
+}
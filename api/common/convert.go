@@ -1,7 +1,9 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
@@ -17,16 +19,30 @@ func FormatTime(t time.Time) string {
 }
 
 func DeviceToProto(d models.Device) *pb.Device {
-	return &pb.Device{
-		Id:        uint32(d.ID),
-		CreatedAt: FormatTime(d.CreatedAt),
-		UpdatedAt: FormatTime(d.UpdatedAt),
-		Token:     d.Token,
-		Platform:  d.Platform,
-		Name:      d.Name,
-		Version:   d.Version,
-		LastSeen:  d.LastSeen,
+	result := &pb.Device{
+		Id:          uint32(d.ID),
+		CreatedAt:   FormatTime(d.CreatedAt),
+		UpdatedAt:   FormatTime(d.UpdatedAt),
+		Token:       d.Token,
+		Platform:    d.Platform,
+		Name:        d.Name,
+		Version:     d.Version,
+		LastSeen:    d.LastSeen,
+		Country:     d.Country,
+		PackageName: d.PackageName,
+		Locale:      d.Locale,
+		RevokedAt:   d.RevokedAt,
+		Fingerprint: d.Fingerprint,
+		BlockedAt:   d.BlockedAt,
+		BlockReason: d.BlockReason,
 	}
+	if d.UserID != nil {
+		result.ManagerId = uint32(*d.UserID)
+	}
+	if d.Manager != nil {
+		result.ManagerEmail = d.Manager.Email
+	}
+	return result
 }
 
 func DevicesToProto(devices []models.Device) []*pb.Device {
@@ -37,6 +53,264 @@ func DevicesToProto(devices []models.Device) []*pb.Device {
 	return result
 }
 
+func DeviceSessionToProto(s models.DeviceSession) *pb.DeviceSession {
+	result := &pb.DeviceSession{
+		Id:              uint32(s.ID),
+		DeviceId:        uint32(s.DeviceID),
+		StartedAt:       s.StartedAt,
+		LastHeartbeatAt: s.LastHeartbeatAt,
+		EndedAt:         s.EndedAt,
+		Version:         s.Version,
+		PackageName:     s.PackageName,
+		NetworkType:     s.NetworkType,
+		Content:         s.Content,
+	}
+	if s.Device != nil {
+		result.DeviceName = s.Device.Name
+	}
+	return result
+}
+
+func DeviceSessionsToProto(sessions []models.DeviceSession) []*pb.DeviceSession {
+	result := make([]*pb.DeviceSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, DeviceSessionToProto(s))
+	}
+	return result
+}
+
+func DeviceUptimeToProto(u models.DeviceUptime) *pb.DeviceUptime {
+	result := &pb.DeviceUptime{
+		DeviceId:      uint32(u.DeviceID),
+		From:          u.From,
+		To:            u.To,
+		UptimeSeconds: u.Seconds,
+		Sessions:      DeviceSessionsToProto(u.Sessions),
+	}
+	if window := u.To - u.From; window > 0 {
+		result.UptimeRatio = float64(u.Seconds) / float64(window)
+	}
+	return result
+}
+
+func DeviceAuditLogsToProto(logs []models.DeviceAuditLog) []*pb.DeviceAuditEntry {
+	result := make([]*pb.DeviceAuditEntry, 0, len(logs))
+	for _, l := range logs {
+		entry := &pb.DeviceAuditEntry{
+			Id:        uint32(l.ID),
+			CreatedAt: FormatTime(l.CreatedAt),
+			DeviceId:  uint32(l.DeviceID),
+			Action:    l.Action,
+			Reason:    l.Reason,
+		}
+		if l.UserID != nil {
+			entry.UserId = uint32(*l.UserID)
+		}
+		if l.User != nil {
+			entry.UserEmail = l.User.Email
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func DuplicateDevicesToProto(groups []models.DuplicateDevices) []*pb.DuplicateDeviceGroup {
+	result := make([]*pb.DuplicateDeviceGroup, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, &pb.DuplicateDeviceGroup{
+			Fingerprint: g.Fingerprint,
+			Devices:     DevicesToProto(g.Devices),
+		})
+	}
+	return result
+}
+
+func UserToProto(u models.User, deviceCount int64) *pb.User {
+	return &pb.User{
+		Id:          uint32(u.ID),
+		CreatedAt:   FormatTime(u.CreatedAt),
+		Email:       u.Email,
+		Role:        u.Role,
+		DeviceQuota: int32(u.DeviceQuota),
+		DeviceCount: deviceCount,
+	}
+}
+
+// EnrollmentCodeToProto converts a code; serverURL is embedded in the QR
+// payload so the app knows where to redeem it.
+func EnrollmentCodeToProto(e models.EnrollmentCode, serverURL string) *pb.EnrollmentCode {
+	result := &pb.EnrollmentCode{
+		Id:         uint32(e.ID),
+		CreatedAt:  FormatTime(e.CreatedAt),
+		Code:       e.Code,
+		ExpiresAt:  e.ExpiresAt,
+		RedeemedAt: e.RedeemedAt,
+	}
+	if e.DeviceID != nil {
+		result.DeviceId = uint32(*e.DeviceID)
+	}
+	if e.Device != nil {
+		result.DeviceName = e.Device.Name
+	}
+	if e.RedeemedAt == 0 {
+		payload, _ := json.Marshal(map[string]any{
+			"server":     serverURL,
+			"code":       e.Code,
+			"expires_at": e.ExpiresAt,
+		})
+		result.QrPayload = string(payload)
+	}
+	return result
+}
+
+func EnrollmentCodesToProto(codes []models.EnrollmentCode, serverURL string) []*pb.EnrollmentCode {
+	result := make([]*pb.EnrollmentCode, 0, len(codes))
+	for _, e := range codes {
+		result = append(result, EnrollmentCodeToProto(e, serverURL))
+	}
+	return result
+}
+
+func PlanToProto(p models.Plan) *pb.Plan {
+	return &pb.Plan{
+		Id:             uint32(p.ID),
+		CreatedAt:      FormatTime(p.CreatedAt),
+		Name:           p.Name,
+		DurationDays:   int32(p.DurationDays),
+		MaxTournaments: int32(p.MaxTournaments),
+		LiveUpdates:    p.LiveUpdates,
+	}
+}
+
+func PlansToProto(plans []models.Plan) []*pb.Plan {
+	result := make([]*pb.Plan, 0, len(plans))
+	for _, p := range plans {
+		result = append(result, PlanToProto(p))
+	}
+	return result
+}
+
+func DeviceSubscriptionToProto(s models.DeviceSubscription) *pb.DeviceSubscription {
+	result := &pb.DeviceSubscription{
+		Id:              uint32(s.ID),
+		DeviceId:        uint32(s.DeviceID),
+		StartsAt:        s.StartsAt,
+		ExpiresAt:       s.ExpiresAt,
+		Active:          s.Active(time.Now()),
+		ExpiryFlaggedAt: s.ExpiryFlaggedAt,
+	}
+	if s.Device != nil {
+		result.DeviceName = s.Device.Name
+	}
+	if s.Plan != nil {
+		result.Plan = PlanToProto(*s.Plan)
+	}
+	return result
+}
+
+func DeviceSubscriptionsToProto(subscriptions []models.DeviceSubscription) []*pb.DeviceSubscription {
+	result := make([]*pb.DeviceSubscription, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		result = append(result, DeviceSubscriptionToProto(s))
+	}
+	return result
+}
+
+func DeviceCommandToProto(dc models.DeviceCommand) *pb.DeviceCommand {
+	p := &pb.DeviceCommand{
+		Id:          uint32(dc.ID),
+		CreatedAt:   FormatTime(dc.CreatedAt),
+		DeviceId:    uint32(dc.DeviceID),
+		Type:        dc.Type,
+		Payload:     dc.Payload,
+		Status:      dc.Status,
+		ExpiresAt:   dc.ExpiresAt,
+		DeliveredAt: dc.DeliveredAt,
+		CompletedAt: dc.CompletedAt,
+		Result:      dc.Result,
+	}
+	if dc.User != nil {
+		p.CreatedBy = dc.User.Email
+	}
+	return p
+}
+
+func DeviceCommandsToProto(commands []models.DeviceCommand) []*pb.DeviceCommand {
+	result := make([]*pb.DeviceCommand, 0, len(commands))
+	for _, dc := range commands {
+		result = append(result, DeviceCommandToProto(dc))
+	}
+	return result
+}
+
+func DeviceGroupToProto(g models.DeviceGroup, deviceCount int64) *pb.DeviceGroup {
+	p := &pb.DeviceGroup{
+		Id:           uint32(g.ID),
+		CreatedAt:    FormatTime(g.CreatedAt),
+		Name:         g.Name,
+		Description:  g.Description,
+		Priority:     int32(g.Priority),
+		DeviceCount:  deviceCount,
+		RulePackage:  g.RulePackage,
+		RuleVersion:  g.RuleVersion,
+		RulePlatform: g.RulePlatform,
+	}
+	if g.RuleManagerID != nil {
+		p.RuleManagerId = uint32(*g.RuleManagerID)
+	}
+	return p
+}
+
+func ConfigKeyToProto(k models.ConfigKey) *pb.ConfigKey {
+	return &pb.ConfigKey{
+		Id:           uint32(k.ID),
+		CreatedAt:    FormatTime(k.CreatedAt),
+		UpdatedAt:    FormatTime(k.UpdatedAt),
+		Key:          k.Key,
+		Type:         k.Type,
+		DefaultValue: k.DefaultValue,
+		Description:  k.Description,
+	}
+}
+
+func ConfigKeysToProto(keys []models.ConfigKey) []*pb.ConfigKey {
+	result := make([]*pb.ConfigKey, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, ConfigKeyToProto(k))
+	}
+	return result
+}
+
+func ConfigValueToProto(v models.ConfigValue) *pb.ConfigValue {
+	result := &pb.ConfigValue{
+		Id:        uint32(v.ID),
+		UpdatedAt: FormatTime(v.UpdatedAt),
+		Scope:     v.Scope,
+		ScopeId:   v.ScopeID,
+		Value:     v.Value,
+	}
+	if v.ConfigKey != nil {
+		result.Key = v.ConfigKey.Key
+	}
+	return result
+}
+
+func ConfigValuesToProto(values []models.ConfigValue) []*pb.ConfigValue {
+	result := make([]*pb.ConfigValue, 0, len(values))
+	for _, v := range values {
+		result = append(result, ConfigValueToProto(v))
+	}
+	return result
+}
+
+func ConfigBundleToProto(b models.ConfigBundle) *pb.ConfigBundle {
+	entries := make([]*pb.ConfigEntry, 0, len(b.Entries))
+	for _, e := range b.Entries {
+		entries = append(entries, &pb.ConfigEntry{Key: e.Key, Type: e.Type, Value: e.Value, Source: e.Source})
+	}
+	return &pb.ConfigBundle{Version: b.Version, Entries: entries}
+}
+
 func TournamentToProto(t models.Tournament) *pb.Tournament {
 	return &pb.Tournament{
 		Id:        uint32(t.ID),
@@ -96,14 +370,27 @@ func EventToProto(e models.SofaScoreEvent) *pb.SofaScoreEvent {
 		TeamHome:                    TeamPtrToProto(e.HomeTeamModel),
 		TeamAway:                    TeamPtrToProto(e.AwayTeamModel),
 		League:                      TournamentPtrToProto(e.League),
+		StatusCode:                  int32(e.StatusCode),
+		StatusType:                  e.StatusType,
+		StatusDescription:           e.StatusDescription,
+		LeagueId:                    uint32(e.LeagueId),
 	}
 }
 
 func EventsToProto(events []models.SofaScoreEvent) []*pb.SofaScoreEvent {
 	result := make([]*pb.SofaScoreEvent, 0, len(events))
 	for _, e := range events {
-		e.AwayTeamModel.LogoUrl = "/api/app/v1" + e.AwayTeamModel.LogoUrl
-		e.HomeTeamModel.LogoUrl = "/api/app/v1" + e.HomeTeamModel.LogoUrl
+		// Copy the teams so the prefix does not leak into shared (cached) models.
+		if e.AwayTeamModel != nil {
+			away := *e.AwayTeamModel
+			away.LogoUrl = "/api/app/v1" + away.LogoUrl
+			e.AwayTeamModel = &away
+		}
+		if e.HomeTeamModel != nil {
+			home := *e.HomeTeamModel
+			home.LogoUrl = "/api/app/v1" + home.LogoUrl
+			e.HomeTeamModel = &home
+		}
 		result = append(result, EventToProto(e))
 	}
 	return result
@@ -190,6 +477,29 @@ func DeviceTournamentsToProto(dts []models.DeviceTournament) []*pb.DeviceTournam
 	return result
 }
 
+func DeviceTeamToProto(dt models.DeviceTeam) *pb.DeviceTeam {
+	p := &pb.DeviceTeam{
+		Id:        uint32(dt.ID),
+		CreatedAt: FormatTime(dt.CreatedAt),
+		UpdatedAt: FormatTime(dt.UpdatedAt),
+		DeviceId:  uint32(dt.DeviceID),
+		TeamId:    dt.TeamID,
+		Team:      TeamPtrToProto(dt.Team),
+	}
+	if dt.Device != nil {
+		p.Device = DeviceToProto(*dt.Device)
+	}
+	return p
+}
+
+func DeviceTeamsToProto(dts []models.DeviceTeam) []*pb.DeviceTeam {
+	result := make([]*pb.DeviceTeam, 0, len(dts))
+	for _, dt := range dts {
+		result = append(result, DeviceTeamToProto(dt))
+	}
+	return result
+}
+
 func EventStatsToProto(stats []repository.EventStats) []*pb.EventStats {
 	result := make([]*pb.EventStats, 0, len(stats))
 	for _, s := range stats {
@@ -228,3 +538,327 @@ func ApksToProto(versions []models.ApkVersion) []*pb.ApkInfo {
 	}
 	return result
 }
+
+func SportToProto(s models.Sport) *pb.Sport {
+	return &pb.Sport{
+		Id:        uint32(s.ID),
+		CreatedAt: FormatTime(s.CreatedAt),
+		UpdatedAt: FormatTime(s.UpdatedAt),
+		Slug:      s.Slug,
+		Name:      s.Name,
+		Enabled:   s.Enabled,
+	}
+}
+
+func SportsToProto(sports []models.Sport) []*pb.Sport {
+	result := make([]*pb.Sport, 0, len(sports))
+	for _, s := range sports {
+		result = append(result, SportToProto(s))
+	}
+	return result
+}
+
+func TrendingCountryToProto(t models.TrendingCountry) *pb.TrendingCountry {
+	return &pb.TrendingCountry{
+		Id:        uint32(t.ID),
+		CreatedAt: FormatTime(t.CreatedAt),
+		UpdatedAt: FormatTime(t.UpdatedAt),
+		Code:      t.Code,
+		Name:      t.Name,
+		Enabled:   t.Enabled,
+	}
+}
+
+func TrendingCountriesToProto(ts []models.TrendingCountry) []*pb.TrendingCountry {
+	result := make([]*pb.TrendingCountry, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, TrendingCountryToProto(t))
+	}
+	return result
+}
+
+func EventChangeToDelta(change models.EventChange) *pb.EventDelta {
+	e := change.Event
+	return &pb.EventDelta{
+		SofaScoreEventId:            e.SofaScoreEventId,
+		LeagueId:                    uint32(e.LeagueId),
+		HomeScore:                   int32(e.HomeScore),
+		AwayScore:                   int32(e.AwayScore),
+		StatusCode:                  int32(e.StatusCode),
+		StatusType:                  e.StatusType,
+		StatusDescription:           e.StatusDescription,
+		StartTimestamp:              e.StartTimestamp,
+		CurrentPeriodStartTimestamp: e.CurrentPeriodStartTimestamp,
+		UpdatedAt:                   FormatTime(e.UpdatedAt),
+		IsNew:                       change.Previous == nil,
+	}
+}
+
+func DeviceStatusChangeToProto(s models.DeviceStatusChange) *pb.DeviceStatusChange {
+	return &pb.DeviceStatusChange{
+		DeviceId: uint32(s.DeviceID),
+		Name:     s.Name,
+		Online:   s.Online,
+		LastSeen: s.LastSeen,
+	}
+}
+
+func CrashReportToProto(r models.CrashReport) *pb.CrashReportSummary {
+	return &pb.CrashReportSummary{
+		Id:         uint32(r.ID),
+		CreatedAt:  FormatTime(r.CreatedAt),
+		Fatal:      r.Fatal,
+		Error:      r.Error,
+		AppName:    r.App.Name,
+		AppVersion: r.App.Version,
+		Platform:   r.App.Platform,
+	}
+}
+
+func JobRunToProto(j models.JobRun) *pb.JobRun {
+	p := &pb.JobRun{
+		Name:       j.Name,
+		StartedAt:  FormatTime(j.StartedAt),
+		DurationMs: j.Duration.Milliseconds(),
+		Tasks:      int32(j.Tasks),
+		Skipped:    int32(j.Skipped),
+	}
+	if j.Err != nil {
+		p.Error = j.Err.Error()
+	}
+	return p
+}
+
+func WebhookToProto(w models.WebhookSubscription) *pb.Webhook {
+	tournamentIDs := w.TournamentIDList()
+	ids := make([]uint32, 0, len(tournamentIDs))
+	for _, id := range tournamentIDs {
+		ids = append(ids, uint32(id))
+	}
+	return &pb.Webhook{
+		Id:            uint32(w.ID),
+		CreatedAt:     FormatTime(w.CreatedAt),
+		UpdatedAt:     FormatTime(w.UpdatedAt),
+		Url:           w.URL,
+		EventTypes:    w.EventTypeList(),
+		TournamentIds: ids,
+		Active:        w.Active,
+	}
+}
+
+func WebhooksToProto(ws []models.WebhookSubscription) []*pb.Webhook {
+	result := make([]*pb.Webhook, 0, len(ws))
+	for _, w := range ws {
+		result = append(result, WebhookToProto(w))
+	}
+	return result
+}
+
+func WebhookDeliveryToProto(d models.WebhookDelivery) *pb.WebhookDelivery {
+	return &pb.WebhookDelivery{
+		Id:             uint32(d.ID),
+		CreatedAt:      FormatTime(d.CreatedAt),
+		SubscriptionId: uint32(d.SubscriptionID),
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: int32(d.ResponseStatus),
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		Payload:        d.Payload,
+	}
+}
+
+func WebhookDeliveriesToProto(ds []models.WebhookDelivery) []*pb.WebhookDelivery {
+	result := make([]*pb.WebhookDelivery, 0, len(ds))
+	for _, d := range ds {
+		result = append(result, WebhookDeliveryToProto(d))
+	}
+	return result
+}
+
+func NotificationSubscriptionToProto(s models.NotificationSubscription) *pb.NotificationSubscription {
+	return &pb.NotificationSubscription{
+		Id:         uint32(s.ID),
+		CreatedAt:  FormatTime(s.CreatedAt),
+		TargetType: s.TargetType,
+		TargetId:   s.TargetID,
+	}
+}
+
+func NotificationSubscriptionsToProto(ss []models.NotificationSubscription) []*pb.NotificationSubscription {
+	result := make([]*pb.NotificationSubscription, 0, len(ss))
+	for _, s := range ss {
+		result = append(result, NotificationSubscriptionToProto(s))
+	}
+	return result
+}
+
+func NotificationTemplateToProto(t models.NotificationTemplate) *pb.NotificationTemplate {
+	return &pb.NotificationTemplate{
+		Kind:      t.Kind,
+		Title:     t.Title,
+		Body:      t.Body,
+		UpdatedAt: FormatTime(t.UpdatedAt),
+	}
+}
+
+func NotificationTemplatesToProto(ts []models.NotificationTemplate) []*pb.NotificationTemplate {
+	result := make([]*pb.NotificationTemplate, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, NotificationTemplateToProto(t))
+	}
+	return result
+}
+
+func NotificationLogToProto(l models.NotificationLog) *pb.NotificationLogEntry {
+	p := &pb.NotificationLogEntry{
+		Id:               uint32(l.ID),
+		CreatedAt:        FormatTime(l.CreatedAt),
+		DeviceId:         uint32(l.DeviceID),
+		SofaScoreEventId: l.SofaScoreEventId,
+		Kind:             l.Kind,
+		Title:            l.Title,
+		Body:             l.Body,
+		Provider:         l.Provider,
+		Status:           l.Status,
+		Error:            l.Error,
+	}
+	if l.Device != nil {
+		p.DeviceName = l.Device.Name
+	}
+	return p
+}
+
+func NotificationLogsToProto(ls []models.NotificationLog) []*pb.NotificationLogEntry {
+	result := make([]*pb.NotificationLogEntry, 0, len(ls))
+	for _, l := range ls {
+		result = append(result, NotificationLogToProto(l))
+	}
+	return result
+}
+
+// SyncChangesToProto returns the events without their nested teams and
+// league, which are sent once in the teams and tournaments lists instead.
+func SyncChangesToProto(s *models.SyncChanges) *pb.SyncResponse {
+	resp := &pb.SyncResponse{
+		Cursor:          s.Cursor.String(),
+		HasMore:         s.HasMore,
+		Events:          make([]*pb.SofaScoreEvent, 0, len(s.Events)),
+		Teams:           make([]*pb.Team, 0, len(s.Teams)),
+		Tournaments:     TournamentsToProto(s.Tournaments),
+		DeletedEventIds: s.DeletedEventIDs,
+		TournamentIds:   make([]uint32, 0, len(s.TournamentIDs)),
+	}
+	for _, e := range s.Events {
+		e.HomeTeamModel, e.AwayTeamModel, e.League = nil, nil, nil
+		resp.Events = append(resp.Events, EventToProto(e))
+	}
+	for _, t := range s.Teams {
+		t.LogoUrl = "/api/app/v1" + t.LogoUrl
+		resp.Teams = append(resp.Teams, TeamPtrToProto(&t))
+	}
+	for _, id := range s.TournamentIDs {
+		resp.TournamentIds = append(resp.TournamentIds, uint32(id))
+	}
+	return resp
+}
+
+func FeedSettingsToProto(f models.FeedSettings) *pb.FeedSettings {
+	p := &pb.FeedSettings{
+		Id:             uint32(f.ID),
+		CreatedAt:      FormatTime(f.CreatedAt),
+		UpdatedAt:      FormatTime(f.UpdatedAt),
+		PackageName:    f.PackageName,
+		MaxItems:       int32(f.MaxItems),
+		LookAheadHours: int32(f.LookAheadHours),
+		RecentHours:    int32(f.RecentHours),
+		Ordering:       f.Ordering,
+		Sports:         f.SportList(),
+	}
+	if f.DeviceID != nil {
+		p.DeviceId = uint32(*f.DeviceID)
+	}
+	if f.Device != nil {
+		p.Device = DeviceToProto(*f.Device)
+	}
+	return p
+}
+
+func FeedSettingsListToProto(fs []models.FeedSettings) []*pb.FeedSettings {
+	result := make([]*pb.FeedSettings, 0, len(fs))
+	for _, f := range fs {
+		result = append(result, FeedSettingsToProto(f))
+	}
+	return result
+}
+
+func EventFeedToProto(feed *models.EventFeed, limit int) *pb.EventsList {
+	return &pb.EventsList{
+		Data:       EventsToProto(feed.Data),
+		Limit:      int32(limit),
+		Live:       EventsToProto(feed.Live),
+		Upcoming:   EventsToProto(feed.Upcoming),
+		Recent:     EventsToProto(feed.Recent),
+		NextCursor: feed.NextCursor.String(),
+	}
+}
+
+func EventDetailToProto(d *models.EventDetail) *pb.EventDetail {
+	event := d.Event
+	p := &pb.EventDetail{
+		Round:          int32(event.Round),
+		RoundName:      event.RoundName,
+		Season:         event.Season,
+		Venue:          event.Venue,
+		VenueCity:      event.VenueCity,
+		Referee:        event.Referee,
+		HomeLastEvents: EventsToProto(d.HomeLast),
+		HomeForm:       teamForm(d.HomeLast, event.HomeTeamId),
+		AwayLastEvents: EventsToProto(d.AwayLast),
+		AwayForm:       teamForm(d.AwayLast, event.AwayTeamId),
+		HeadToHead:     EventsToProto(d.HeadToHead),
+	}
+	p.Event = EventsToProto([]models.SofaScoreEvent{event})[0]
+	for _, e := range d.HeadToHead {
+		switch e.ResultFor(event.HomeTeamId) {
+		case "W":
+			p.HomeWins++
+		case "L":
+			p.AwayWins++
+		default:
+			p.Draws++
+		}
+	}
+	return p
+}
+
+func teamForm(events []models.SofaScoreEvent, teamID int64) string {
+	var form strings.Builder
+	for _, e := range events {
+		form.WriteString(e.ResultFor(teamID))
+	}
+	return form.String()
+}
+
+func TranslationToProto(t models.Translation) *pb.Translation {
+	return &pb.Translation{
+		Id:         uint32(t.ID),
+		CreatedAt:  FormatTime(t.CreatedAt),
+		UpdatedAt:  FormatTime(t.UpdatedAt),
+		EntityType: t.EntityType,
+		EntityKey:  t.EntityKey,
+		Locale:     t.Locale,
+		Name:       t.Name,
+		Source:     t.Source,
+	}
+}
+
+func TranslationsToProto(ts []models.Translation) []*pb.Translation {
+	result := make([]*pb.Translation, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, TranslationToProto(t))
+	}
+	return result
+}
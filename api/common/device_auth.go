@@ -0,0 +1,80 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/deviceauth"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+const deviceSignedKey = "deviceSigned"
+
+// signatureRequired reports whether devices that were never issued a secret
+// are rejected. Devices holding a secret must always sign their requests,
+// otherwise a leaked token alone would be enough to impersonate them.
+func signatureRequired() bool {
+	return os.Getenv("DEVICE_AUTH_STRICT") == "true"
+}
+
+// VerifyDeviceRequest checks the signature headers of the request against
+// the secrets of device. The body is left readable for the handler.
+func VerifyDeviceRequest(c *gin.Context, device *models.Device) error {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return deviceauth.Verify(device.ID, deviceauth.Request{
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.RequestURI(),
+		Timestamp: c.GetHeader(deviceauth.HeaderTimestamp),
+		Nonce:     c.GetHeader(deviceauth.HeaderNonce),
+		Signature: c.GetHeader(deviceauth.HeaderSignature),
+		Body:      body,
+	}, device.SigningSecrets(time.Now())...)
+}
+
+// DeviceSigned reports whether AppMiddleware verified a signature for the
+// current request.
+func DeviceSigned(c *gin.Context) bool {
+	return c.GetBool(deviceSignedKey)
+}
+
+// authenticateDevice applies the signing policy to a device found by token
+// and responds when the request has to be rejected.
+func authenticateDevice(c *gin.Context, device *models.Device) bool {
+	if device.Revoked() {
+		RespondError(c, http.StatusForbidden, "device credentials have been revoked")
+		return false
+	}
+	if device.Blocked() {
+		RespondError(c, http.StatusForbidden, "device is blocked")
+		return false
+	}
+
+	if device.PairingRequired {
+		RespondError(c, http.StatusForbidden, "device must be paired again with an enrollment code")
+		return false
+	}
+
+	if device.Secret == "" {
+		if signatureRequired() {
+			RespondError(c, http.StatusUnauthorized, "device must register again to obtain a secret")
+			return false
+		}
+		return true
+	}
+
+	if err := VerifyDeviceRequest(c, device); err != nil {
+		RespondError(c, http.StatusUnauthorized, err.Error())
+		return false
+	}
+	c.Set(deviceSignedKey, true)
+	return true
+}
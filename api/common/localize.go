@@ -0,0 +1,69 @@
+package common
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/locale"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+// Localize replaces team, tournament and status names in an app response
+// with their translation in the device locale or, failing that, in the
+// Accept-Language locales. Names without a translation are left as stored.
+func Localize(c *gin.Context, events []*pb.SofaScoreEvent, teams []*pb.Team, tournaments []*pb.Tournament) {
+	device := c.MustGet("device").(models.Device)
+	locales := locale.Preferred(device.Locale, c.GetHeader("Accept-Language"))
+	if len(locales) == 0 {
+		return
+	}
+
+	for _, e := range events {
+		if e.TeamHome != nil {
+			teams = append(teams, e.TeamHome)
+		}
+		if e.TeamAway != nil {
+			teams = append(teams, e.TeamAway)
+		}
+		if e.League != nil {
+			tournaments = append(tournaments, e.League)
+		}
+	}
+
+	teamIDs := make([]int64, 0, len(teams))
+	for _, t := range teams {
+		teamIDs = append(teamIDs, t.TeamId)
+	}
+	tournamentIDs := make([]uint, 0, len(tournaments))
+	for _, t := range tournaments {
+		tournamentIDs = append(tournamentIDs, uint(t.Id))
+	}
+	statusCodes := make([]int, 0, len(events))
+	for _, e := range events {
+		statusCodes = append(statusCodes, int(e.StatusCode))
+	}
+
+	set, err := repository.LookupTranslations(locales, teamIDs, tournamentIDs, statusCodes)
+	if err != nil {
+		log.Printf("api: failed to load translations: %v", err)
+		return
+	}
+	if len(set) == 0 {
+		return
+	}
+
+	for _, t := range teams {
+		t.Name = set.Name(models.TranslationTeam, strconv.FormatInt(t.TeamId, 10), t.Name)
+	}
+	for _, t := range tournaments {
+		t.Name = set.Name(models.TranslationTournament, strconv.FormatUint(uint64(t.Id), 10), t.Name)
+	}
+	for _, e := range events {
+		if e.StatusDescription != "" {
+			e.StatusDescription = set.Name(models.TranslationStatus, strconv.Itoa(int(e.StatusCode)), e.StatusDescription)
+		}
+	}
+}
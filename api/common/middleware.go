@@ -76,6 +76,10 @@ func ParseRefreshToken(tokenStr string) (*TokenClaims, error) {
 	return parseToken(tokenStr, refreshTokenType)
 }
 
+func ParseAccessToken(tokenStr string) (*TokenClaims, error) {
+	return parseToken(tokenStr, accessTokenType)
+}
+
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenStr, ok := ExtractBearerToken(c)
@@ -85,7 +89,7 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		claims, err := parseToken(tokenStr, accessTokenType)
+		claims, err := ParseAccessToken(tokenStr)
 		if err != nil {
 			RespondError(c, http.StatusUnauthorized, "invalid token")
 			c.Abort()
@@ -176,7 +180,19 @@ func GetUserID(c *gin.Context) (uint, bool) {
 	return id, ok
 }
 
+// AppMiddleware authenticates the device of an app request and requires it
+// to hold an active subscription.
 func AppMiddleware() gin.HandlerFunc {
+	return appMiddleware(true)
+}
+
+// AppDeviceMiddleware authenticates the device without looking at its
+// subscription, for the endpoints a device needs to get one.
+func AppDeviceMiddleware() gin.HandlerFunc {
+	return appMiddleware(false)
+}
+
+func appMiddleware(enforceSubscription bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		db, err := database.GetDB()
 		if err != nil {
@@ -192,6 +208,16 @@ func AppMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		if !authenticateDevice(c, &device) {
+			c.Abort()
+			return
+		}
+
+		if enforceSubscription && !checkSubscription(c, &device) {
+			c.Abort()
+			return
+		}
+
 		c.Set("device", device)
 		c.Next()
 	}
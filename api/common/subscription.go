@@ -0,0 +1,74 @@
+package common
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+const planKey = "plan"
+
+// subscriptionRequired reports whether devices without any subscription are
+// refused. Expired subscriptions are always refused.
+func subscriptionRequired() bool {
+	return os.Getenv("REQUIRE_SUBSCRIPTION") == "true"
+}
+
+// RespondSubscriptionError answers 402 Payment Required with a
+// pb.SubscriptionError the app can act on.
+func RespondSubscriptionError(c *gin.Context, code, msg string, expiresAt int64) {
+	c.Header("Cache-Control", "no-store")
+	RespondProto(c, http.StatusPaymentRequired, &pb.SubscriptionError{Code: code, Message: msg, ExpiresAt: expiresAt})
+}
+
+// checkSubscription applies the subscription policy to an authenticated
+// device and responds when the request has to be rejected.
+func checkSubscription(c *gin.Context, device *models.Device) bool {
+	subscription, err := repository.GetDeviceSubscription(device.ID)
+	if err != nil {
+		RespondError(c, http.StatusInternalServerError, err.Error())
+		return false
+	}
+
+	if subscription == nil {
+		if subscriptionRequired() {
+			RespondSubscriptionError(c, models.SubscriptionErrorRequired, "device has no subscription", 0)
+			return false
+		}
+		plan, err := repository.GetDefaultPlan()
+		if err != nil {
+			RespondError(c, http.StatusInternalServerError, err.Error())
+			return false
+		}
+		if plan != nil {
+			c.Set(planKey, plan)
+		}
+		return true
+	}
+
+	if !subscription.Active(time.Now()) {
+		RespondSubscriptionError(c, models.SubscriptionErrorExpired, "device subscription has expired", subscription.ExpiresAt)
+		return false
+	}
+
+	if subscription.Plan != nil {
+		c.Set(planKey, subscription.Plan)
+	}
+	return true
+}
+
+// CurrentPlan returns the plan checked by AppMiddleware: that of the active
+// subscription, else the default plan, or nil when neither applies.
+func CurrentPlan(c *gin.Context) *models.Plan {
+	v, ok := c.Get(planKey)
+	if !ok {
+		return nil
+	}
+	plan, _ := v.(*models.Plan)
+	return plan
+}
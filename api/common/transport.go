@@ -1,23 +1,79 @@
 package common
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/proto"
 )
 
+// RespondProto writes v as protobuf. Successful GET responses carry an ETag
+// computed from the payload, and a matching If-None-Match is answered with
+// 304 Not Modified and no body.
 func RespondProto(c *gin.Context, status int, v proto.Message) {
-	data, err := proto.Marshal(v)
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(v)
 	if err != nil {
 		c.String(http.StatusInternalServerError, "encoding error")
 		return
 	}
+
+	if status == http.StatusOK && (c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead) {
+		sum := sha256.Sum256(data)
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+		c.Header("ETag", etag)
+		if etagMatches(c.GetHeader("If-None-Match"), etag) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
 	c.Data(status, "application/x-protobuf", data)
 }
 
+// RespondVersionedProto answers 200 with v using version as its ETag, or 304
+// when the client already has that version. The body is not encoded then.
+func RespondVersionedProto(c *gin.Context, version string, v proto.Message) {
+	etag := `"` + version + `"`
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(v)
+	if err != nil {
+		c.String(http.StatusInternalServerError, "encoding error")
+		return
+	}
+	c.Data(http.StatusOK, "application/x-protobuf", data)
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using the
+// weak comparison required for GET requests.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// CacheControl sets the Cache-Control policy of a route. Error responses
+// replace it with no-store.
+func CacheControl(value string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", value)
+		c.Next()
+	}
+}
+
 func RespondError(c *gin.Context, status int, msg string) {
+	c.Header("Cache-Control", "no-store")
 	c.JSON(status, map[string]string{"error": msg})
 }
 
@@ -26,5 +82,6 @@ func ParseProtoBody(c *gin.Context, v proto.Message) error {
 	if err != nil {
 		return err
 	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
 	return proto.Unmarshal(body, v)
 }
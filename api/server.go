@@ -1,7 +1,12 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/app"
@@ -9,7 +14,14 @@ import (
 	"github.com/jeriveromartinez/sofascore-scrapper/api/web"
 )
 
-func Start(addr string) {
+// shutdownTimeout bounds how long running requests may take to finish once
+// the server is asked to stop.
+const shutdownTimeout = 10 * time.Second
+
+// Start serves the API until ctx is done, then stops accepting connections
+// and returns once the running requests are done. Long polls and live streams see ctx
+// end through their request context and return early.
+func Start(ctx context.Context, addr string) {
 	router := gin.New()
 	router.Use(common.CorsMiddleware(), gin.Logger(), gin.Recovery())
 
@@ -18,24 +30,65 @@ func Start(addr string) {
 
 	(&app.ApkController{Group: appV1}).LoadRoutes()
 	(&app.CurrentEventsController{Group: appV1}).LoadRoutes()
+	(&app.TrendingEventsController{Group: appV1}).LoadRoutes()
+	(&app.LiveEventsController{Group: appV1}).LoadRoutes()
 	(&app.DeviceRegistrationController{Group: appV1}).LoadRoutes()
 	(&app.TeamController{Group: appV1}).LoadRoutes()
 	(&app.ReportController{Group: appV1}).LoadRoutes()
+	(&app.NotificationController{Group: appV1}).LoadRoutes()
+	(&app.SyncController{Group: appV1}).LoadRoutes()
+	(&app.FavoriteController{Group: appV1}).LoadRoutes()
+	(&app.EventDetailController{Group: appV1}).LoadRoutes()
+	(&app.ConfigController{Group: appV1}).LoadRoutes()
+	(&app.CommandController{Group: appV1}).LoadRoutes()
+	(&app.PlaybackController{Group: appV1}).LoadRoutes()
 
 	(&web.EventController{Group: webV1}).LoadRoutes()
 	(&web.UserController{Group: webV1}).LoadRoutes()
 	(&web.DeviceController{Group: webV1}).LoadRoutes()
+	(&web.EnrollmentController{Group: webV1}).LoadRoutes()
+	(&web.SubscriptionController{Group: webV1}).LoadRoutes()
+	(&web.DeviceCommandController{Group: webV1}).LoadRoutes()
+	(&web.DeviceGroupController{Group: webV1}).LoadRoutes()
+	(&web.RemoteConfigController{Group: webV1}).LoadRoutes()
 	(&web.PlaybackController{Group: webV1}).LoadRoutes()
 	(&web.StatsController{Group: webV1}).LoadRoutes()
 	(&web.ApkController{Group: webV1}).LoadRoutes()
 	(&web.TournamentController{Group: webV1}).LoadRoutes()
 	(&web.DeviceTournamentController{Group: webV1}).LoadRoutes()
+	(&web.DeviceTeamController{Group: webV1}).LoadRoutes()
+	(&web.FeedSettingsController{Group: webV1}).LoadRoutes()
+	(&web.TranslationController{Group: webV1}).LoadRoutes()
 	(&web.GlobalConfigController{Group: webV1}).LoadRoutes()
+	(&web.SportController{Group: webV1}).LoadRoutes()
+	(&web.TrendingCountryController{Group: webV1}).LoadRoutes()
+	(&web.LiveController{Group: webV1}).LoadRoutes()
+	(&web.WebhookController{Group: webV1}).LoadRoutes()
+	(&web.NotificationController{Group: webV1}).LoadRoutes()
 
 	web.RegisterDashboardRoutes(router)
 
+	srv := &http.Server{
+		Addr:        addr,
+		Handler:     router,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("API server shutdown: %v", err)
+		}
+	}()
+
 	log.Printf("API server listening on %s", addr)
-	if err := router.Run(addr); err != nil {
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("API server error: %v", err)
 	}
+	// ListenAndServe returns as soon as shutdown begins; wait for the
+	// running requests.
+	<-done
 }
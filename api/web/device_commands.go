@@ -0,0 +1,149 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+// maxCommandPayload caps the payload sent along with a command.
+const maxCommandPayload = 4 << 10
+
+type DeviceCommandController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *DeviceCommandController) LoadRoutes() {
+	c.Group.GET("/devices/:id/commands", common.AuthMiddleware(), handleGetDeviceCommands)
+	c.Group.POST("/devices/:id/commands", common.AuthMiddleware(), handleCreateDeviceCommand)
+	c.Group.DELETE("/devices/:id/commands/:commandId", common.AuthMiddleware(), handleCancelDeviceCommand)
+}
+
+func handleGetDeviceCommands(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+
+	page := 1
+	limit := 20
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 100 {
+			parsedLimit = 100
+		}
+		limit = parsedLimit
+	}
+
+	commands, total, err := repository.GetDeviceCommands(device.ID, uint(page), uint(limit))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	common.RespondProto(c, http.StatusOK, &pb.DeviceCommandList{
+		Data:       common.DeviceCommandsToProto(commands),
+		Page:       int32(page),
+		Limit:      int32(limit),
+		Total:      total,
+		TotalPages: int32(totalPages),
+	})
+}
+
+func handleCreateDeviceCommand(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+	var req pb.DeviceCommandRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if !models.ValidDeviceCommandType(req.Type) {
+		common.RespondError(c, http.StatusBadRequest, "type must be check_update, clear_cache, reload_config, restart_player or upload_logs")
+		return
+	}
+	if len(req.Payload) > maxCommandPayload {
+		common.RespondError(c, http.StatusBadRequest, "payload is too large")
+		return
+	}
+
+	ttl := models.DeviceCommandDefaultTTL
+	if req.TtlMinutes < 0 {
+		common.RespondError(c, http.StatusBadRequest, "ttl_minutes must not be negative")
+		return
+	}
+	if req.TtlMinutes > 0 {
+		ttl = min(time.Duration(req.TtlMinutes)*time.Minute, models.DeviceCommandMaxTTL)
+	}
+
+	userID, _ := common.GetUserID(c)
+	command := models.DeviceCommand{
+		DeviceID:  device.ID,
+		UserID:    &userID,
+		Type:      req.Type,
+		Payload:   req.Payload,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}
+	if err := repository.CreateDeviceCommand(&command); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	broker.Publish(broker.TopicDeviceCommand, command)
+	common.RespondProto(c, http.StatusCreated, common.DeviceCommandToProto(command))
+}
+
+// handleCancelDeviceCommand withdraws a command that no poll has delivered
+// yet; delivered commands can only run or expire.
+func handleCancelDeviceCommand(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+	commandID, err := common.ParseID(c.Param("commandId"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid command ID")
+		return
+	}
+	if _, err := repository.GetDeviceCommand(device.ID, commandID); err != nil {
+		common.RespondError(c, http.StatusNotFound, "command not found")
+		return
+	}
+
+	command, err := repository.CancelDeviceCommand(device.ID, commandID)
+	if errors.Is(err, models.ErrDeviceCommandClosed) {
+		common.RespondError(c, http.StatusConflict, "only pending commands can be cancelled")
+		return
+	}
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	broker.Publish(broker.TopicDeviceCommand, *command)
+	common.RespondProto(c, http.StatusOK, common.DeviceCommandToProto(*command))
+}
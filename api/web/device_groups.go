@@ -0,0 +1,373 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type DeviceGroupController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *DeviceGroupController) LoadRoutes() {
+	c.Group.GET("/device-groups", common.AuthMiddleware(), handleGetDeviceGroups)
+	c.Group.POST("/device-groups", common.AuthMiddleware(), handleCreateDeviceGroup)
+	c.Group.PUT("/device-groups/:id", common.AuthMiddleware(), handleUpdateDeviceGroup)
+	c.Group.DELETE("/device-groups/:id", common.AuthMiddleware(), handleDeleteDeviceGroup)
+	c.Group.GET("/device-groups/:id/devices", common.AuthMiddleware(), handleGetDeviceGroupMembers)
+	c.Group.PUT("/device-groups/:id/devices/:deviceId", common.AuthMiddleware(), handleAddDeviceToGroup)
+	c.Group.DELETE("/device-groups/:id/devices/:deviceId", common.AuthMiddleware(), handleRemoveDeviceFromGroup)
+	c.Group.POST("/device-groups/:id/devices", common.AuthMiddleware(), handleAddDevicesToGroup)
+	c.Group.DELETE("/device-groups/:id/devices", common.AuthMiddleware(), handleRemoveDevicesFromGroup)
+	c.Group.GET("/device-groups/:id/tournaments", common.AuthMiddleware(), handleGetDeviceGroupTournaments)
+	c.Group.PUT("/device-groups/:id/tournaments", common.AuthMiddleware(), handleSetDeviceGroupTournaments)
+}
+
+// maxBulkGroupDevices caps the devices of one bulk membership request.
+const maxBulkGroupDevices = 500
+
+func handleGetDeviceGroups(c *gin.Context) {
+	groups, counts, err := repository.GetDeviceGroups()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	data := make([]*pb.DeviceGroup, 0, len(groups))
+	for _, group := range groups {
+		data = append(data, common.DeviceGroupToProto(group, counts[group.ID]))
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceGroupList{Data: data})
+}
+
+func parseDeviceGroupRequest(c *gin.Context) (models.DeviceGroup, bool) {
+	var req pb.DeviceGroupRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return models.DeviceGroup{}, false
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 100 {
+		common.RespondError(c, http.StatusBadRequest, "name is required and must be at most 100 characters")
+		return models.DeviceGroup{}, false
+	}
+	if len(req.Description) > 255 {
+		common.RespondError(c, http.StatusBadRequest, "description must be at most 255 characters")
+		return models.DeviceGroup{}, false
+	}
+	group := models.DeviceGroup{
+		Name:         name,
+		Description:  req.Description,
+		Priority:     int(req.Priority),
+		RulePackage:  strings.TrimSpace(req.RulePackage),
+		RuleVersion:  strings.TrimSpace(req.RuleVersion),
+		RulePlatform: strings.TrimSpace(req.RulePlatform),
+	}
+	if len(group.RulePackage) > 191 || len(group.RulePlatform) > 32 {
+		common.RespondError(c, http.StatusBadRequest, "rule_package or rule_platform is too long")
+		return models.DeviceGroup{}, false
+	}
+	if group.RuleVersion != "" && !models.ValidRuleVersion(group.RuleVersion) {
+		common.RespondError(c, http.StatusBadRequest, "rule_version must be a version such as 2.1 or 2.1.4")
+		return models.DeviceGroup{}, false
+	}
+	if req.RuleManagerId != 0 {
+		manager, err := repository.GetUserByID(uint(req.RuleManagerId))
+		if err != nil {
+			common.RespondError(c, http.StatusBadRequest, "rule_manager_id does not match a user")
+			return models.DeviceGroup{}, false
+		}
+		group.RuleManagerID = &manager.ID
+	}
+	return group, true
+}
+
+func handleCreateDeviceGroup(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	group, ok := parseDeviceGroupRequest(c)
+	if !ok {
+		return
+	}
+	if err := repository.CreateDeviceGroup(&group); err != nil {
+		common.RespondError(c, http.StatusConflict, "could not create device group")
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.DeviceGroupToProto(group, 0))
+}
+
+func handleUpdateDeviceGroup(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device group ID")
+		return
+	}
+	values, ok := parseDeviceGroupRequest(c)
+	if !ok {
+		return
+	}
+	group, err := repository.UpdateDeviceGroup(id, values)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device group not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.DeviceGroupToProto(*group, 0))
+}
+
+func handleDeleteDeviceGroup(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device group ID")
+		return
+	}
+	deleted, err := repository.DeleteDeviceGroup(id)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !deleted {
+		common.RespondError(c, http.StatusNotFound, "device group not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device group deleted"})
+}
+
+func handleGetDeviceGroupMembers(c *gin.Context) {
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return
+	}
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+	devices, err := repository.GetDeviceGroupMembers(*group, scope)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceList{Data: common.DevicesToProto(devices)})
+}
+
+func deviceGroupParam(c *gin.Context) (*models.DeviceGroup, bool) {
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device group ID")
+		return nil, false
+	}
+	group, err := repository.GetDeviceGroup(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device group not found")
+		return nil, false
+	}
+	return group, true
+}
+
+// groupMembership resolves the group and the managed device of a
+// membership route.
+func groupMembership(c *gin.Context) (uint, *models.Device, bool) {
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return 0, nil, false
+	}
+	deviceID, err := common.ParseID(c.Param("deviceId"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device ID")
+		return 0, nil, false
+	}
+	device, err := repository.GetDeviceByID(deviceID)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device not found")
+		return 0, nil, false
+	}
+	if !canManageDevice(c, device) {
+		return 0, nil, false
+	}
+	return group.ID, device, true
+}
+
+func handleAddDeviceToGroup(c *gin.Context) {
+	groupID, device, ok := groupMembership(c)
+	if !ok {
+		return
+	}
+	if err := repository.AddDeviceToGroup(groupID, device.ID); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device added to group"})
+}
+
+func handleRemoveDeviceFromGroup(c *gin.Context) {
+	groupID, device, ok := groupMembership(c)
+	if !ok {
+		return
+	}
+	removed, err := repository.RemoveDeviceFromGroup(groupID, device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !removed {
+		common.RespondError(c, http.StatusNotFound, "device is not in the group")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device removed from group"})
+}
+
+// bulkGroupDevices parses a bulk membership request and splits its devices
+// into those the current user may manage and the rejected ones.
+func bulkGroupDevices(c *gin.Context) ([]uint, []uint32, bool) {
+	var req pb.DeviceGroupMembersRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || len(req.DeviceIds) == 0 {
+		common.RespondError(c, http.StatusBadRequest, "device_ids is required")
+		return nil, nil, false
+	}
+	if len(req.DeviceIds) > maxBulkGroupDevices {
+		common.RespondError(c, http.StatusBadRequest, fmt.Sprintf("at most %d devices per request", maxBulkGroupDevices))
+		return nil, nil, false
+	}
+	scope, ok := managerScope(c)
+	if !ok {
+		return nil, nil, false
+	}
+
+	ids := make([]uint, len(req.DeviceIds))
+	for i, id := range req.DeviceIds {
+		ids[i] = uint(id)
+	}
+	managed, err := repository.GetManagedDeviceIDs(scope, ids)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return nil, nil, false
+	}
+	accepted := make(map[uint]struct{}, len(managed))
+	for _, id := range managed {
+		accepted[id] = struct{}{}
+	}
+	rejected := make([]uint32, 0)
+	for _, id := range req.DeviceIds {
+		if _, ok := accepted[uint(id)]; !ok {
+			rejected = append(rejected, id)
+		}
+	}
+	return managed, rejected, true
+}
+
+func handleAddDevicesToGroup(c *gin.Context) {
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return
+	}
+	deviceIDs, rejected, ok := bulkGroupDevices(c)
+	if !ok {
+		return
+	}
+	added, err := repository.AddDevicesToGroup(group.ID, deviceIDs)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceGroupMembersResult{Affected: added, RejectedDeviceIds: rejected})
+}
+
+func handleRemoveDevicesFromGroup(c *gin.Context) {
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return
+	}
+	deviceIDs, rejected, ok := bulkGroupDevices(c)
+	if !ok {
+		return
+	}
+	removed, err := repository.RemoveDevicesFromGroup(group.ID, deviceIDs)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceGroupMembersResult{Affected: removed, RejectedDeviceIds: rejected})
+}
+
+func handleGetDeviceGroupTournaments(c *gin.Context) {
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return
+	}
+	groupTournaments, err := repository.GetDeviceGroupTournaments(group.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	tournaments := make([]*pb.Tournament, 0, len(groupTournaments))
+	for _, gt := range groupTournaments {
+		if gt.Tournament != nil {
+			tournaments = append(tournaments, common.TournamentToProto(*gt.Tournament))
+		}
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceGroupTournamentList{DeviceGroupId: uint32(group.ID), Tournaments: tournaments})
+}
+
+// handleSetDeviceGroupTournaments replaces the tournaments shown to members
+// of the group without tournaments of their own. An empty set hands them
+// over to the next group or to the global configuration.
+func handleSetDeviceGroupTournaments(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	group, ok := deviceGroupParam(c)
+	if !ok {
+		return
+	}
+	var req pb.SetTournamentIdsRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	ids := make([]uint, 0, len(req.TournamentIds))
+	seen := make(map[uint]struct{}, len(req.TournamentIds))
+	for _, id := range req.TournamentIds {
+		if _, ok := seen[uint(id)]; !ok {
+			seen[uint(id)] = struct{}{}
+			ids = append(ids, uint(id))
+		}
+	}
+
+	found, err := repository.CountTournaments(ids)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if found != int64(len(ids)) {
+		common.RespondError(c, http.StatusBadRequest, "unknown tournament")
+		return
+	}
+	plan, err := repository.GetGroupTournamentLimit(*group)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if plan != nil && len(ids) > plan.MaxTournaments {
+		common.RespondError(c, http.StatusConflict, fmt.Sprintf("plan %s of a group device allows at most %d tournaments", plan.Name, plan.MaxTournaments))
+		return
+	}
+
+	if err := repository.SetDeviceGroupTournaments(group.ID, ids); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device group tournaments updated"})
+}
@@ -0,0 +1,113 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type DeviceTeamController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *DeviceTeamController) LoadRoutes() {
+	c.Group.GET("/device-teams", common.AuthMiddleware(), handleGetAllDeviceTeams)
+	c.Group.GET("/device-teams/:deviceId", common.AuthMiddleware(), handleGetDeviceTeams)
+	c.Group.POST("/device-teams", common.AuthMiddleware(), handleAddTeamToDevice)
+	c.Group.DELETE("/device-teams", common.AuthMiddleware(), handleRemoveTeamFromDevice)
+	c.Group.PUT("/device-teams/:deviceId", common.AuthMiddleware(), handleSetDeviceTeams)
+}
+
+func handleGetAllDeviceTeams(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+	deviceTeams, err := repository.GetAllDeviceTeams(scope)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceTeamList{DeviceTeams: common.DeviceTeamsToProto(deviceTeams)})
+}
+
+func handleGetDeviceTeams(c *gin.Context) {
+	device, ok := managedDeviceFrom(c, c.Param("deviceId"))
+	if !ok {
+		return
+	}
+
+	deviceTeams, err := repository.GetDeviceTeams(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.DeviceTeamList{DeviceTeams: common.DeviceTeamsToProto(deviceTeams)})
+}
+
+func handleAddTeamToDevice(c *gin.Context) {
+	var req pb.AssignTeamRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if _, ok := managedDevice(c, uint(req.DeviceId)); !ok {
+		return
+	}
+	exists, err := repository.TeamExists(req.TeamId)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !exists {
+		common.RespondError(c, http.StatusNotFound, "team not found")
+		return
+	}
+
+	deviceTeam, err := repository.AddTeamToDevice(uint(req.DeviceId), req.TeamId)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.DeviceTeamToProto(*deviceTeam))
+}
+
+func handleRemoveTeamFromDevice(c *gin.Context) {
+	var req pb.AssignTeamRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if _, ok := managedDevice(c, uint(req.DeviceId)); !ok {
+		return
+	}
+
+	if err := repository.RemoveTeamFromDevice(uint(req.DeviceId), req.TeamId); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "team removed from device"})
+}
+
+func handleSetDeviceTeams(c *gin.Context) {
+	device, ok := managedDeviceFrom(c, c.Param("deviceId"))
+	if !ok {
+		return
+	}
+
+	var req pb.SetTeamIdsRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if err := repository.SetDeviceTeams(device.ID, req.TeamIds); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device teams updated"})
+}
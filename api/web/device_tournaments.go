@@ -1,6 +1,7 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -22,7 +23,11 @@ func (c *DeviceTournamentController) LoadRoutes() {
 }
 
 func handleGetAllDeviceTournaments(c *gin.Context) {
-	deviceTournaments, err := repository.GetAllDeviceTournaments()
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+	deviceTournaments, err := repository.GetAllDeviceTournaments(scope)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
@@ -31,13 +36,12 @@ func handleGetAllDeviceTournaments(c *gin.Context) {
 }
 
 func handleGetDeviceTournaments(c *gin.Context) {
-	deviceID, err := common.ParseID(c.Param("deviceId"))
-	if err != nil {
-		common.RespondError(c, http.StatusBadRequest, "invalid device id")
+	device, ok := managedDeviceFrom(c, c.Param("deviceId"))
+	if !ok {
 		return
 	}
 
-	deviceTournaments, err := repository.GetDeviceTournaments(deviceID)
+	deviceTournaments, err := repository.GetDeviceTournaments(device.ID)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
@@ -51,6 +55,18 @@ func handleAssignTournamentToDevice(c *gin.Context) {
 		common.RespondError(c, http.StatusBadRequest, "invalid request")
 		return
 	}
+	if _, ok := managedDevice(c, uint(req.DeviceId)); !ok {
+		return
+	}
+
+	assigned, err := repository.CountDeviceTournaments(uint(req.DeviceId))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !withinTournamentLimit(c, uint(req.DeviceId), int(assigned)+1) {
+		return
+	}
 
 	deviceTournament, err := repository.AssignTournamentToDevice(uint(req.DeviceId), uint(req.TournamentId))
 	if err != nil {
@@ -66,6 +82,9 @@ func handleRemoveTournamentFromDevice(c *gin.Context) {
 		common.RespondError(c, http.StatusBadRequest, "invalid request")
 		return
 	}
+	if _, ok := managedDevice(c, uint(req.DeviceId)); !ok {
+		return
+	}
 
 	if err := repository.RemoveTournamentFromDevice(uint(req.DeviceId), uint(req.TournamentId)); err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
@@ -75,11 +94,11 @@ func handleRemoveTournamentFromDevice(c *gin.Context) {
 }
 
 func handleSetDeviceTournaments(c *gin.Context) {
-	deviceID, err := common.ParseID(c.Param("deviceId"))
-	if err != nil {
-		common.RespondError(c, http.StatusBadRequest, "invalid device id")
+	device, ok := managedDeviceFrom(c, c.Param("deviceId"))
+	if !ok {
 		return
 	}
+	deviceID := device.ID
 
 	var req pb.SetTournamentIdsRequest
 	if err := common.ParseProtoBody(c, &req); err != nil {
@@ -92,9 +111,31 @@ func handleSetDeviceTournaments(c *gin.Context) {
 		ids[i] = uint(id)
 	}
 
+	if !withinTournamentLimit(c, deviceID, len(ids)) {
+		return
+	}
+
 	if err := repository.SetDeviceTournaments(deviceID, ids); err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "device tournaments updated"})
 }
+
+// withinTournamentLimit responds 409 when count tournaments exceed what the
+// device plan allows.
+func withinTournamentLimit(c *gin.Context, deviceID uint, count int) bool {
+	plan, err := repository.GetDevicePlan(deviceID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return false
+	}
+	if plan == nil || plan.MaxTournaments == 0 {
+		return true
+	}
+	if count > plan.MaxTournaments {
+		common.RespondError(c, http.StatusConflict, fmt.Sprintf("plan %s allows at most %d tournaments", plan.Name, plan.MaxTournaments))
+		return false
+	}
+	return true
+}
@@ -3,9 +3,12 @@ package web
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
@@ -18,9 +21,68 @@ func (c *DeviceController) LoadRoutes() {
 	c.Group.GET("/devices", common.AuthMiddleware(), handleGetDevices)
 	c.Group.GET("/devices/all", common.AuthMiddleware(), handleGetAllDevices)
 	c.Group.PUT("/devices", common.AuthMiddleware(), handleUpdateDevice)
+	c.Group.POST("/devices/:id/revoke", common.AuthMiddleware(), handleRevokeDevice)
+	c.Group.POST("/devices/:id/reinstate", common.AuthMiddleware(), handleReinstateDevice)
+	c.Group.POST("/devices/:id/block", common.AuthMiddleware(), handleBlockDevice)
+	c.Group.POST("/devices/:id/unblock", common.AuthMiddleware(), handleUnblockDevice)
+	c.Group.GET("/devices/:id/audit", common.AuthMiddleware(), handleGetDeviceAudit)
+	c.Group.GET("/devices/duplicates", common.AuthMiddleware(), handleGetDuplicateDevices)
+	c.Group.GET("/devices/online", common.AuthMiddleware(), handleGetOnlineDevices)
+	c.Group.GET("/devices/:id/sessions", common.AuthMiddleware(), handleGetDeviceSessions)
+}
+
+// managerScope returns the manager whose devices the current user may see,
+// or nil for admins, who see every device.
+func managerScope(c *gin.Context) (*uint, bool) {
+	userID, ok := common.GetUserID(c)
+	if !ok {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return nil, false
+	}
+	user, err := repository.GetUserByID(userID)
+	if err != nil {
+		common.RespondError(c, http.StatusUnauthorized, "user not found")
+		return nil, false
+	}
+	if user.IsAdmin() {
+		return nil, true
+	}
+	return &user.ID, true
+}
+
+// requireAdmin responds 403 unless the current user is an admin.
+func requireAdmin(c *gin.Context) bool {
+	scope, ok := managerScope(c)
+	if !ok {
+		return false
+	}
+	if scope != nil {
+		common.RespondError(c, http.StatusForbidden, "admin role required")
+		return false
+	}
+	return true
+}
+
+// canManageDevice reports whether the current user may manage device and
+// answers 404 otherwise, so other managers' devices are not disclosed.
+func canManageDevice(c *gin.Context, device *models.Device) bool {
+	scope, ok := managerScope(c)
+	if !ok {
+		return false
+	}
+	if scope != nil && (device.UserID == nil || *device.UserID != *scope) {
+		common.RespondError(c, http.StatusNotFound, "device not found")
+		return false
+	}
+	return true
 }
 
 func handleGetDevices(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
 	page := 1
 	limit := 10
 	if pageParam := c.Query("page"); pageParam != "" {
@@ -44,7 +106,7 @@ func handleGetDevices(c *gin.Context) {
 		limit = parsedLimit
 	}
 
-	devices, total, err := repository.GetDevices(uint(page), uint(limit))
+	devices, total, err := repository.GetDevices(scope, uint(page), uint(limit))
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
@@ -61,7 +123,12 @@ func handleGetDevices(c *gin.Context) {
 }
 
 func handleGetAllDevices(c *gin.Context) {
-	devices, err := repository.GetAllDevices()
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
+	devices, err := repository.GetAllDevices(scope)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
@@ -81,6 +148,15 @@ func handleUpdateDevice(c *gin.Context) {
 		return
 	}
 
+	device, err := repository.GetDeviceByToken(req.Token)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device not found")
+		return
+	}
+	if !canManageDevice(c, device) {
+		return
+	}
+
 	updatedDevice, err := repository.UpdateDevice(req.Token, req.Platform, req.Name)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
@@ -89,3 +165,239 @@ func handleUpdateDevice(c *gin.Context) {
 
 	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*updatedDevice))
 }
+
+// managedDeviceParam loads the device in the :id path parameter if the
+// current user manages it.
+func managedDeviceParam(c *gin.Context) (*models.Device, bool) {
+	return managedDeviceFrom(c, c.Param("id"))
+}
+
+// managedDeviceFrom parses a device ID and loads the device if the current
+// user manages it.
+func managedDeviceFrom(c *gin.Context, idStr string) (*models.Device, bool) {
+	id, err := common.ParseID(idStr)
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device ID")
+		return nil, false
+	}
+	return managedDevice(c, id)
+}
+
+// managedDevice loads a device if the current user manages it.
+func managedDevice(c *gin.Context, id uint) (*models.Device, bool) {
+	device, err := repository.GetDeviceByID(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device not found")
+		return nil, false
+	}
+	if !canManageDevice(c, device) {
+		return nil, false
+	}
+	return device, true
+}
+
+func handleRevokeDevice(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+	userID, _ := common.GetUserID(c)
+
+	device, err := repository.RevokeDevice(device.ID, userID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*device))
+}
+
+func handleReinstateDevice(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+	userID, _ := common.GetUserID(c)
+
+	device, err := repository.ReinstateDevice(device.ID, userID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*device))
+}
+
+func handleBlockDevice(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+
+	var req pb.BlockDeviceRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || strings.TrimSpace(req.Reason) == "" {
+		common.RespondError(c, http.StatusBadRequest, "reason is required")
+		return
+	}
+	if len(req.Reason) > 255 {
+		common.RespondError(c, http.StatusBadRequest, "reason must be at most 255 characters")
+		return
+	}
+	userID, _ := common.GetUserID(c)
+
+	device, err := repository.BlockDevice(device.ID, userID, strings.TrimSpace(req.Reason))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*device))
+}
+
+func handleUnblockDevice(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+
+	// The reason is optional when lifting a block.
+	var req pb.BlockDeviceRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if len(req.Reason) > 255 {
+		common.RespondError(c, http.StatusBadRequest, "reason must be at most 255 characters")
+		return
+	}
+	userID, _ := common.GetUserID(c)
+
+	device, err := repository.UnblockDevice(device.ID, userID, strings.TrimSpace(req.Reason))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceToProto(*device))
+}
+
+func handleGetDeviceAudit(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+
+	logs, err := repository.GetDeviceAuditLogs(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, &pb.DeviceAuditList{Data: common.DeviceAuditLogsToProto(logs)})
+}
+
+// handleGetDuplicateDevices lists the hardware fingerprints that reported
+// more than one token, which usually means a cloned or shared install.
+func handleGetDuplicateDevices(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
+	groups, err := repository.GetDuplicateDevices(scope)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, &pb.DuplicateDeviceGroupList{Data: common.DuplicateDevicesToProto(groups)})
+}
+
+func handleGetOnlineDevices(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
+	page := 1
+	limit := 50
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 500 {
+			parsedLimit = 500
+		}
+		limit = parsedLimit
+	}
+
+	sessions, total, err := repository.GetOnlineDevices(scope, uint(page), uint(limit))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	common.RespondProto(c, http.StatusOK, &pb.DeviceSessionList{
+		Data:       common.DeviceSessionsToProto(sessions),
+		Page:       int32(page),
+		Limit:      int32(limit),
+		Total:      total,
+		TotalPages: int32(totalPages),
+	})
+}
+
+// handleGetDeviceSessions returns the uptime of a device between the "from"
+// and "to" Unix timestamps, the last seven days by default.
+func handleGetDeviceSessions(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+
+	to := time.Now().Unix()
+	if toParam := c.Query("to"); toParam != "" {
+		parsed, parseErr := strconv.ParseInt(toParam, 10, 64)
+		if parseErr != nil || parsed <= 0 {
+			common.RespondError(c, http.StatusBadRequest, "to must be a Unix timestamp")
+			return
+		}
+		to = parsed
+	}
+	from := to - int64(7*24*time.Hour/time.Second)
+	if fromParam := c.Query("from"); fromParam != "" {
+		parsed, parseErr := strconv.ParseInt(fromParam, 10, 64)
+		if parseErr != nil || parsed < 0 {
+			common.RespondError(c, http.StatusBadRequest, "from must be a Unix timestamp")
+			return
+		}
+		from = parsed
+	}
+	if from >= to {
+		common.RespondError(c, http.StatusBadRequest, "from must be before to")
+		return
+	}
+	if to-from > int64(92*24*time.Hour/time.Second) {
+		common.RespondError(c, http.StatusBadRequest, "the range cannot exceed 92 days")
+		return
+	}
+
+	uptime, err := repository.GetDeviceUptime(device.ID, from, to)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.DeviceUptimeToProto(*uptime))
+}
@@ -0,0 +1,108 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type EnrollmentController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *EnrollmentController) LoadRoutes() {
+	c.Group.GET("/enrollment-codes", common.AuthMiddleware(), handleGetEnrollmentCodes)
+	c.Group.POST("/enrollment-codes", common.AuthMiddleware(), handleCreateEnrollmentCode)
+	c.Group.DELETE("/enrollment-codes/:id", common.AuthMiddleware(), handleDeleteEnrollmentCode)
+}
+
+func handleGetEnrollmentCodes(c *gin.Context) {
+	userID, ok := common.GetUserID(c)
+	if !ok {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	codes, err := repository.GetEnrollmentCodes(userID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, &pb.EnrollmentCodeList{Data: common.EnrollmentCodesToProto(codes, serverURL(c))})
+}
+
+func handleCreateEnrollmentCode(c *gin.Context) {
+	userID, ok := common.GetUserID(c)
+	if !ok {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	var req pb.EnrollmentCodeRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	ttl := models.EnrollmentCodeTTL
+	if req.TtlMinutes != 0 {
+		ttl = time.Duration(req.TtlMinutes) * time.Minute
+		if ttl <= 0 || ttl > models.EnrollmentCodeMaxTTL {
+			common.RespondError(c, http.StatusBadRequest, "ttl_minutes must be between 1 and 1440")
+			return
+		}
+	}
+
+	code, err := repository.CreateEnrollmentCode(userID, ttl)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusCreated, common.EnrollmentCodeToProto(*code, serverURL(c)))
+}
+
+func handleDeleteEnrollmentCode(c *gin.Context) {
+	userID, ok := common.GetUserID(c)
+	if !ok {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid enrollment code ID")
+		return
+	}
+
+	deleted, err := repository.DeleteEnrollmentCode(userID, id)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !deleted {
+		common.RespondError(c, http.StatusNotFound, "enrollment code not found")
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "enrollment code deleted"})
+}
+
+// serverURL is the address the dashboard reached the API on, which is also
+// where devices redeem the codes.
+func serverURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
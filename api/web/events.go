@@ -8,8 +8,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
 
 type EventController struct {
@@ -18,6 +20,7 @@ type EventController struct {
 
 func (c *EventController) LoadRoutes() {
 	c.Group.GET("/events", common.AuthMiddleware(), handleGetEvents)
+	c.Group.DELETE("/events/:id", common.AuthMiddleware(), handleDeleteEvent)
 }
 
 func handleGetEvents(c *gin.Context) {
@@ -28,6 +31,11 @@ func handleGetEvents(c *gin.Context) {
 	}
 	date := c.Query("date")
 	sport := c.Query("sport")
+	loc, err := timezone.Resolve(c.Query("tz"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "tz must be a valid IANA time zone")
+		return
+	}
 	page := 1
 	limit := 10
 
@@ -54,11 +62,10 @@ func handleGetEvents(c *gin.Context) {
 
 	query := db.Model(&models.SofaScoreEvent{})
 	if date != "" {
-		t, err := time.Parse("2006-01-02", date)
+		t, err := timezone.ParseDay(date, loc)
 		if err == nil {
-			start := t.Unix()
-			end := t.Add(24 * time.Hour).Unix()
-			query = query.Where("start_timestamp >= ? AND start_timestamp < ?", start, end)
+			start, end := timezone.DayBounds(t, loc)
+			query = query.Where("start_timestamp >= ? AND start_timestamp < ?", start.Unix(), end.Unix())
 		}
 	} else {
 		start := time.Now().Unix()
@@ -90,3 +97,20 @@ func handleGetEvents(c *gin.Context) {
 		TotalPages: int32(totalPages),
 	})
 }
+
+func handleDeleteEvent(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := repository.DeleteEvent(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "event deleted"})
+}
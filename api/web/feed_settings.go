@@ -0,0 +1,142 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type FeedSettingsController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *FeedSettingsController) LoadRoutes() {
+	c.Group.GET("/feed-settings", common.AuthMiddleware(), handleGetFeedSettings)
+	c.Group.PUT("/feed-settings/devices/:deviceId", common.AuthMiddleware(), handleSetDeviceFeedSettings)
+	c.Group.PUT("/feed-settings/packages/:packageName", common.AuthMiddleware(), handleSetPackageFeedSettings)
+	c.Group.DELETE("/feed-settings/:id", common.AuthMiddleware(), handleDeleteFeedSettings)
+}
+
+func handleGetFeedSettings(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+	settings, err := repository.GetAllFeedSettings(scope)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.FeedSettingsList{Settings: common.FeedSettingsListToProto(settings)})
+}
+
+func handleSetDeviceFeedSettings(c *gin.Context) {
+	device, ok := managedDeviceFrom(c, c.Param("deviceId"))
+	if !ok {
+		return
+	}
+	saveFeedSettings(c, &device.ID, "")
+}
+
+// handleSetPackageFeedSettings changes the feed of every device of a package,
+// so only admins may use it.
+func handleSetPackageFeedSettings(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	packageName := strings.TrimSpace(c.Param("packageName"))
+	if packageName == "" {
+		common.RespondError(c, http.StatusBadRequest, "invalid package name")
+		return
+	}
+	saveFeedSettings(c, nil, packageName)
+}
+
+func saveFeedSettings(c *gin.Context, deviceID *uint, packageName string) {
+	var req pb.FeedSettingsRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	values, msg := feedSettingsFromRequest(&req)
+	if msg != "" {
+		common.RespondError(c, http.StatusBadRequest, msg)
+		return
+	}
+
+	settings, err := repository.SaveFeedSettings(deviceID, packageName, values)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.FeedSettingsToProto(*settings))
+}
+
+func handleDeleteFeedSettings(c *gin.Context) {
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	settings, err := repository.GetFeedSettingsByID(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "feed settings not found")
+		return
+	}
+	if settings.DeviceID == nil {
+		if !requireAdmin(c) {
+			return
+		}
+	} else if _, ok := managedDevice(c, *settings.DeviceID); !ok {
+		return
+	}
+
+	if err := repository.DeleteFeedSettings(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "feed settings deleted"})
+}
+
+// feedSettingsFromRequest validates req. It returns a client facing message
+// when the request is invalid.
+func feedSettingsFromRequest(req *pb.FeedSettingsRequest) (models.FeedSettings, string) {
+	settings := models.FeedSettings{
+		MaxItems:       int(req.MaxItems),
+		LookAheadHours: int(req.LookAheadHours),
+		RecentHours:    int(req.RecentHours),
+		Ordering:       req.Ordering,
+	}
+	if settings.MaxItems < 1 || settings.MaxItems > 50 {
+		return settings, "max_items must be between 1 and 50"
+	}
+	if settings.LookAheadHours < 1 || settings.LookAheadHours > 30*24 {
+		return settings, "look_ahead_hours must be between 1 and 720"
+	}
+	if settings.RecentHours < 0 || settings.RecentHours > 7*24 {
+		return settings, "recent_hours must be between 0 and 168"
+	}
+	switch settings.Ordering {
+	case "":
+		settings.Ordering = models.FeedOrderLiveFirst
+	case models.FeedOrderLiveFirst, models.FeedOrderChronological:
+	default:
+		return settings, "ordering must be live_first or chronological"
+	}
+
+	sports := make([]string, 0, len(req.Sports))
+	for _, sport := range req.Sports {
+		if sport = strings.ToLower(strings.TrimSpace(sport)); sport != "" {
+			sports = append(sports, sport)
+		}
+	}
+	settings.Sports = strings.Join(sports, ",")
+	return settings, ""
+}
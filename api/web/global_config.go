@@ -29,6 +29,9 @@ func handleGetGlobalConfig(c *gin.Context) {
 }
 
 func handleAddGlobalConfig(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
 	var req pb.SetTournamentIdsRequest
 	if err := common.ParseProtoBody(c, &req); err != nil {
 		common.RespondError(c, http.StatusBadRequest, "invalid request")
@@ -49,6 +52,9 @@ func handleAddGlobalConfig(c *gin.Context) {
 }
 
 func handleRemoveGlobalConfig(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
 	tournamentID, err := common.ParseID(c.Param("tournamentId"))
 	if err != nil {
 		common.RespondError(c, http.StatusBadRequest, "invalid tournament id")
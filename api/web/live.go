@@ -0,0 +1,324 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	liveWriteTimeout = 10 * time.Second
+	livePongTimeout  = 60 * time.Second
+	livePingInterval = 25 * time.Second
+	// liveScopeRefresh is how often a manager's socket reloads the devices
+	// it may follow, so devices enrolled meanwhile show up.
+	liveScopeRefresh = 30 * time.Second
+)
+
+var errUnsupportedPayload = errors.New("unsupported live payload")
+
+var liveTopics = []string{
+	broker.TopicEventChanged,
+	broker.TopicDeviceStatus,
+	broker.TopicPlaybackStarted,
+	broker.TopicCrashReported,
+	broker.TopicJobFinished,
+	broker.TopicSubscriptionExpiring,
+	broker.TopicDeviceCommand,
+}
+
+var liveUpgrader = websocket.Upgrader{
+	CheckOrigin: checkLiveOrigin,
+}
+
+// checkLiveOrigin accepts requests without an Origin header (non-browser
+// clients), same-origin requests and the origins listed in the comma
+// separated DASHBOARD_ORIGINS environment variable. The token may travel in
+// the query string, so any other site must not be able to open the socket.
+func checkLiveOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(parsed.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range strings.Split(os.Getenv("DASHBOARD_ORIGINS"), ",") {
+		if allowed = strings.TrimSpace(allowed); allowed != "" && strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// liveMessage is the envelope sent to the dashboard for every notification.
+// Data holds the protojson encoding of the topic's protobuf message.
+type liveMessage struct {
+	Topic string          `json:"topic"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// liveCommand lets the dashboard change its topic subscription.
+type liveCommand struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics"`
+}
+
+type LiveController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *LiveController) LoadRoutes() {
+	c.Group.GET("/live", handleLiveSocket)
+}
+
+// handleLiveSocket upgrades to a WebSocket that multiplexes live dashboard
+// topics. Browsers cannot set headers on WebSocket requests, so the access
+// token may also be passed in the "token" query parameter. Clients receive
+// every topic unless they narrow it with "topics" (comma separated) or send
+// subscribe/unsubscribe commands. Managers only receive the device topics
+// of their own devices. The socket is closed when the access token expires;
+// clients reconnect with a refreshed one.
+func handleLiveSocket(c *gin.Context) {
+	tokenStr, ok := common.ExtractBearerToken(c)
+	if !ok {
+		tokenStr = c.Query("token")
+	}
+	claims, err := common.ParseAccessToken(tokenStr)
+	if err != nil || claims.ExpiresAt == nil {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		common.RespondError(c, http.StatusUnauthorized, "invalid token")
+		return
+	}
+	user, err := repository.GetUserByID(userID)
+	if err != nil {
+		common.RespondError(c, http.StatusUnauthorized, "user not found")
+		return
+	}
+	var scope *liveDeviceScope
+	if !user.IsAdmin() {
+		scope = &liveDeviceScope{managerID: user.ID}
+	}
+
+	conn, err := liveUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("live: websocket upgrade failed: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	filter := newTopicFilter(c.Query("topics"))
+	messages, unsubscribe := broker.Subscribe(128, liveTopics...)
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	go readLiveCommands(conn, filter, done)
+
+	ping := time.NewTicker(livePingInterval)
+	defer ping.Stop()
+	expiry := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+	defer expiry.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-expiry.C:
+			closeMsg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "token expired")
+			_ = conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(liveWriteTimeout))
+			return
+		case <-ping.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			if !filter.allows(msg.Topic) || !scope.allows(msg) {
+				continue
+			}
+			payload, err := encodeLiveMessage(msg)
+			if err != nil {
+				continue
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// liveDeviceScope limits a manager's socket to the devices paired to them.
+// It is only used by the goroutine writing to the socket.
+type liveDeviceScope struct {
+	managerID uint
+	devices   map[uint]struct{}
+	loadedAt  time.Time
+}
+
+// allows reports whether msg concerns a device of the manager. Crash reports
+// do not say which device sent them, so managers do not get them. A nil
+// scope allows everything.
+func (s *liveDeviceScope) allows(msg broker.Message) bool {
+	if s == nil {
+		return true
+	}
+	switch payload := msg.Payload.(type) {
+	case models.DeviceStatusChange:
+		return s.hasDevice(payload.DeviceID)
+	case *models.PlaybackLog:
+		return s.hasDevice(payload.DeviceID)
+	case models.DeviceSubscription:
+		return s.hasDevice(payload.DeviceID)
+	case models.DeviceCommand:
+		return s.hasDevice(payload.DeviceID)
+	case models.CrashReport:
+		return false
+	}
+	return true
+}
+
+func (s *liveDeviceScope) hasDevice(id uint) bool {
+	if time.Since(s.loadedAt) > liveScopeRefresh {
+		// On a failure the previous devices are kept until the next refresh.
+		if ids, err := repository.GetPairedDeviceIDs(s.managerID); err != nil {
+			log.Printf("live: failed to load the devices of manager %d: %v", s.managerID, err)
+		} else {
+			s.devices = make(map[uint]struct{}, len(ids))
+			for _, deviceID := range ids {
+				s.devices[deviceID] = struct{}{}
+			}
+		}
+		s.loadedAt = time.Now()
+	}
+	_, ok := s.devices[id]
+	return ok
+}
+
+func readLiveCommands(conn *websocket.Conn, filter *topicFilter, done chan<- struct{}) {
+	defer close(done)
+	_ = conn.SetReadDeadline(time.Now().Add(livePongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(livePongTimeout))
+	})
+
+	for {
+		var cmd liveCommand
+		if err := conn.ReadJSON(&cmd); err != nil {
+			if _, isJSONErr := err.(*json.SyntaxError); isJSONErr {
+				continue
+			}
+			return
+		}
+		switch cmd.Action {
+		case "subscribe":
+			filter.add(cmd.Topics)
+		case "unsubscribe":
+			filter.remove(cmd.Topics)
+		}
+	}
+}
+
+func encodeLiveMessage(msg broker.Message) ([]byte, error) {
+	var data proto.Message
+	switch payload := msg.Payload.(type) {
+	case models.EventChange:
+		data = common.EventChangeToDelta(payload)
+	case models.DeviceStatusChange:
+		data = common.DeviceStatusChangeToProto(payload)
+	case *models.PlaybackLog:
+		data = common.PlaybackToProto(payload)
+	case models.CrashReport:
+		data = common.CrashReportToProto(payload)
+	case models.JobRun:
+		data = common.JobRunToProto(payload)
+	case models.DeviceSubscription:
+		data = common.DeviceSubscriptionToProto(payload)
+	case models.DeviceCommand:
+		data = common.DeviceCommandToProto(payload)
+	default:
+		return nil, errUnsupportedPayload
+	}
+
+	encoded, err := protojson.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(liveMessage{Topic: msg.Topic, Data: encoded})
+}
+
+// topicFilter is the set of topics a dashboard connection wants; nil topics
+// means every topic.
+type topicFilter struct {
+	mu     sync.RWMutex
+	topics map[string]struct{}
+}
+
+func newTopicFilter(csv string) *topicFilter {
+	f := &topicFilter{}
+	if csv != "" {
+		f.add(strings.Split(csv, ","))
+	}
+	return f
+}
+
+func (f *topicFilter) allows(topic string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.topics == nil {
+		return true
+	}
+	_, ok := f.topics[topic]
+	return ok
+}
+
+func (f *topicFilter) add(topics []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.topics == nil {
+		f.topics = make(map[string]struct{})
+	}
+	for _, topic := range topics {
+		f.topics[strings.TrimSpace(topic)] = struct{}{}
+	}
+}
+
+func (f *topicFilter) remove(topics []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.topics == nil {
+		f.topics = make(map[string]struct{}, len(liveTopics))
+		for _, topic := range liveTopics {
+			f.topics[topic] = struct{}{}
+		}
+	}
+	for _, topic := range topics {
+		delete(f.topics, strings.TrimSpace(topic))
+	}
+}
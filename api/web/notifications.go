@@ -0,0 +1,105 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type NotificationController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *NotificationController) LoadRoutes() {
+	c.Group.GET("/notifications/log", common.AuthMiddleware(), handleGetNotificationLog)
+	c.Group.GET("/notifications/templates", common.AuthMiddleware(), handleGetNotificationTemplates)
+	c.Group.PUT("/notifications/templates/:kind", common.AuthMiddleware(), handleUpdateNotificationTemplate)
+}
+
+func handleGetNotificationLog(c *gin.Context) {
+	page := 1
+	limit := 20
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 100 {
+			parsedLimit = 100
+		}
+		limit = parsedLimit
+	}
+
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+	var deviceID uint
+	if deviceParam := c.Query("device_id"); deviceParam != "" {
+		id, err := common.ParseID(deviceParam)
+		if err != nil {
+			common.RespondError(c, http.StatusBadRequest, "invalid device_id")
+			return
+		}
+		if _, ok := managedDevice(c, id); !ok {
+			return
+		}
+		deviceID = id
+	}
+
+	entries, total, err := repository.GetNotificationLogs(scope, deviceID, c.Query("status"), page, limit)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.NotificationLogList{Entries: common.NotificationLogsToProto(entries), Total: total})
+}
+
+func handleGetNotificationTemplates(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	templates, err := repository.GetNotificationTemplates()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.NotificationTemplateList{Templates: common.NotificationTemplatesToProto(templates)})
+}
+
+func handleUpdateNotificationTemplate(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	var req pb.NotificationTemplateRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+	if strings.TrimSpace(req.Title) == "" {
+		common.RespondError(c, http.StatusBadRequest, "title is required")
+		return
+	}
+
+	template, err := repository.UpdateNotificationTemplate(c.Param("kind"), req.Title, req.Body)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "template not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.NotificationTemplateToProto(*template))
+}
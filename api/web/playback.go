@@ -18,6 +18,11 @@ func (c *PlaybackController) LoadRoutes() {
 }
 
 func handleGetLogPlayback(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
 	page := 1
 	limit := 10
 
@@ -42,13 +47,13 @@ func handleGetLogPlayback(c *gin.Context) {
 		limit = parsedLimit
 	}
 
-	stats, err := repository.GetList(page, limit)
+	stats, err := repository.GetList(scope, page, limit)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	total := repository.TotalCount()
+	total := repository.TotalCount(scope)
 
 	common.RespondProto(c, http.StatusOK, common.PlaybackListToProto(stats, total))
 }
@@ -0,0 +1,270 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type RemoteConfigController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *RemoteConfigController) LoadRoutes() {
+	c.Group.GET("/config/keys", common.AuthMiddleware(), handleGetConfigKeys)
+	c.Group.POST("/config/keys", common.AuthMiddleware(), handleCreateConfigKey)
+	c.Group.PUT("/config/keys/:id", common.AuthMiddleware(), handleUpdateConfigKey)
+	c.Group.DELETE("/config/keys/:id", common.AuthMiddleware(), handleDeleteConfigKey)
+	c.Group.GET("/config/values", common.AuthMiddleware(), handleGetConfigValues)
+	c.Group.PUT("/config/values", common.AuthMiddleware(), handleSetConfigValue)
+	c.Group.DELETE("/config/values/:id", common.AuthMiddleware(), handleDeleteConfigValue)
+	c.Group.GET("/config/devices/:id", common.AuthMiddleware(), handleGetDeviceConfig)
+}
+
+func handleGetConfigKeys(c *gin.Context) {
+	keys, err := repository.GetConfigKeys()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.ConfigKeyList{Data: common.ConfigKeysToProto(keys)})
+}
+
+func parseConfigKeyRequest(c *gin.Context) (models.ConfigKey, bool) {
+	var req pb.ConfigKeyRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return models.ConfigKey{}, false
+	}
+	if err := models.ValidateConfigValue(req.Type, req.DefaultValue); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "default_value: "+err.Error())
+		return models.ConfigKey{}, false
+	}
+	if len(req.Description) > 255 {
+		common.RespondError(c, http.StatusBadRequest, "description must be at most 255 characters")
+		return models.ConfigKey{}, false
+	}
+	return models.ConfigKey{
+		Key:          strings.TrimSpace(req.Key),
+		Type:         req.Type,
+		DefaultValue: req.DefaultValue,
+		Description:  req.Description,
+	}, true
+}
+
+func handleCreateConfigKey(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	key, ok := parseConfigKeyRequest(c)
+	if !ok {
+		return
+	}
+	if !models.ValidConfigKey(key.Key) {
+		common.RespondError(c, http.StatusBadRequest, "key must be lowercase letters, digits, dots or underscores")
+		return
+	}
+	if err := repository.CreateConfigKey(&key); err != nil {
+		common.RespondError(c, http.StatusConflict, "could not create config key")
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.ConfigKeyToProto(key))
+}
+
+func handleUpdateConfigKey(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid config key ID")
+		return
+	}
+	values, ok := parseConfigKeyRequest(c)
+	if !ok {
+		return
+	}
+
+	// A type change must not leave overrides that the app cannot parse.
+	overrides, err := repository.GetConfigValues(id, "")
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for _, override := range overrides {
+		if models.ValidateConfigValue(values.Type, override.Value) != nil {
+			common.RespondError(c, http.StatusConflict, "the "+override.Scope+" value "+strconv.Quote(override.Value)+" is not a valid "+values.Type)
+			return
+		}
+	}
+
+	key, err := repository.UpdateConfigKey(id, values)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "config key not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.ConfigKeyToProto(*key))
+}
+
+func handleDeleteConfigKey(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid config key ID")
+		return
+	}
+	deleted, err := repository.DeleteConfigKey(id)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !deleted {
+		common.RespondError(c, http.StatusNotFound, "config key not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "config key deleted"})
+}
+
+func handleGetConfigValues(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+
+	var keyID uint
+	if name := c.Query("key"); name != "" {
+		key, err := repository.GetConfigKeyByName(name)
+		if err != nil {
+			common.RespondError(c, http.StatusNotFound, "config key not found")
+			return
+		}
+		keyID = key.ID
+	}
+	scope := c.Query("scope")
+	if scope != "" && !models.ValidConfigScope(scope) {
+		common.RespondError(c, http.StatusBadRequest, "scope must be global, package, group or device")
+		return
+	}
+
+	values, err := repository.GetConfigValues(keyID, scope)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.ConfigValueList{Data: common.ConfigValuesToProto(values)})
+}
+
+// authorizeConfigScope checks that the scope target exists and that the
+// current user may change it: managers can only override their own devices.
+func authorizeConfigScope(c *gin.Context, scope, scopeID string) bool {
+	switch scope {
+	case models.ConfigScopeGlobal:
+		if scopeID != "" {
+			common.RespondError(c, http.StatusBadRequest, "scope_id must be empty for the global scope")
+			return false
+		}
+	case models.ConfigScopePackage:
+		if scopeID == "" || len(scopeID) > 191 {
+			common.RespondError(c, http.StatusBadRequest, "scope_id must be a package name")
+			return false
+		}
+	case models.ConfigScopeGroup:
+		id, err := common.ParseID(scopeID)
+		if err != nil {
+			common.RespondError(c, http.StatusBadRequest, "scope_id must be a group ID")
+			return false
+		}
+		if _, err := repository.GetDeviceGroup(id); err != nil {
+			common.RespondError(c, http.StatusNotFound, "device group not found")
+			return false
+		}
+	case models.ConfigScopeDevice:
+		id, err := common.ParseID(scopeID)
+		if err != nil {
+			common.RespondError(c, http.StatusBadRequest, "scope_id must be a device ID")
+			return false
+		}
+		device, err := repository.GetDeviceByID(id)
+		if err != nil {
+			common.RespondError(c, http.StatusNotFound, "device not found")
+			return false
+		}
+		return canManageDevice(c, device)
+	default:
+		common.RespondError(c, http.StatusBadRequest, "scope must be global, package, group or device")
+		return false
+	}
+	return requireAdmin(c)
+}
+
+func handleSetConfigValue(c *gin.Context) {
+	var req pb.ConfigValueRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.Key == "" {
+		common.RespondError(c, http.StatusBadRequest, "key is required")
+		return
+	}
+	scopeID := strings.TrimSpace(req.ScopeId)
+	if !authorizeConfigScope(c, req.Scope, scopeID) {
+		return
+	}
+
+	key, err := repository.GetConfigKeyByName(req.Key)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "config key not found")
+		return
+	}
+	if err := models.ValidateConfigValue(key.Type, req.Value); err != nil {
+		common.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	value, err := repository.SetConfigValue(key, req.Scope, scopeID, req.Value)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.ConfigValueToProto(*value))
+}
+
+func handleDeleteConfigValue(c *gin.Context) {
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid config value ID")
+		return
+	}
+	value, err := repository.GetConfigValue(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "config value not found")
+		return
+	}
+	if !authorizeConfigScope(c, value.Scope, value.ScopeID) {
+		return
+	}
+
+	if _, err := repository.DeleteConfigValue(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "config value deleted"})
+}
+
+// handleGetDeviceConfig previews the bundle a device would receive.
+func handleGetDeviceConfig(c *gin.Context) {
+	device, ok := managedDeviceParam(c)
+	if !ok {
+		return
+	}
+	bundle, err := repository.GetDeviceConfig(*device)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.ConfigBundleToProto(*bundle))
+}
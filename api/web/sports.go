@@ -0,0 +1,133 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/httpcli"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+var sportSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type SportController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *SportController) LoadRoutes() {
+	c.Group.GET("/sports", common.AuthMiddleware(), handleGetSports)
+	c.Group.POST("/sports", common.AuthMiddleware(), handleCreateSport)
+	c.Group.POST("/sports/discover", common.AuthMiddleware(), handleDiscoverSports)
+	c.Group.PUT("/sports/:id", common.AuthMiddleware(), handleUpdateSport)
+	c.Group.DELETE("/sports/:id", common.AuthMiddleware(), handleDeleteSport)
+}
+
+func handleGetSports(c *gin.Context) {
+	sports, err := repository.GetAllSports()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.SportList{Sports: common.SportsToProto(sports)})
+}
+
+func handleCreateSport(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	var req pb.SportRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || !sportSlugPattern.MatchString(req.Slug) {
+		common.RespondError(c, http.StatusBadRequest, "a valid slug is required")
+		return
+	}
+
+	name := req.Name
+	if name == "" {
+		name = repository.SportNameFromSlug(req.Slug)
+	}
+
+	sport, err := repository.CreateSport(req.Slug, name, req.Enabled)
+	if err != nil {
+		common.RespondError(c, http.StatusConflict, "could not create sport")
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.SportToProto(*sport))
+}
+
+func handleUpdateSport(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req pb.SportRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || !sportSlugPattern.MatchString(req.Slug) {
+		common.RespondError(c, http.StatusBadRequest, "a valid slug is required")
+		return
+	}
+
+	sport, err := repository.UpdateSport(id, req.Slug, req.Name, req.Enabled)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.SportToProto(*sport))
+}
+
+func handleDeleteSport(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := repository.DeleteSport(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "sport deleted"})
+}
+
+func handleDiscoverSports(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	body := httpcli.LoadSportList()
+	if body == nil {
+		common.RespondError(c, http.StatusBadGateway, "could not load the upstream sport list")
+		return
+	}
+
+	var counters map[string]json.RawMessage
+	if err := json.Unmarshal(body, &counters); err != nil {
+		common.RespondError(c, http.StatusBadGateway, "unexpected upstream sport list")
+		return
+	}
+
+	slugs := make([]string, 0, len(counters))
+	for slug := range counters {
+		if sportSlugPattern.MatchString(slug) {
+			slugs = append(slugs, slug)
+		}
+	}
+	sort.Strings(slugs)
+
+	created, err := repository.DiscoverSports(slugs)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.SportList{Sports: common.SportsToProto(created)})
+}
@@ -0,0 +1,278 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type SubscriptionController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *SubscriptionController) LoadRoutes() {
+	c.Group.GET("/plans", common.AuthMiddleware(), handleGetPlans)
+	c.Group.POST("/plans", common.AuthMiddleware(), handleCreatePlan)
+	c.Group.PUT("/plans/:id", common.AuthMiddleware(), handleUpdatePlan)
+	c.Group.DELETE("/plans/:id", common.AuthMiddleware(), handleDeletePlan)
+	c.Group.GET("/subscriptions", common.AuthMiddleware(), handleGetSubscriptions)
+	c.Group.GET("/subscriptions/devices/:deviceId", common.AuthMiddleware(), handleGetDeviceSubscription)
+	c.Group.POST("/subscriptions/devices/:deviceId/renew", common.AuthMiddleware(), handleRenewSubscription)
+	c.Group.POST("/subscriptions/devices/:deviceId/extend", common.AuthMiddleware(), handleExtendSubscription)
+}
+
+func handleGetPlans(c *gin.Context) {
+	plans, err := repository.GetPlans()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.PlanList{Data: common.PlansToProto(plans)})
+}
+
+func parsePlanRequest(c *gin.Context) (models.Plan, bool) {
+	var req pb.PlanRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return models.Plan{}, false
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		common.RespondError(c, http.StatusBadRequest, "name is required")
+		return models.Plan{}, false
+	}
+	if req.DurationDays < 1 {
+		common.RespondError(c, http.StatusBadRequest, "duration_days must be a positive integer")
+		return models.Plan{}, false
+	}
+	if req.MaxTournaments < 0 {
+		common.RespondError(c, http.StatusBadRequest, "max_tournaments cannot be negative")
+		return models.Plan{}, false
+	}
+	return models.Plan{
+		Name:           name,
+		DurationDays:   int(req.DurationDays),
+		MaxTournaments: int(req.MaxTournaments),
+		LiveUpdates:    req.LiveUpdates,
+	}, true
+}
+
+func handleCreatePlan(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	plan, ok := parsePlanRequest(c)
+	if !ok {
+		return
+	}
+	if err := repository.CreatePlan(&plan); err != nil {
+		common.RespondError(c, http.StatusConflict, "could not create plan")
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.PlanToProto(plan))
+}
+
+func handleUpdatePlan(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid plan ID")
+		return
+	}
+	values, ok := parsePlanRequest(c)
+	if !ok {
+		return
+	}
+	plan, err := repository.UpdatePlan(id, values)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "plan not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.PlanToProto(*plan))
+}
+
+func handleDeletePlan(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid plan ID")
+		return
+	}
+	inUse, err := repository.PlanInUse(id)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if inUse {
+		common.RespondError(c, http.StatusConflict, "plan has subscriptions")
+		return
+	}
+	deleted, err := repository.DeletePlan(id)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !deleted {
+		common.RespondError(c, http.StatusNotFound, "plan not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "plan deleted"})
+}
+
+func handleGetSubscriptions(c *gin.Context) {
+	scope, ok := managerScope(c)
+	if !ok {
+		return
+	}
+
+	page := 1
+	limit := 10
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 100 {
+			parsedLimit = 100
+		}
+		limit = parsedLimit
+	}
+
+	var expiringWithin time.Duration
+	if daysParam := c.Query("expiringDays"); daysParam != "" {
+		days, parseErr := strconv.Atoi(daysParam)
+		if parseErr != nil || days < 1 {
+			common.RespondError(c, http.StatusBadRequest, "expiringDays must be a positive integer")
+			return
+		}
+		expiringWithin = time.Duration(days) * 24 * time.Hour
+	}
+
+	subscriptions, total, err := repository.GetDeviceSubscriptions(scope, expiringWithin, uint(page), uint(limit))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	common.RespondProto(c, http.StatusOK, &pb.DeviceSubscriptionList{
+		Data:       common.DeviceSubscriptionsToProto(subscriptions),
+		Page:       int32(page),
+		Limit:      int32(limit),
+		Total:      total,
+		TotalPages: int32(totalPages),
+	})
+}
+
+// subscriptionDevice loads the device in the path if the current user
+// manages it.
+func subscriptionDevice(c *gin.Context) (*models.Device, bool) {
+	deviceID, err := common.ParseID(c.Param("deviceId"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid device ID")
+		return nil, false
+	}
+	device, err := repository.GetDeviceByID(deviceID)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "device not found")
+		return nil, false
+	}
+	if !canManageDevice(c, device) {
+		return nil, false
+	}
+	return device, true
+}
+
+func handleGetDeviceSubscription(c *gin.Context) {
+	device, ok := subscriptionDevice(c)
+	if !ok {
+		return
+	}
+	subscription, err := repository.GetDeviceSubscription(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if subscription == nil {
+		common.RespondError(c, http.StatusNotFound, "device has no subscription")
+		return
+	}
+	subscription.Device = device
+	common.RespondProto(c, http.StatusOK, common.DeviceSubscriptionToProto(*subscription))
+}
+
+func handleRenewSubscription(c *gin.Context) {
+	device, ok := subscriptionDevice(c)
+	if !ok {
+		return
+	}
+
+	var req pb.SubscriptionRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.PlanId == 0 {
+		common.RespondError(c, http.StatusBadRequest, "plan_id is required")
+		return
+	}
+	plan, err := repository.GetPlan(uint(req.PlanId))
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "plan not found")
+		return
+	}
+
+	subscription, err := repository.RenewDeviceSubscription(device.ID, plan)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.DeviceSubscriptionToProto(*subscription))
+}
+
+func handleExtendSubscription(c *gin.Context) {
+	device, ok := subscriptionDevice(c)
+	if !ok {
+		return
+	}
+
+	var req pb.SubscriptionRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.Days < 1 {
+		common.RespondError(c, http.StatusBadRequest, "days must be a positive integer")
+		return
+	}
+
+	current, err := repository.GetDeviceSubscription(device.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if current == nil {
+		common.RespondError(c, http.StatusNotFound, "device has no subscription")
+		return
+	}
+
+	subscription, err := repository.ExtendDeviceSubscription(current, int(req.Days))
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.DeviceSubscriptionToProto(*subscription))
+}
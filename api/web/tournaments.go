@@ -46,6 +46,9 @@ func handleGetTournament(c *gin.Context) {
 }
 
 func handleCreateTournament(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
 	var req pb.TournamentRequest
 	if err := common.ParseProtoBody(c, &req); err != nil || req.Name == "" {
 		common.RespondError(c, http.StatusBadRequest, "name is required")
@@ -61,6 +64,9 @@ func handleCreateTournament(c *gin.Context) {
 }
 
 func handleUpdateTournament(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
 	id, err := common.ParseID(c.Param("id"))
 	if err != nil {
 		common.RespondError(c, http.StatusBadRequest, "invalid id")
@@ -82,6 +88,9 @@ func handleUpdateTournament(c *gin.Context) {
 }
 
 func handleDeleteTournament(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
 	id, err := common.ParseID(c.Param("id"))
 	if err != nil {
 		common.RespondError(c, http.StatusBadRequest, "invalid id")
@@ -0,0 +1,119 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/locale"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+type TranslationController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *TranslationController) LoadRoutes() {
+	c.Group.GET("/translations", common.AuthMiddleware(), handleGetTranslations)
+	c.Group.PUT("/translations", common.AuthMiddleware(), handleSaveTranslation)
+	c.Group.DELETE("/translations/:id", common.AuthMiddleware(), handleDeleteTranslation)
+}
+
+func handleGetTranslations(c *gin.Context) {
+	page := 1
+	limit := 50
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 200 {
+			parsedLimit = 200
+		}
+		limit = parsedLimit
+	}
+
+	loc := c.Query("locale")
+	if loc != "" {
+		loc = locale.Normalize(loc)
+	}
+
+	translations, total, err := repository.GetTranslations(c.Query("entity_type"), c.Query("entity_key"), loc, page, limit)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.TranslationList{Translations: common.TranslationsToProto(translations), Total: total})
+}
+
+// handleSaveTranslation creates or overrides a translation. Admin
+// translations take precedence over the scraped ones.
+func handleSaveTranslation(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	var req pb.TranslationRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	switch req.EntityType {
+	case models.TranslationTeam, models.TranslationTournament, models.TranslationStatus:
+	default:
+		common.RespondError(c, http.StatusBadRequest, "entity_type must be team, tournament or status")
+		return
+	}
+	if _, err := strconv.ParseInt(req.EntityKey, 10, 64); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "entity_key must be a numeric ID or status code")
+		return
+	}
+	loc := locale.Normalize(req.Locale)
+	if loc == "" {
+		common.RespondError(c, http.StatusBadRequest, "invalid locale")
+		return
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		common.RespondError(c, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	translation, err := repository.SaveAdminTranslation(req.EntityType, req.EntityKey, loc, name)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.TranslationToProto(*translation))
+}
+
+func handleDeleteTranslation(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := repository.DeleteTranslation(id); err != nil {
+		common.RespondError(c, http.StatusNotFound, "translation not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "translation deleted"})
+}
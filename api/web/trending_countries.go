@@ -0,0 +1,93 @@
+package web
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+type TrendingCountryController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *TrendingCountryController) LoadRoutes() {
+	c.Group.GET("/trending-countries", common.AuthMiddleware(), handleGetTrendingCountries)
+	c.Group.POST("/trending-countries", common.AuthMiddleware(), handleCreateTrendingCountry)
+	c.Group.PUT("/trending-countries/:id", common.AuthMiddleware(), handleUpdateTrendingCountry)
+	c.Group.DELETE("/trending-countries/:id", common.AuthMiddleware(), handleDeleteTrendingCountry)
+}
+
+func handleGetTrendingCountries(c *gin.Context) {
+	countries, err := repository.GetAllTrendingCountries()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.TrendingCountryList{Countries: common.TrendingCountriesToProto(countries)})
+}
+
+func handleCreateTrendingCountry(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	var req pb.TrendingCountryRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || !countryCodePattern.MatchString(strings.ToUpper(req.Code)) {
+		common.RespondError(c, http.StatusBadRequest, "a two-letter country code is required")
+		return
+	}
+
+	country, err := repository.CreateTrendingCountry(req.Code, req.Name, req.Enabled)
+	if err != nil {
+		common.RespondError(c, http.StatusConflict, "could not create trending country")
+		return
+	}
+	common.RespondProto(c, http.StatusCreated, common.TrendingCountryToProto(*country))
+}
+
+func handleUpdateTrendingCountry(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req pb.TrendingCountryRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || !countryCodePattern.MatchString(strings.ToUpper(req.Code)) {
+		common.RespondError(c, http.StatusBadRequest, "a two-letter country code is required")
+		return
+	}
+
+	country, err := repository.UpdateTrendingCountry(id, req.Code, req.Name, req.Enabled)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.TrendingCountryToProto(*country))
+}
+
+func handleDeleteTrendingCountry(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := repository.DeleteTrendingCountry(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "trending country deleted"})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
@@ -18,6 +19,8 @@ func (c *UserController) LoadRoutes() {
 	c.Group.POST("/users/login", handleLogin)
 	c.Group.POST("/users/refresh", handleRefresh)
 	c.Group.POST("/users/logout", common.AuthMiddleware(), handleLogout)
+	c.Group.GET("/users", common.AuthMiddleware(), handleGetUsers)
+	c.Group.PUT("/users/:id/quota", common.AuthMiddleware(), handleSetUserQuota)
 }
 
 func handleRegister(c *gin.Context) {
@@ -31,7 +34,7 @@ func handleRegister(c *gin.Context) {
 		common.RespondError(c, http.StatusConflict, "could not create user")
 		return
 	}
-	response, err := buildAuthResponse(user.ID, user.Email)
+	response, err := buildAuthResponse(user)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, "token generation failed")
 		return
@@ -50,7 +53,7 @@ func handleLogin(c *gin.Context) {
 		common.RespondError(c, http.StatusUnauthorized, "invalid credentials")
 		return
 	}
-	response, err := buildAuthResponse(user.ID, user.Email)
+	response, err := buildAuthResponse(user)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, "token generation failed")
 		return
@@ -94,7 +97,7 @@ func handleRefresh(c *gin.Context) {
 		return
 	}
 
-	response, err := buildAuthResponse(user.ID, user.Email)
+	response, err := buildAuthResponse(user)
 	if err != nil {
 		common.RespondError(c, http.StatusInternalServerError, "token generation failed")
 		return
@@ -134,20 +137,71 @@ func handleLogout(c *gin.Context) {
 	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "logout successful"})
 }
 
-func buildAuthResponse(userID uint, email string) (*pb.AuthResponse, error) {
-	accessToken, refreshToken, tokenID, expiresAt, err := common.GenerateTokenPair(userID, email)
+func handleGetUsers(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+
+	users, counts, err := repository.GetUsers()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	data := make([]*pb.User, 0, len(users))
+	for _, user := range users {
+		data = append(data, common.UserToProto(user, counts[user.ID]))
+	}
+	common.RespondProto(c, http.StatusOK, &pb.UserList{Data: data})
+}
+
+func handleSetUserQuota(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid user ID")
+		return
+	}
+
+	var req pb.UserQuotaRequest
+	if err := common.ParseProtoBody(c, &req); err != nil || req.DeviceQuota < 0 {
+		common.RespondError(c, http.StatusBadRequest, "device_quota must be zero or positive")
+		return
+	}
+
+	user, err := repository.SetUserDeviceQuota(id, int(req.DeviceQuota))
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	paired, err := repository.CountUserDevices(user.ID)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	common.RespondProto(c, http.StatusOK, common.UserToProto(*user, paired))
+}
+
+func buildAuthResponse(user *models.User) (*pb.AuthResponse, error) {
+	accessToken, refreshToken, tokenID, expiresAt, err := common.GenerateTokenPair(user.ID, user.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := repository.SaveRefreshToken(userID, tokenID, expiresAt); err != nil {
+	if err := repository.SaveRefreshToken(user.ID, tokenID, expiresAt); err != nil {
 		return nil, err
 	}
 
 	return &pb.AuthResponse{
-		Id:           uint32(userID),
-		Email:        email,
+		Id:           uint32(user.ID),
+		Email:        user.Email,
 		Token:        accessToken,
 		RefreshToken: refreshToken,
+		Role:         user.Role,
 	}, nil
 }
@@ -0,0 +1,275 @@
+package web
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jeriveromartinez/sofascore-scrapper/api/common"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+var webhookEventTypes = []string{
+	models.WebhookEventMatchStarted,
+	models.WebhookEventMatchEnded,
+	models.WebhookEventGoalScored,
+}
+
+type WebhookController struct {
+	Group *gin.RouterGroup
+}
+
+func (c *WebhookController) LoadRoutes() {
+	c.Group.GET("/webhooks", common.AuthMiddleware(), handleGetWebhooks)
+	c.Group.POST("/webhooks", common.AuthMiddleware(), handleCreateWebhook)
+	c.Group.PUT("/webhooks/:id", common.AuthMiddleware(), handleUpdateWebhook)
+	c.Group.DELETE("/webhooks/:id", common.AuthMiddleware(), handleDeleteWebhook)
+	c.Group.POST("/webhooks/:id/test", common.AuthMiddleware(), handleTestWebhook)
+	c.Group.GET("/webhooks/:id/deliveries", common.AuthMiddleware(), handleGetWebhookDeliveries)
+	c.Group.POST("/webhooks/deliveries/:id/retry", common.AuthMiddleware(), handleRetryWebhookDelivery)
+}
+
+func handleGetWebhooks(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	webhooks, err := repository.GetWebhookSubscriptions()
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.WebhookList{Webhooks: common.WebhooksToProto(webhooks)})
+}
+
+func handleCreateWebhook(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	var req pb.WebhookRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	subscription := &models.WebhookSubscription{}
+	if msg := applyWebhookRequest(subscription, &req); msg != "" {
+		common.RespondError(c, http.StatusBadRequest, msg)
+		return
+	}
+
+	generated := false
+	if subscription.Secret == "" {
+		secret, err := randomWebhookSecret()
+		if err != nil {
+			common.RespondError(c, http.StatusInternalServerError, "could not generate secret")
+			return
+		}
+		subscription.Secret = secret
+		generated = true
+	}
+
+	if err := repository.SaveWebhookSubscription(subscription); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	resp := common.WebhookToProto(*subscription)
+	if generated {
+		resp.Secret = subscription.Secret
+	}
+	common.RespondProto(c, http.StatusCreated, resp)
+}
+
+func handleUpdateWebhook(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req pb.WebhookRequest
+	if err := common.ParseProtoBody(c, &req); err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	subscription, err := repository.GetWebhookSubscriptionByID(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "webhook not found")
+		return
+	}
+
+	if msg := applyWebhookRequest(subscription, &req); msg != "" {
+		common.RespondError(c, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := repository.SaveWebhookSubscription(subscription); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.WebhookToProto(*subscription))
+}
+
+func handleDeleteWebhook(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := repository.DeleteWebhookSubscription(id); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.StatusMessage{Message: "webhook deleted"})
+}
+
+// handleTestWebhook sends a test notification right away and returns the
+// outcome of that first attempt; failures are retried like any delivery. The
+// delivery is created claimed so the worker never sends it concurrently.
+func handleTestWebhook(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	subscription, err := repository.GetWebhookSubscriptionByID(id)
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "webhook not found")
+		return
+	}
+
+	delivery, err := repository.CreateTestWebhookDelivery(subscription)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := repository.DeliverWebhook(delivery); err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.WebhookDeliveryToProto(*delivery))
+}
+
+func handleGetWebhookDeliveries(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	page := 1
+	limit := 20
+	if pageParam := c.Query("page"); pageParam != "" {
+		parsedPage, parseErr := strconv.Atoi(pageParam)
+		if parseErr != nil || parsedPage < 1 {
+			common.RespondError(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsedPage
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitParam)
+		if parseErr != nil || parsedLimit < 1 {
+			common.RespondError(c, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		if parsedLimit > 100 {
+			parsedLimit = 100
+		}
+		limit = parsedLimit
+	}
+
+	deliveries, total, err := repository.GetWebhookDeliveries(id, c.Query("status"), page, limit)
+	if err != nil {
+		common.RespondError(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	common.RespondProto(c, http.StatusOK, &pb.WebhookDeliveryList{Deliveries: common.WebhookDeliveriesToProto(deliveries), Total: total})
+}
+
+func handleRetryWebhookDelivery(c *gin.Context) {
+	if !requireAdmin(c) {
+		return
+	}
+	id, err := common.ParseID(c.Param("id"))
+	if err != nil {
+		common.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	delivery, err := repository.RequeueWebhookDelivery(id)
+	if errors.Is(err, models.ErrWebhookDeliveryNotDead) {
+		common.RespondError(c, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		common.RespondError(c, http.StatusNotFound, "delivery not found")
+		return
+	}
+	common.RespondProto(c, http.StatusOK, common.WebhookDeliveryToProto(*delivery))
+}
+
+// applyWebhookRequest validates req and copies it into subscription. It
+// returns a client facing message when the request is invalid. An empty
+// secret keeps the current one.
+func applyWebhookRequest(subscription *models.WebhookSubscription, req *pb.WebhookRequest) string {
+	target, err := url.Parse(req.Url)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return "a valid http(s) url is required"
+	}
+	if len(req.EventTypes) == 0 {
+		return "at least one event type is required"
+	}
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return "unknown event type " + eventType
+		}
+	}
+
+	ids := make([]string, 0, len(req.TournamentIds))
+	for _, id := range req.TournamentIds {
+		ids = append(ids, strconv.FormatUint(uint64(id), 10))
+	}
+
+	subscription.URL = req.Url
+	subscription.EventTypes = strings.Join(req.EventTypes, ",")
+	subscription.TournamentIDs = strings.Join(ids, ",")
+	subscription.Active = req.Active
+	if req.Secret != "" {
+		subscription.Secret = req.Secret
+	}
+	return ""
+}
+
+func randomWebhookSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
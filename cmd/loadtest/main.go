@@ -0,0 +1,253 @@
+// Command loadtest drives the playback endpoints with many simulated devices
+// and reports the throughput and latency the API sustained. It exits with
+// status 1 when the run misses the -max-errors, -max-p99 or -min-rps targets,
+// so it can gate a deployment.
+//
+//	go run ./cmd/loadtest -url http://localhost:8080 -devices 5000 -duration 1m -max-p99 250ms
+//
+// It registers the devices first, so point it at a test database and run the
+// server with REQUIRE_SUBSCRIPTION=false.
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/deviceauth"
+	pb "github.com/jeriveromartinez/sofascore-scrapper/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const appPrefix = "/api/app/v1"
+
+type device struct {
+	token    string
+	secret   string
+	playback uint32
+	beats    int
+}
+
+type stats struct {
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+	statuses  map[string]map[int]int
+	requests  atomic.Int64
+}
+
+func (s *stats) record(name string, status int, elapsed time.Duration) {
+	s.requests.Add(1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latencies[name] = append(s.latencies[name], elapsed)
+	if s.statuses[name] == nil {
+		s.statuses[name] = make(map[int]int)
+	}
+	s.statuses[name][status]++
+}
+
+type client struct {
+	base  string
+	http  *http.Client
+	stats *stats
+}
+
+// call sends a signed protobuf request and decodes the response into out
+// when it is not nil. Transport errors are recorded with status 0.
+func (c *client) call(name string, d *device, method, path string, in, out proto.Message) int {
+	body, err := proto.Marshal(in)
+	if err != nil {
+		log.Fatalf("marshal %s: %v", name, err)
+	}
+	req, err := http.NewRequest(method, c.base+appPrefix+path, bytes.NewReader(body))
+	if err != nil {
+		log.Fatalf("request %s: %v", name, err)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("APP-XIPTV", d.token)
+	if d.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		nonce := randomHex(16)
+		req.Header.Set(deviceauth.HeaderTimestamp, timestamp)
+		req.Header.Set(deviceauth.HeaderNonce, nonce)
+		req.Header.Set(deviceauth.HeaderSignature, deviceauth.Sign(d.secret, method, appPrefix+path, timestamp, nonce, body))
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.stats.record(name, 0, time.Since(start))
+		return 0
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.stats.record(name, resp.StatusCode, time.Since(start))
+	if err == nil && out != nil && resp.StatusCode < 300 {
+		if err := proto.Unmarshal(data, out); err != nil {
+			log.Printf("decode %s: %v", name, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+// step moves a device one request further through its viewing cycle: start
+// a playback, send a few heartbeats, stop it. Every fifth cycle goes through
+// the legacy viewing endpoint instead.
+func (c *client) step(d *device, cycle int) {
+	switch {
+	case d.playback == 0 && cycle%5 == 4:
+		c.call("viewing", d, http.MethodPost, "/devices/viewing", &pb.LogPlaybackRequest{DeviceToken: d.token, Content: "event:" + strconv.Itoa(cycle)}, nil)
+	case d.playback == 0:
+		var session pb.PlaybackSession
+		if c.call("start", d, http.MethodPost, "/playback/start", &pb.LogPlaybackRequest{Content: "event:" + strconv.Itoa(cycle)}, &session) == http.StatusCreated {
+			d.playback = session.GetPlayback().GetId()
+			d.beats = 0
+		}
+	case d.beats < 3:
+		d.beats++
+		if status := c.call("heartbeat", d, http.MethodPost, fmt.Sprintf("/playback/%d/heartbeat", d.playback), &pb.HeartbeatRequest{}, nil); status == http.StatusNotFound || status == http.StatusConflict {
+			d.playback = 0
+		}
+	default:
+		c.call("stop", d, http.MethodPost, fmt.Sprintf("/playback/%d/stop", d.playback), &pb.UpdatePlaybackRequest{}, nil)
+		d.playback = 0
+	}
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		log.Fatal(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(i, 0)]
+}
+
+func main() {
+	base := flag.String("url", "http://localhost:8080", "base URL of the API")
+	devices := flag.Int("devices", 2000, "number of simulated devices")
+	duration := flag.Duration("duration", time.Minute, "how long to send requests")
+	concurrency := flag.Int("concurrency", 200, "number of concurrent workers")
+	maxErrors := flag.Float64("max-errors", 0.01, "highest share of failed requests (transport errors and 5xx)")
+	maxP99 := flag.Duration("max-p99", 0, "highest p99 latency of any endpoint, 0 to skip")
+	minRPS := flag.Float64("min-rps", 0, "lowest overall requests per second, 0 to skip")
+	flag.Parse()
+
+	c := &client{
+		base: *base,
+		http: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{MaxIdleConnsPerHost: *concurrency},
+		},
+		stats: &stats{latencies: make(map[string][]time.Duration), statuses: make(map[string]map[int]int)},
+	}
+
+	log.Printf("registering %d devices", *devices)
+	run := randomHex(4)
+	fleet := make([]*device, *devices)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, *concurrency)
+	for i := range fleet {
+		fleet[i] = &device{token: fmt.Sprintf("loadtest-%s-%d", run, i)}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(d *device) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			var registered pb.Device
+			c.call("register", d, http.MethodPost, "/devices", &pb.DeviceRegisterRequest{Token: d.token, Platform: "android", Name: d.token, Version: "1.0.0"}, &registered)
+			d.secret = registered.GetSecret()
+		}(fleet[i])
+	}
+	wg.Wait()
+	log.Printf("registration statuses: %v", c.stats.statuses["register"])
+	delete(c.stats.latencies, "register")
+	delete(c.stats.statuses, "register")
+	c.stats.requests.Store(0)
+
+	// Each worker owns a slice of the fleet, so a device never has two
+	// requests in flight.
+	log.Printf("sending requests for %s with %d workers", *duration, *concurrency)
+	deadline := time.Now().Add(*duration)
+	started := time.Now()
+	for w := range min(*concurrency, len(fleet)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for cycle := 0; time.Now().Before(deadline); cycle++ {
+				for i := w; i < len(fleet) && time.Now().Before(deadline); i += *concurrency {
+					c.step(fleet[i], cycle)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(started)
+
+	total := c.stats.requests.Load()
+	rps := float64(total) / elapsed.Seconds()
+	fmt.Printf("\n%d requests in %s: %.0f req/s\n\n", total, elapsed.Round(time.Millisecond), rps)
+	fmt.Printf("%-10s %8s %8s %8s %8s  %s\n", "endpoint", "count", "p50", "p95", "p99", "statuses")
+	names := make([]string, 0, len(c.stats.latencies))
+	for name := range c.stats.latencies {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var failed int
+	var worstP99 time.Duration
+	for _, name := range names {
+		latencies := c.stats.latencies[name]
+		slices.Sort(latencies)
+		p99 := percentile(latencies, 0.99)
+		worstP99 = max(worstP99, p99)
+		for status, count := range c.stats.statuses[name] {
+			if status == 0 || status >= 500 {
+				failed += count
+			}
+		}
+		fmt.Printf("%-10s %8d %8s %8s %8s  %v\n", name, len(latencies),
+			percentile(latencies, 0.50).Round(time.Microsecond),
+			percentile(latencies, 0.95).Round(time.Microsecond),
+			p99.Round(time.Microsecond),
+			c.stats.statuses[name])
+	}
+
+	var misses []string
+	errorRate := float64(failed) / float64(max(total, 1))
+	if errorRate > *maxErrors {
+		misses = append(misses, fmt.Sprintf("error rate %.2f%% above %.2f%%", 100*errorRate, 100*(*maxErrors)))
+	}
+	if *maxP99 > 0 && worstP99 > *maxP99 {
+		misses = append(misses, fmt.Sprintf("p99 %s above %s", worstP99.Round(time.Microsecond), *maxP99))
+	}
+	if *minRPS > 0 && rps < *minRPS {
+		misses = append(misses, fmt.Sprintf("%.0f req/s below %.0f", rps, *minRPS))
+	}
+	fmt.Println()
+	if len(misses) > 0 {
+		for _, miss := range misses {
+			fmt.Println("FAIL:", miss)
+		}
+		os.Exit(1)
+	}
+	fmt.Println("PASS")
+}
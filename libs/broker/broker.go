@@ -0,0 +1,71 @@
+// Package broker is a small in-process publish/subscribe hub used to fan out
+// domain notifications (score changes, device activity, ...) to live
+// consumers such as streaming endpoints and background workers.
+package broker
+
+import (
+	"log"
+	"sync"
+)
+
+// Message is a notification published on a topic.
+type Message struct {
+	Topic   string
+	Payload any
+}
+
+type subscriber struct {
+	ch     chan Message
+	topics map[string]struct{}
+}
+
+var (
+	mu          sync.RWMutex
+	subscribers = make(map[*subscriber]struct{})
+)
+
+// Subscribe registers a consumer for the given topics. Messages are delivered
+// on the returned channel, which holds up to buffer pending messages; when
+// the consumer falls behind further messages for it are dropped. The returned
+// function unsubscribes and closes the channel.
+func Subscribe(buffer int, topics ...string) (<-chan Message, func()) {
+	sub := &subscriber{
+		ch:     make(chan Message, buffer),
+		topics: make(map[string]struct{}, len(topics)),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+
+	mu.Lock()
+	subscribers[sub] = struct{}{}
+	mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			mu.Lock()
+			delete(subscribers, sub)
+			mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// Publish delivers payload to every subscriber of topic without blocking.
+func Publish(topic string, payload any) {
+	msg := Message{Topic: topic, Payload: payload}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	for sub := range subscribers {
+		if _, ok := sub.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+			log.Printf("broker: dropping %s message for a slow subscriber", topic)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package broker
+
+const (
+	// TopicEventChanged carries a models.EventChange whenever a scraped event
+	// is created or its score or status changes.
+	TopicEventChanged = "events.changed"
+	// TopicDeviceStatus carries a models.DeviceStatusChange when a device
+	// comes online or goes offline.
+	TopicDeviceStatus = "devices.status"
+	// TopicPlaybackStarted carries the *models.PlaybackLog of a new session.
+	TopicPlaybackStarted = "playback.started"
+	// TopicCrashReported carries a newly stored models.CrashReport.
+	TopicCrashReported = "crash_reports.created"
+	// TopicJobFinished carries a models.JobRun when a background job ends.
+	TopicJobFinished = "jobs.finished"
+	// TopicSubscriptionExpiring carries a models.DeviceSubscription that is
+	// about to expire.
+	TopicSubscriptionExpiring = "subscriptions.expiring"
+	// TopicDeviceCommand carries a models.DeviceCommand when it is queued or
+	// its status changes.
+	TopicDeviceCommand = "devices.commands"
+)
@@ -22,7 +22,9 @@ func Connect() (*gorm.DB, error) {
 	password := getEnv("DB_PASSWORD", "")
 	dbName := getEnv("DB_NAME", "sofascore")
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", user, password, host, port, dbName)
+	// DATETIME columns are stored in UTC; rows written with loc=Local by older
+	// versions must be converted once, see the README.
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=UTC", user, password, host, port, dbName)
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to database: %w", err)
@@ -0,0 +1,175 @@
+package deviceauth
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+const (
+	currentSecret  = "current-secret"
+	previousSecret = "previous-secret"
+)
+
+// signedRequest returns a request signed with secret at the given time.
+func signedRequest(secret string, at time.Time, nonce string, body []byte) Request {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return Request{
+		Method:    "POST",
+		Path:      "/api/app/devices/heartbeat?v=1",
+		Timestamp: timestamp,
+		Nonce:     nonce,
+		Signature: Sign(secret, "POST", "/api/app/devices/heartbeat?v=1", timestamp, nonce, body),
+		Body:      body,
+	}
+}
+
+// resetNonces gives the test an empty replay cache.
+func resetNonces() {
+	nonces = &nonceCache{seen: make(map[string]time.Time)}
+}
+
+func TestVerify(t *testing.T) {
+	resetNonces()
+	now := time.Now()
+	body := []byte(`{"version":"1.0.0"}`)
+	rotated := models.Device{
+		Secret:                  currentSecret,
+		PreviousSecret:          previousSecret,
+		PreviousSecretExpiresAt: now.Add(time.Minute).Unix(),
+	}
+	expired := rotated
+	expired.PreviousSecretExpiresAt = now.Add(-time.Second).Unix()
+
+	tests := []struct {
+		name    string
+		req     func(nonce string) Request
+		secrets []string
+		want    error
+	}{
+		{
+			name:    "valid signature",
+			req:     func(nonce string) Request { return signedRequest(currentSecret, now, nonce, body) },
+			secrets: []string{currentSecret},
+		},
+		{
+			name: "uppercase signature",
+			req: func(nonce string) Request {
+				req := signedRequest(currentSecret, now, nonce, body)
+				req.Signature = strings.ToUpper(req.Signature)
+				return req
+			},
+			secrets: []string{currentSecret},
+		},
+		{
+			name: "tampered body",
+			req: func(nonce string) Request {
+				req := signedRequest(currentSecret, now, nonce, body)
+				req.Body = []byte(`{"version":"9.9.9"}`)
+				return req
+			},
+			secrets: []string{currentSecret},
+			want:    ErrInvalidSignature,
+		},
+		{
+			name:    "wrong secret",
+			req:     func(nonce string) Request { return signedRequest("other-secret", now, nonce, body) },
+			secrets: []string{currentSecret},
+			want:    ErrInvalidSignature,
+		},
+		{
+			name: "timestamp too old",
+			req: func(nonce string) Request {
+				return signedRequest(currentSecret, now.Add(-MaxClockSkew-time.Minute), nonce, body)
+			},
+			secrets: []string{currentSecret},
+			want:    ErrInvalidTimestamp,
+		},
+		{
+			name: "timestamp too far ahead",
+			req: func(nonce string) Request {
+				return signedRequest(currentSecret, now.Add(MaxClockSkew+time.Minute), nonce, body)
+			},
+			secrets: []string{currentSecret},
+			want:    ErrInvalidTimestamp,
+		},
+		{
+			name: "timestamp within the window",
+			req: func(nonce string) Request {
+				return signedRequest(currentSecret, now.Add(-MaxClockSkew+time.Minute), nonce, body)
+			},
+			secrets: []string{currentSecret},
+		},
+		{
+			name: "unsigned",
+			req: func(nonce string) Request {
+				req := signedRequest(currentSecret, now, nonce, body)
+				req.Signature = ""
+				return req
+			},
+			secrets: []string{currentSecret},
+			want:    ErrMissingSignature,
+		},
+		{
+			name:    "previous secret before it expires",
+			req:     func(nonce string) Request { return signedRequest(previousSecret, now, nonce, body) },
+			secrets: rotated.SigningSecrets(now),
+		},
+		{
+			name:    "previous secret after it expires",
+			req:     func(nonce string) Request { return signedRequest(previousSecret, now, nonce, body) },
+			secrets: expired.SigningSecrets(now),
+			want:    ErrInvalidSignature,
+		},
+		{
+			name:    "current secret after a rotation",
+			req:     func(nonce string) Request { return signedRequest(currentSecret, now, nonce, body) },
+			secrets: expired.SigningSecrets(now),
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Every case uses its own nonce so the cases do not replay each
+			// other.
+			err := Verify(1, tt.req("verify-"+strconv.Itoa(i)), tt.secrets...)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsReplayedNonce(t *testing.T) {
+	resetNonces()
+	req := signedRequest(currentSecret, time.Now(), "replayed", []byte(`{}`))
+	if err := Verify(2, req, currentSecret); err != nil {
+		t.Fatalf("first Verify = %v", err)
+	}
+	if err := Verify(2, req, currentSecret); !errors.Is(err, ErrReplayed) {
+		t.Fatalf("replayed Verify = %v, want ErrReplayed", err)
+	}
+	// Nonces are tracked per device.
+	if err := Verify(3, req, currentSecret); err != nil {
+		t.Fatalf("Verify for another device = %v", err)
+	}
+}
+
+func TestVerifyKeepsNonceOfRejectedRequest(t *testing.T) {
+	resetNonces()
+	// A request with a bad signature must not burn the nonce of the
+	// legitimate one.
+	req := signedRequest(currentSecret, time.Now(), "kept", []byte(`{}`))
+	forged := req
+	forged.Signature = Sign("other-secret", req.Method, req.Path, req.Timestamp, req.Nonce, req.Body)
+	if err := Verify(4, forged, currentSecret); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("forged Verify = %v, want ErrInvalidSignature", err)
+	}
+	if err := Verify(4, req, currentSecret); err != nil {
+		t.Fatalf("Verify = %v", err)
+	}
+}
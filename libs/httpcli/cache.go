@@ -0,0 +1,136 @@
+package httpcli
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+const (
+	// responseTTL is how long a fetched body is shared with other callers
+	// asking for the same URL without hitting SofaScore again.
+	responseTTL = 10 * time.Second
+	// validatorRetention is how long ETag/Last-Modified validators are kept
+	// for a URL that is no longer requested.
+	validatorRetention = 24 * time.Hour
+)
+
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	hash         [sha256.Size]byte
+	body         []byte
+	fetchedAt    time.Time
+	// persisted is the hash of the last body a caller reported as saved.
+	persisted [sha256.Size]byte
+}
+
+type pendingFetch struct {
+	done    chan struct{}
+	body    []byte
+	changed bool
+	commit  func()
+}
+
+var (
+	cacheMu   sync.Mutex
+	responses = make(map[string]*cachedResponse)
+	inflight  = make(map[string]*pendingFetch)
+)
+
+// fetchCached returns the body of apiURL, whether it differs from the last
+// body a caller persisted, and a commit func to call once it has been
+// persisted. Until commit is called the same body keeps reporting changed, so
+// a failed save is retried on the next fetch. Callers arriving while a fetch
+// for the same URL is running, or within responseTTL of the last one, share
+// that body and its result.
+func fetchCached(apiURL string) ([]byte, bool, func()) {
+	cacheMu.Lock()
+	if cached, ok := responses[apiURL]; ok && time.Since(cached.fetchedAt) < responseTTL {
+		body, changed, commit := cached.body, cached.hash != cached.persisted, commitFunc(apiURL, cached.hash)
+		cacheMu.Unlock()
+		return body, changed, commit
+	}
+	if pending, ok := inflight[apiURL]; ok {
+		cacheMu.Unlock()
+		<-pending.done
+		return pending.body, pending.changed, pending.commit
+	}
+	pending := &pendingFetch{done: make(chan struct{})}
+	inflight[apiURL] = pending
+	previous := responses[apiURL]
+	cacheMu.Unlock()
+
+	body, changed, commit := fetchConditional(apiURL, previous)
+
+	cacheMu.Lock()
+	delete(inflight, apiURL)
+	pending.body, pending.changed, pending.commit = body, changed, commit
+	close(pending.done)
+	pruneResponses()
+	cacheMu.Unlock()
+
+	return body, changed, commit
+}
+
+// fetchConditional sends a conditional request using the validators of the
+// previous response and stores the result.
+func fetchConditional(apiURL string, previous *cachedResponse) ([]byte, bool, func()) {
+	var etag, lastModified string
+	if previous != nil {
+		etag, lastModified = previous.etag, previous.lastModified
+	}
+
+	resp := loadJSON(apiURL, etag, lastModified)
+	if resp == nil {
+		return nil, false, func() {}
+	}
+
+	now := time.Now()
+	if resp.notModified && previous != nil {
+		cacheMu.Lock()
+		previous.fetchedAt = now
+		changed := previous.hash != previous.persisted
+		cacheMu.Unlock()
+		return previous.body, changed, commitFunc(apiURL, previous.hash)
+	}
+
+	entry := &cachedResponse{
+		etag:         resp.etag,
+		lastModified: resp.lastModified,
+		hash:         sha256.Sum256(resp.body),
+		body:         resp.body,
+		fetchedAt:    now,
+	}
+
+	cacheMu.Lock()
+	if previous != nil {
+		entry.persisted = previous.persisted
+	}
+	changed := entry.hash != entry.persisted
+	responses[apiURL] = entry
+	cacheMu.Unlock()
+
+	return entry.body, changed, commitFunc(apiURL, entry.hash)
+}
+
+// commitFunc returns a func recording hash as the persisted body of apiURL.
+func commitFunc(apiURL string, hash [sha256.Size]byte) func() {
+	return func() {
+		cacheMu.Lock()
+		defer cacheMu.Unlock()
+		if cached, ok := responses[apiURL]; ok {
+			cached.persisted = hash
+		}
+	}
+}
+
+// pruneResponses drops entries that have not been refreshed in a long time.
+// It must be called with cacheMu held.
+func pruneResponses() {
+	for url, cached := range responses {
+		if time.Since(cached.fetchedAt) > validatorRetention {
+			delete(responses, url)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"net/http/cookiejar"
 	"strings"
 	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/locale"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
 )
 
 const browserUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/145.0.0.0 Safari/537.36"
@@ -13,7 +16,7 @@ const browserUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/
 func setBrowserHeaders(req *http.Request, accept string, referer string) {
 	req.Header.Set("User-Agent", browserUserAgent)
 	req.Header.Set("Accept", accept)
-	req.Header.Set("Accept-Language", "es-ES,es;q=0.9,en-US;q=0.8,en;q=0.7")
+	req.Header.Set("Accept-Language", acceptLanguage())
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Pragma", "no-cache")
 	if referer != "" {
@@ -21,11 +24,25 @@ func setBrowserHeaders(req *http.Request, accept string, referer string) {
 	}
 }
 
+// acceptLanguage asks for the scrape locale first and English as fallback.
+func acceptLanguage() string {
+	tag := locale.Scrape()
+	return tag.String() + "," + locale.Base(tag) + ";q=0.9,en-US;q=0.8,en;q=0.7"
+}
+
+// siteURL returns the SofaScore home page in the scrape language.
+func siteURL() string {
+	if base := locale.Base(locale.Scrape()); base != "en" {
+		return "https://www.sofascore.com/" + base + "/"
+	}
+	return "https://www.sofascore.com/"
+}
+
 func loadCookies() *http.Client {
 	jar, _ := cookiejar.New(nil)
 	client := &http.Client{Jar: jar, Timeout: 5 * time.Second}
 
-	homeReq, err := http.NewRequest(http.MethodGet, "https://www.sofascore.com/es/", nil)
+	homeReq, err := http.NewRequest(http.MethodGet, siteURL(), nil)
 	if err != nil {
 		return nil
 	}
@@ -44,57 +61,68 @@ func loadCookies() *http.Client {
 	return client
 }
 
-func LoadDataBySport(sport string, date time.Time) []byte {
-	client := loadCookies()
-	if client == nil {
-		return nil
-	}
-
-	now := date.Format("2006-01-02")
-	apiURL := "https://www.sofascore.com/api/v1/sport/" + sport + "/scheduled-events/" + now
-	apiReq, err := http.NewRequest(http.MethodGet, apiURL, nil)
-	if err != nil {
-		return nil
-	}
-
-	setBrowserHeaders(apiReq, "application/json, text/plain, */*", "https://www.sofascore.com/es/")
-	resp, err := client.Do(apiReq)
-	if err != nil {
-		return nil
-	}
-
-	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode >= 305 {
-		return nil
-	}
+// LoadDataBySport returns the scheduled events of a sport for a day, whether
+// the payload changed since it was last persisted, and a func to call once it
+// has been persisted.
+func LoadDataBySport(sport string, date time.Time) ([]byte, bool, func()) {
+	now := timezone.FormatDay(date, timezone.Reference())
+	return fetchCached("https://www.sofascore.com/api/v1/sport/" + sport + "/scheduled-events/" + now)
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil
-	}
+// LoadDataByTrendingCountry returns the trending events of a country,
+// whether the payload changed since it was last persisted, and a func to call
+// once it has been persisted.
+func LoadDataByTrendingCountry(countryCode string) ([]byte, bool, func()) {
+	return fetchCached("https://www.sofascore.com/api/v1/trending/events/" + strings.ToUpper(countryCode) + "/all")
+}
 
+// LoadSportList returns the per-sport event counters SofaScore exposes, keyed
+// by sport slug. It is used to discover the sports available upstream.
+func LoadSportList() []byte {
+	body, _, _ := fetchCached("https://www.sofascore.com/api/v1/sport/0/event-count")
 	return body
 }
 
-func LoadDataByTrendingCountry(countryCode string) []byte {
+type jsonResponse struct {
+	body         []byte
+	etag         string
+	lastModified string
+	notModified  bool
+}
+
+func loadJSON(apiURL, etag, lastModified string) *jsonResponse {
 	client := loadCookies()
 	if client == nil {
 		return nil
 	}
 
-	apiURL := "https://www.sofascore.com/api/v1/trending/events/" + strings.ToUpper(countryCode) + "/all"
 	apiReq, err := http.NewRequest(http.MethodGet, apiURL, nil)
 	if err != nil {
 		return nil
 	}
 
-	setBrowserHeaders(apiReq, "application/json, text/plain, */*", "https://www.sofascore.com/es/")
+	setBrowserHeaders(apiReq, "application/json, text/plain, */*", siteURL())
+	if etag != "" || lastModified != "" {
+		// Conditional requests must be allowed to revalidate against caches.
+		apiReq.Header.Del("Cache-Control")
+		apiReq.Header.Del("Pragma")
+	}
+	if etag != "" {
+		apiReq.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		apiReq.Header.Set("If-Modified-Since", lastModified)
+	}
+
 	resp, err := client.Do(apiReq)
 	if err != nil {
 		return nil
 	}
 
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return &jsonResponse{notModified: true, etag: etag, lastModified: lastModified}
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 305 {
 		return nil
 	}
@@ -104,5 +132,9 @@ func LoadDataByTrendingCountry(countryCode string) []byte {
 		return nil
 	}
 
-	return body
+	return &jsonResponse{
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}
 }
@@ -0,0 +1,332 @@
+// Package ingest batches high-volume writes. Items queued on a Buffer are
+// handed to its flush function in batches, either when Size items are
+// waiting or every Interval. The queue is bounded: once Capacity items are
+// pending, Add fails with ErrFull so callers can shed load instead of piling
+// up goroutines. Items that cannot be written are appended to a spool file
+// and written again after the next successful flush or on the next start.
+package ingest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var (
+	ErrFull   = errors.New("ingest buffer is full")
+	ErrClosed = errors.New("ingest buffer is closed")
+)
+
+// Options configures a Buffer.
+type Options struct {
+	// Name identifies the buffer in logs.
+	Name     string
+	Size     int
+	Interval time.Duration
+	Capacity int
+	// SpoolPath is the file holding the items that could not be written.
+	// Spooling is disabled when empty.
+	SpoolPath string
+}
+
+type entry[T any] struct {
+	item T
+	done chan error
+}
+
+// Buffer queues items of type T for batched writes. T must round-trip
+// through encoding/json to be spooled.
+type Buffer[T any] struct {
+	opts  Options
+	flush func(context.Context, []T) error
+
+	queue     chan entry[T]
+	mu        sync.RWMutex
+	closed    bool
+	closeOnce sync.Once
+	stopping  chan struct{}
+	stopped   chan struct{}
+
+	// flushCtx is cancelled when Close runs out of time, aborting the flush
+	// in progress so its items are spooled.
+	flushCtx    context.Context
+	abortFlush  context.CancelFunc
+	spoolExists bool
+}
+
+// New returns a buffer writing batches with flush. Start must be called
+// before items are added.
+func New[T any](opts Options, flush func(context.Context, []T) error) *Buffer[T] {
+	if opts.Size < 1 {
+		opts.Size = 1
+	}
+	if opts.Capacity < opts.Size {
+		opts.Capacity = opts.Size
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Buffer[T]{
+		opts:       opts,
+		flush:      flush,
+		queue:      make(chan entry[T], opts.Capacity),
+		stopping:   make(chan struct{}),
+		stopped:    make(chan struct{}),
+		flushCtx:   ctx,
+		abortFlush: cancel,
+	}
+}
+
+// Start writes the items spooled by a previous run and starts flushing.
+func (b *Buffer[T]) Start() {
+	b.replaySpool()
+	go b.run()
+}
+
+// Add queues item without waiting for it to be written.
+func (b *Buffer[T]) Add(item T) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+	select {
+	case b.queue <- entry[T]{item: item}:
+		return nil
+	default:
+		return ErrFull
+	}
+}
+
+// AddWait queues item and waits until the batch holding it is written,
+// returning the flush error. It waits for room in the queue until ctx is
+// done, in which case it returns ErrFull.
+func (b *Buffer[T]) AddWait(ctx context.Context, item T) error {
+	e := entry[T]{item: item, done: make(chan error, 1)}
+
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrClosed
+	}
+	select {
+	case b.queue <- e:
+	case <-b.stopping:
+		b.mu.RUnlock()
+		return ErrClosed
+	case <-ctx.Done():
+		b.mu.RUnlock()
+		return ErrFull
+	}
+	b.mu.RUnlock()
+
+	// Once queued the item is written even if ctx ends, so the outcome is
+	// always reported.
+	return <-e.done
+}
+
+// Close stops accepting items and writes the pending ones. Whatever is
+// still unwritten when ctx is done is spooled.
+func (b *Buffer[T]) Close(ctx context.Context) error {
+	b.closeOnce.Do(func() {
+		// Release the AddWait calls blocked on a full queue before taking
+		// the lock they hold.
+		close(b.stopping)
+		b.mu.Lock()
+		b.closed = true
+		close(b.queue)
+		b.mu.Unlock()
+	})
+
+	select {
+	case <-b.stopped:
+		return nil
+	case <-ctx.Done():
+		b.abortFlush()
+		<-b.stopped
+		return ctx.Err()
+	}
+}
+
+func (b *Buffer[T]) run() {
+	defer close(b.stopped)
+	ticker := time.NewTicker(b.opts.Interval)
+	defer ticker.Stop()
+
+	batch := make([]entry[T], 0, b.opts.Size)
+	for {
+		select {
+		case e, ok := <-b.queue:
+			if !ok {
+				b.write(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= b.opts.Size {
+				b.write(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				b.write(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
+
+// write flushes a batch and reports the outcome to its waiters. Items
+// without a waiter are spooled when the flush fails; waiters got the error
+// and answer their client with it.
+func (b *Buffer[T]) write(batch []entry[T]) {
+	if len(batch) == 0 {
+		return
+	}
+	items := make([]T, len(batch))
+	for i, e := range batch {
+		items[i] = e.item
+	}
+
+	err := b.flush(b.flushCtx, items)
+	for _, e := range batch {
+		if e.done != nil {
+			e.done <- err
+		}
+	}
+	if err == nil {
+		if b.spoolExists {
+			b.replaySpool()
+		}
+		return
+	}
+
+	log.Printf("ingest: %s: failed to write %d items: %v", b.opts.Name, len(items), err)
+	unanswered := make([]T, 0, len(batch))
+	for _, e := range batch {
+		if e.done == nil {
+			unanswered = append(unanswered, e.item)
+		}
+	}
+	b.spool(unanswered)
+}
+
+func (b *Buffer[T]) spool(items []T) {
+	if len(items) == 0 {
+		return
+	}
+	if b.opts.SpoolPath == "" {
+		log.Printf("ingest: %s: dropped %d items, spooling is disabled", b.opts.Name, len(items))
+		return
+	}
+	if err := appendSpool(b.opts.SpoolPath, items); err != nil {
+		log.Printf("ingest: %s: failed to spool %d items: %v", b.opts.Name, len(items), err)
+		return
+	}
+	b.spoolExists = true
+}
+
+func appendSpool[T any](path string, items []T) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// replaySpool writes the spooled items in batches and removes the spool
+// once all of them are written. It only runs before the loop starts or
+// from the loop itself, so the spool is never accessed concurrently.
+func (b *Buffer[T]) replaySpool() {
+	if b.opts.SpoolPath == "" {
+		return
+	}
+	items, err := b.readSpool()
+	if errors.Is(err, os.ErrNotExist) {
+		b.spoolExists = false
+		return
+	}
+	if err != nil {
+		log.Printf("ingest: %s: failed to read spool: %v", b.opts.Name, err)
+		b.spoolExists = true
+		return
+	}
+
+	for start := 0; start < len(items); start += b.opts.Size {
+		end := min(start+b.opts.Size, len(items))
+		if err := b.flush(b.flushCtx, items[start:end]); err != nil {
+			log.Printf("ingest: %s: failed to replay spool: %v", b.opts.Name, err)
+			b.spoolExists = true
+			if start > 0 {
+				b.rewriteSpool(items[start:])
+			}
+			return
+		}
+	}
+	if err := os.Remove(b.opts.SpoolPath); err != nil {
+		log.Printf("ingest: %s: failed to remove spool: %v", b.opts.Name, err)
+		return
+	}
+	if len(items) > 0 {
+		log.Printf("ingest: %s: replayed %d spooled items", b.opts.Name, len(items))
+	}
+	b.spoolExists = false
+}
+
+// readSpool decodes the spooled items, skipping the lines that cannot be
+// decoded. The file is closed on return so it can be replaced or removed.
+func (b *Buffer[T]) readSpool() ([]T, error) {
+	f, err := os.Open(b.opts.SpoolPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []T
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64<<10), 1<<20)
+	for scanner.Scan() {
+		var item T
+		if err := json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			log.Printf("ingest: %s: skipping unreadable spooled item: %v", b.opts.Name, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, scanner.Err()
+}
+
+// rewriteSpool replaces the spool with the items still to be written, so
+// the batches already replayed are not written twice.
+func (b *Buffer[T]) rewriteSpool(items []T) {
+	tmp := b.opts.SpoolPath + ".tmp"
+	_ = os.Remove(tmp)
+	if err := appendSpool(tmp, items); err != nil {
+		log.Printf("ingest: %s: failed to rewrite spool: %v", b.opts.Name, err)
+		return
+	}
+	if err := os.Rename(tmp, b.opts.SpoolPath); err != nil {
+		log.Printf("ingest: %s: failed to rewrite spool: %v", b.opts.Name, err)
+	}
+}
@@ -0,0 +1,238 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder collects the batches handed to a flush function.
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+	err     error
+}
+
+func (r *recorder) flush(_ context.Context, items []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.batches = append(r.batches, slices.Clone(items))
+	return nil
+}
+
+func (r *recorder) items() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Concat(r.batches...)
+}
+
+func (r *recorder) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+}
+
+func closeBuffer[T any](t *testing.T, b *Buffer[T]) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := b.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within a second")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFlushesFullBatches(t *testing.T) {
+	rec := &recorder{}
+	b := New(Options{Name: "test", Size: 3, Interval: time.Hour, Capacity: 10}, rec.flush)
+	b.Start()
+	defer closeBuffer(t, b)
+
+	for i := range 5 {
+		if err := b.Add(i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+	// AddWait returns once the batch holding its item is written.
+	if err := b.AddWait(context.Background(), 5); err != nil {
+		t.Fatalf("AddWait: %v", err)
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	want := [][]int{{0, 1, 2}, {3, 4, 5}}
+	if !slices.EqualFunc(rec.batches, want, slices.Equal) {
+		t.Fatalf("batches = %v, want %v", rec.batches, want)
+	}
+}
+
+func TestFlushesPartialBatchAfterInterval(t *testing.T) {
+	rec := &recorder{}
+	b := New(Options{Name: "test", Size: 100, Interval: 10 * time.Millisecond, Capacity: 100}, rec.flush)
+	b.Start()
+	defer closeBuffer(t, b)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := b.AddWait(ctx, 7); err != nil {
+		t.Fatalf("AddWait: %v", err)
+	}
+	if got := rec.items(); !slices.Equal(got, []int{7}) {
+		t.Fatalf("items = %v, want [7]", got)
+	}
+}
+
+func TestFullQueueRejectsItems(t *testing.T) {
+	release := make(chan struct{})
+	flushing := make(chan struct{}, 1)
+	b := New(Options{Name: "test", Size: 1, Interval: time.Hour, Capacity: 2}, func(context.Context, []int) error {
+		select {
+		case flushing <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	})
+	b.Start()
+	defer closeBuffer(t, b)
+	defer close(release)
+
+	// The first item blocks the flush, the next two fill the queue.
+	if err := b.Add(0); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	<-flushing
+	for i := 1; i <= 2; i++ {
+		if err := b.Add(i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+
+	if err := b.Add(3); !errors.Is(err, ErrFull) {
+		t.Fatalf("Add on a full queue = %v, want ErrFull", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.AddWait(ctx, 3); !errors.Is(err, ErrFull) {
+		t.Fatalf("AddWait on a full queue = %v, want ErrFull", err)
+	}
+}
+
+func TestCloseDrainsPendingItems(t *testing.T) {
+	rec := &recorder{}
+	b := New(Options{Name: "test", Size: 100, Interval: time.Hour, Capacity: 100}, rec.flush)
+	b.Start()
+
+	for i := range 5 {
+		if err := b.Add(i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+	closeBuffer(t, b)
+
+	if got := rec.items(); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("items = %v, want [0 1 2 3 4]", got)
+	}
+	if err := b.Add(5); !errors.Is(err, ErrClosed) {
+		t.Fatalf("Add after Close = %v, want ErrClosed", err)
+	}
+}
+
+func TestCloseSpoolsWhenOutOfTime(t *testing.T) {
+	spool := filepath.Join(t.TempDir(), "items.jsonl")
+	b := New(Options{Name: "test", Size: 100, Interval: time.Hour, Capacity: 100, SpoolPath: spool}, func(ctx context.Context, _ []int) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	b.Start()
+	for i := range 3 {
+		if err := b.Add(i); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close = %v, want DeadlineExceeded", err)
+	}
+
+	rec := &recorder{}
+	replay := New(Options{Name: "test", Size: 2, Interval: time.Hour, Capacity: 100, SpoolPath: spool}, rec.flush)
+	replay.Start()
+	defer closeBuffer(t, replay)
+	if got := rec.items(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Fatalf("replayed items = %v, want [0 1 2]", got)
+	}
+}
+
+func TestFailedFlushIsSpooledAndReplayed(t *testing.T) {
+	spool := filepath.Join(t.TempDir(), "items.jsonl")
+	rec := &recorder{}
+	rec.fail(errors.New("database is down"))
+	b := New(Options{Name: "test", Size: 2, Interval: time.Hour, Capacity: 100, SpoolPath: spool}, rec.flush)
+	b.Start()
+
+	// Queued items are spooled; the waiter gets the error instead.
+	if err := b.Add(1); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := b.AddWait(context.Background(), 2); err == nil {
+		t.Fatal("AddWait succeeded while the flush fails")
+	}
+	// The unanswered items are spooled right after the waiters get the
+	// error.
+	waitFor(t, func() bool {
+		_, err := os.Stat(spool)
+		return err == nil
+	})
+
+	// The next successful flush writes the spooled item too.
+	rec.fail(nil)
+	if err := b.Add(3); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := b.AddWait(context.Background(), 4); err != nil {
+		t.Fatalf("AddWait: %v", err)
+	}
+	// The replay runs right after the waiters are answered.
+	closeBuffer(t, b)
+	if got := rec.items(); !slices.Equal(got, []int{3, 4, 1}) {
+		t.Fatalf("items = %v, want [3 4 1]", got)
+	}
+	if _, err := os.Stat(spool); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("spool still exists after replay: %v", err)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	buffer := New(Options{Name: "bench", Size: 500, Interval: 250 * time.Millisecond, Capacity: 10000}, func(context.Context, []int) error {
+		return nil
+	})
+	buffer.Start()
+	defer buffer.Close(context.Background())
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for errors.Is(buffer.Add(1), ErrFull) {
+			}
+		}
+	})
+}
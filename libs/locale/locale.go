@@ -0,0 +1,73 @@
+// Package locale normalizes locale identifiers and resolves the locales a
+// response should be localized in.
+package locale
+
+import (
+	"os"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+const defaultScrapeLocale = "es-ES"
+
+var (
+	scrapeOnce   sync.Once
+	scrapeLocale language.Tag
+)
+
+// Scrape returns the locale requested from SofaScore, read from
+// SCRAPE_LOCALE. Invalid or missing values fall back to es-ES.
+func Scrape() language.Tag {
+	scrapeOnce.Do(func() {
+		tag, err := language.Parse(os.Getenv("SCRAPE_LOCALE"))
+		if err != nil || tag == language.Und {
+			tag = language.MustParse(defaultScrapeLocale)
+		}
+		scrapeLocale = tag
+	})
+	return scrapeLocale
+}
+
+// Normalize returns the canonical form of a locale such as "es-MX", or an
+// empty string when s is not a valid locale.
+func Normalize(s string) string {
+	tag, err := language.Parse(s)
+	if err != nil || tag == language.Und {
+		return ""
+	}
+	return tag.String()
+}
+
+// Base returns the language of a locale, e.g. "pt" for "pt-BR".
+func Base(tag language.Tag) string {
+	base, _ := tag.Base()
+	return base.String()
+}
+
+// Preferred returns the locales to try, most preferred first: the device
+// locale and then the Accept-Language entries, each followed by its base
+// language.
+func Preferred(deviceLocale, acceptLanguage string) []string {
+	var tags []language.Tag
+	if tag, err := language.Parse(deviceLocale); err == nil && tag != language.Und {
+		tags = append(tags, tag)
+	}
+	if accepted, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil {
+		tags = append(tags, accepted...)
+	}
+
+	seen := make(map[string]bool)
+	var locales []string
+	add := func(s string) {
+		if s != "" && s != "und" && !seen[s] {
+			seen[s] = true
+			locales = append(locales, s)
+		}
+	}
+	for _, tag := range tags {
+		add(tag.String())
+		add(Base(tag))
+	}
+	return locales
+}
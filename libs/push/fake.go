@@ -0,0 +1,39 @@
+package push
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// FakeProvider logs notifications instead of sending them and keeps them in
+// memory so they can be inspected.
+type FakeProvider struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Send(_ context.Context, msg Message) error {
+	p.mu.Lock()
+	p.sent = append(p.sent, msg)
+	p.mu.Unlock()
+	log.Printf("push: [fake] %s: %s", msg.Title, msg.Body)
+	return nil
+}
+
+// Sent returns a copy of the notifications sent so far.
+func (p *FakeProvider) Sent() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]Message, len(p.sent))
+	copy(out, p.sent)
+	return out
+}
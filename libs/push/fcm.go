@@ -0,0 +1,213 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	fcmScope       = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmSendURL     = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	fcmHTTPTimeout = 10 * time.Second
+)
+
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMProvider sends notifications through the FCM HTTP v1 API using a Google
+// service account.
+type FCMProvider struct {
+	account serviceAccount
+	client  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMProviderFromFile loads the service account JSON at path.
+func NewFCMProviderFromFile(path string) (*FCMProvider, error) {
+	if path == "" {
+		return nil, errors.New("FCM_CREDENTIALS_FILE is not set")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var account serviceAccount
+	if err := json.Unmarshal(data, &account); err != nil {
+		return nil, fmt.Errorf("invalid service account file: %w", err)
+	}
+	if account.ProjectID == "" || account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.New("service account file is missing project_id, client_email or private_key")
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	return &FCMProvider{account: account, client: &http.Client{Timeout: fcmHTTPTimeout}}, nil
+}
+
+func (p *FCMProvider) Name() string {
+	return "fcm"
+}
+
+func (p *FCMProvider) Send(ctx context.Context, msg Message) error {
+	token, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token": msg.Token,
+			"notification": map[string]string{
+				"title": msg.Title,
+				"body":  msg.Body,
+			},
+			"data": msg.Data,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf(fcmSendURL, p.account.ProjectID), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if invalidToken(respBody) {
+		return ErrInvalidToken
+	}
+	return fmt.Errorf("fcm responded with HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+}
+
+// fcmError is the error body of the FCM HTTP v1 API.
+type fcmError struct {
+	Error struct {
+		Status  string `json:"status"`
+		Details []struct {
+			Type            string `json:"@type"`
+			ErrorCode       string `json:"errorCode"`
+			FieldViolations []struct {
+				Field string `json:"field"`
+			} `json:"fieldViolations"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// invalidToken reports whether an FCM error body says the registration token
+// is gone (UNREGISTERED) or malformed (INVALID_ARGUMENT on the token field).
+// Other errors, including a 404 for a wrong project, leave the token alone.
+func invalidToken(body []byte) bool {
+	var resp fcmError
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+	for _, detail := range resp.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return true
+		}
+	}
+	if resp.Error.Status != "INVALID_ARGUMENT" {
+		return false
+	}
+	for _, detail := range resp.Error.Details {
+		for _, violation := range detail.FieldViolations {
+			if violation.Field == "message.token" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// token returns a cached OAuth2 access token, exchanging a signed service
+// account assertion for a new one when it is about to expire.
+func (p *FCMProvider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.accessToken != "" && time.Until(p.expiresAt) > time.Minute {
+		return p.accessToken, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(p.account.PrivateKey))
+	if err != nil {
+		return "", fmt.Errorf("invalid service account private key: %w", err)
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   p.account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   p.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token exchange failed with HTTP %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", err
+	}
+
+	p.accessToken = payload.AccessToken
+	p.expiresAt = now.Add(time.Duration(payload.ExpiresIn) * time.Second)
+	return p.accessToken, nil
+}
@@ -0,0 +1,44 @@
+package push
+
+import "testing"
+
+func TestInvalidToken(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want bool
+	}{
+		{
+			name: "unregistered",
+			body: `{"error":{"code":404,"status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`,
+			want: true,
+		},
+		{
+			name: "malformed token",
+			body: `{"error":{"code":400,"status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"INVALID_ARGUMENT"},{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"message.token","description":"Invalid registration token"}]}]}}`,
+			want: true,
+		},
+		{
+			name: "invalid payload",
+			body: `{"error":{"code":400,"status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"message.data[0].value","description":"Invalid value"}]}]}}`,
+			want: false,
+		},
+		{
+			name: "unknown project",
+			body: `{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND"}}`,
+			want: false,
+		},
+		{
+			name: "not json",
+			body: `<html>Not Found</html>`,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := invalidToken([]byte(tt.body)); got != tt.want {
+				t.Errorf("invalidToken = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Package push delivers notifications to devices through a pluggable
+// provider.
+package push
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+)
+
+// ErrInvalidToken is returned when the provider rejects the device token as
+// unknown or expired.
+var ErrInvalidToken = errors.New("push token is not registered")
+
+// Message is a notification addressed to a single device token.
+type Message struct {
+	Token string
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// Provider sends push notifications.
+type Provider interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// FromEnv builds the provider selected by PUSH_PROVIDER. "fcm" uses Firebase
+// Cloud Messaging configured through FCM_CREDENTIALS_FILE; any other value,
+// or an FCM configuration error, falls back to the local fake provider.
+func FromEnv() Provider {
+	if os.Getenv("PUSH_PROVIDER") == "fcm" {
+		provider, err := NewFCMProviderFromFile(os.Getenv("FCM_CREDENTIALS_FILE"))
+		if err == nil {
+			return provider
+		}
+		log.Printf("WARNING: could not configure FCM push provider, using fake provider: %v", err)
+	}
+	return NewFakeProvider()
+}
@@ -0,0 +1,78 @@
+// Package timezone centralizes the reference time zone used to split time
+// into calendar days for scraping, event queries and statistics.
+package timezone
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+	// The runtime image ships without zoneinfo, so embed the IANA database.
+	_ "time/tzdata"
+)
+
+const defaultZone = "UTC"
+
+var (
+	referenceOnce sync.Once
+	reference     *time.Location
+)
+
+// Reference returns the configured reference time zone. It can be set via
+// the APP_TIMEZONE environment variable using an IANA name such as
+// "America/Mexico_City" and defaults to UTC.
+func Reference() *time.Location {
+	referenceOnce.Do(func() {
+		name := os.Getenv("APP_TIMEZONE")
+		if name == "" {
+			name = defaultZone
+		}
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			log.Printf("WARNING: invalid APP_TIMEZONE %q, falling back to %s: %v", name, defaultZone, err)
+			loc = time.UTC
+		}
+		reference = loc
+	})
+	return reference
+}
+
+// Resolve returns the location named by an IANA time zone name, or the
+// reference time zone when name is empty.
+func Resolve(name string) (*time.Location, error) {
+	if name == "" {
+		return Reference(), nil
+	}
+	return time.LoadLocation(name)
+}
+
+// StartOfDay returns midnight of the calendar day t falls on in loc.
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// DayBounds returns the half-open interval [start, end) of the calendar day
+// t falls on in loc. Days crossing a DST transition last 23 or 25 hours.
+func DayBounds(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	start := StartOfDay(t, loc)
+	return start, start.AddDate(0, 0, 1)
+}
+
+// MonthBounds returns the half-open interval [start, end) of the calendar
+// month t falls on in loc.
+func MonthBounds(t time.Time, loc *time.Location) (time.Time, time.Time) {
+	t = t.In(loc)
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// ParseDay parses a "2006-01-02" date as the start of that day in loc.
+func ParseDay(date string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(time.DateOnly, date, loc)
+}
+
+// FormatDay formats the calendar day t falls on in loc as "2006-01-02".
+func FormatDay(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(time.DateOnly)
+}
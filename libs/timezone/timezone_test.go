@@ -0,0 +1,103 @@
+package timezone
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestDayBoundsAcrossDST(t *testing.T) {
+	tests := []struct {
+		name  string
+		zone  string
+		at    string
+		start string
+		end   string
+		hours float64
+	}{
+		{"regular day", "America/New_York", "2026-06-10T15:04:05-04:00", "2026-06-10T00:00:00-04:00", "2026-06-11T00:00:00-04:00", 24},
+		{"spring forward", "America/New_York", "2026-03-08T12:00:00-04:00", "2026-03-08T00:00:00-05:00", "2026-03-09T00:00:00-04:00", 23},
+		{"spring forward before the gap", "America/New_York", "2026-03-08T01:30:00-05:00", "2026-03-08T00:00:00-05:00", "2026-03-09T00:00:00-04:00", 23},
+		{"fall back", "America/New_York", "2026-11-01T12:00:00-05:00", "2026-11-01T00:00:00-04:00", "2026-11-02T00:00:00-05:00", 25},
+		{"fall back in the repeated hour", "America/New_York", "2026-11-01T01:30:00-05:00", "2026-11-01T00:00:00-04:00", "2026-11-02T00:00:00-05:00", 25},
+		{"spring forward in Europe", "Europe/Madrid", "2026-03-29T23:59:59+02:00", "2026-03-29T00:00:00+01:00", "2026-03-30T00:00:00+02:00", 23},
+		{"fall back in Europe", "Europe/Madrid", "2026-10-25T00:00:00+02:00", "2026-10-25T00:00:00+02:00", "2026-10-26T00:00:00+01:00", 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("load %s: %v", tt.zone, err)
+			}
+			start, end := DayBounds(mustParse(t, tt.at), loc)
+			if want := mustParse(t, tt.start); !start.Equal(want) {
+				t.Errorf("start = %s, want %s", start, want)
+			}
+			if want := mustParse(t, tt.end); !end.Equal(want) {
+				t.Errorf("end = %s, want %s", end, want)
+			}
+			if got := end.Sub(start).Hours(); got != tt.hours {
+				t.Errorf("day lasts %vh, want %vh", got, tt.hours)
+			}
+		})
+	}
+}
+
+func TestParseAndFormatDayAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		date  string
+		start string
+	}{
+		{"2026-03-08", "2026-03-08T00:00:00-05:00"},
+		{"2026-03-09", "2026-03-09T00:00:00-04:00"},
+		{"2026-11-01", "2026-11-01T00:00:00-04:00"},
+		{"2026-11-02", "2026-11-02T00:00:00-05:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			day, err := ParseDay(tt.date, loc)
+			if err != nil {
+				t.Fatalf("ParseDay: %v", err)
+			}
+			if want := mustParse(t, tt.start); !day.Equal(want) {
+				t.Errorf("ParseDay = %s, want %s", day, want)
+			}
+			// The last second of the day must still format as the same day.
+			_, end := DayBounds(day, loc)
+			if got := FormatDay(end.Add(-time.Second), loc); got != tt.date {
+				t.Errorf("FormatDay(end-1s) = %s, want %s", got, tt.date)
+			}
+		})
+	}
+}
+
+func TestMonthBoundsAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start, end := MonthBounds(mustParse(t, "2026-03-15T12:00:00-04:00"), loc)
+	if want := mustParse(t, "2026-03-01T00:00:00-05:00"); !start.Equal(want) {
+		t.Errorf("start = %s, want %s", start, want)
+	}
+	if want := mustParse(t, "2026-04-01T00:00:00-04:00"); !end.Equal(want) {
+		t.Errorf("end = %s, want %s", end, want)
+	}
+}
+
+func mustParse(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse %s: %v", value, err)
+	}
+	return parsed
+}
@@ -0,0 +1,100 @@
+// Package webhook signs and sends outbound webhook notifications.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	sendTimeout = 10 * time.Second
+)
+
+var client = &http.Client{Timeout: sendTimeout}
+
+// Envelope is the JSON body posted to subscribers.
+type Envelope struct {
+	Type      string `json:"type"`
+	CreatedAt int64  `json:"created_at"`
+	Data      any    `json:"data"`
+}
+
+// Result describes the outcome of a single delivery attempt.
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// OK reports whether the receiver accepted the delivery.
+func (r Result) OK() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed by
+// secret. Receivers recompute it to authenticate the payload and reject
+// stale timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts body to url with the signature headers.
+func Send(url, secret, eventType string, deliveryID uint, body []byte) Result {
+	begin := time.Now()
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Result{Err: err}
+	}
+
+	timestamp := begin.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sofascore-scrapper-webhooks/1")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(deliveryID), 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{Duration: time.Since(begin), Err: err}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	result := Result{StatusCode: resp.StatusCode, Duration: time.Since(begin)}
+	if !result.OK() {
+		result.Err = fmt.Errorf("receiver responded with HTTP %d", resp.StatusCode)
+	}
+	return result
+}
+
+// MatchData is the payload of match related notifications.
+type MatchData struct {
+	EventID           int64  `json:"event_id"`
+	Slug              string `json:"slug"`
+	Sport             string `json:"sport"`
+	TournamentID      uint   `json:"tournament_id"`
+	HomeTeamID        int64  `json:"home_team_id"`
+	AwayTeamID        int64  `json:"away_team_id"`
+	HomeScore         int    `json:"home_score"`
+	AwayScore         int    `json:"away_score"`
+	StatusType        string `json:"status_type"`
+	StatusDescription string `json:"status_description"`
+	StartTimestamp    int64  `json:"start_timestamp"`
+}
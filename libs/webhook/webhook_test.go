@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSendSignsPayload(t *testing.T) {
+	const secret = "s3cret"
+	body := []byte(`{"type":"match.started","created_at":1,"data":{}}`)
+
+	var got *http.Request
+	var gotBody []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	result := Send(receiver.URL, secret, "match.started", 42, body)
+	if !result.OK() {
+		t.Fatalf("Send failed: status %d, err %v", result.StatusCode, result.Err)
+	}
+
+	if got.Method != http.MethodPost {
+		t.Errorf("method = %s, want POST", got.Method)
+	}
+	if string(gotBody) != string(body) {
+		t.Errorf("body = %s, want %s", gotBody, body)
+	}
+	if h := got.Header.Get(HeaderEvent); h != "match.started" {
+		t.Errorf("%s = %q", HeaderEvent, h)
+	}
+	if h := got.Header.Get(HeaderDelivery); h != "42" {
+		t.Errorf("%s = %q", HeaderDelivery, h)
+	}
+
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", HeaderTimestamp, err)
+	}
+	if age := time.Since(time.Unix(timestamp, 0)); age < 0 || age > time.Minute {
+		t.Errorf("timestamp is %s old", age)
+	}
+	if want := "sha256=" + Sign(secret, timestamp, gotBody); got.Header.Get(HeaderSignature) != want {
+		t.Errorf("%s = %q, want %q", HeaderSignature, got.Header.Get(HeaderSignature), want)
+	}
+	if other := "sha256=" + Sign("other", timestamp, gotBody); got.Header.Get(HeaderSignature) == other {
+		t.Error("signature does not depend on the secret")
+	}
+}
+
+func TestSendReportsReceiverFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		ok     bool
+	}{
+		{"accepted", http.StatusOK, true},
+		{"server error", http.StatusInternalServerError, false},
+		{"client error", http.StatusGone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer receiver.Close()
+
+			result := Send(receiver.URL, "secret", "goal.scored", 1, []byte("{}"))
+			if result.OK() != tt.ok {
+				t.Errorf("OK() = %v, want %v (err %v)", result.OK(), tt.ok, result.Err)
+			}
+			if result.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", result.StatusCode, tt.status)
+			}
+			if !tt.ok && result.Err == nil {
+				t.Error("failed delivery has no error")
+			}
+		})
+	}
+}
+
+func TestSendReportsUnreachableReceiver(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	result := Send(url, "secret", "goal.scored", 1, []byte("{}"))
+	if result.OK() || result.Err == nil {
+		t.Fatalf("Send to a closed receiver succeeded: %+v", result)
+	}
+}
+
+func TestSignIsStable(t *testing.T) {
+	// Receivers reimplement the signature, so its format must not change. The
+	// expected value is HMAC-SHA256("secret", "1700000000.{\"a\":1}").
+	const want = "49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686"
+	if got := Sign("secret", 1700000000, []byte(`{"a":1}`)); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+}
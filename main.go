@@ -1,21 +1,55 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/jeriveromartinez/sofascore-scrapper/api"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 	"github.com/jeriveromartinez/sofascore-scrapper/scheduler"
 )
 
 func main() {
 	models.Migrate()
+	if err := repository.EnsureAdminUser(); err != nil {
+		log.Printf("failed to ensure an admin user: %v", err)
+	}
+	if err := repository.SeedCatalog(); err != nil {
+		log.Printf("failed to seed sports and trending countries: %v", err)
+	}
+	if err := repository.BackfillEventSports(); err != nil {
+		log.Printf("failed to backfill event sports: %v", err)
+	}
+	if err := repository.BackfillPlaybackHeartbeats(); err != nil {
+		log.Printf("failed to backfill playback heartbeats: %v", err)
+	}
+	if err := repository.SeedNotificationTemplates(); err != nil {
+		log.Printf("failed to seed notification templates: %v", err)
+	}
+	if err := repository.SeedTranslations(); err != nil {
+		log.Printf("failed to seed translations: %v", err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	repository.StartIngest()
 	scheduler.Begin()
 	addr := os.Getenv("API_ADDR")
 	if addr == "" {
 		addr = ":8080"
 	}
 	log.Println("Starting API server and scheduler...")
-	api.Start(addr)
+	api.Start(ctx, addr)
+
+	log.Println("Writing buffered playback and heartbeat data...")
+	flushCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if err := repository.StopIngest(flushCtx); err != nil {
+		log.Printf("failed to write buffered data, the rest was spooled: %v", err)
+	}
 }
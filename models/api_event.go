@@ -1,6 +1,9 @@
 package models
 
-import "fmt"
+import (
+	"fmt"
+	"maps"
+)
 
 type TeamApi struct {
 	ID     int64  `json:"id"`
@@ -10,6 +13,18 @@ type TeamApi struct {
 		Secondary string `json:"secondary"`
 		Text      string `json:"text"`
 	} `json:"teamColors"`
+	FieldTranslations FieldTranslationsApi `json:"fieldTranslations"`
+}
+
+// FieldTranslationsApi holds the names SofaScore provides per language.
+type FieldTranslationsApi struct {
+	NameTranslation map[string]string `json:"nameTranslation"`
+}
+
+type SportApi struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
 }
 
 type APIEvent struct {
@@ -38,16 +53,23 @@ type APIEvent struct {
 	} `json:"status"`
 
 	Tournament struct {
-		ID               int64  `json:"id"`
-		Name             string `json:"name"`
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		Category struct {
+			Name  string   `json:"name"`
+			Slug  string   `json:"slug"`
+			Sport SportApi `json:"sport"`
+		} `json:"category"`
 		UniqueTournament struct {
 			ID       int64  `json:"id"`
 			Name     string `json:"name"`
 			Slug     string `json:"slug"`
 			Category struct {
-				Name string `json:"name"`
-				Slug string `json:"slug"`
+				Name  string   `json:"name"`
+				Slug  string   `json:"slug"`
+				Sport SportApi `json:"sport"`
 			} `json:"category"`
+			FieldTranslations FieldTranslationsApi `json:"fieldTranslations"`
 		} `json:"uniqueTournament"`
 	} `json:"tournament"`
 
@@ -79,6 +101,17 @@ type APIEvent struct {
 	Time struct {
 		CurrentPeriodStartTimestamp int64 `json:"currentPeriodStartTimestamp"`
 	} `json:"time"`
+
+	Venue *struct {
+		Name string `json:"name"`
+		City struct {
+			Name string `json:"name"`
+		} `json:"city"`
+	} `json:"venue"`
+
+	Referee *struct {
+		Name string `json:"name"`
+	} `json:"referee"`
 }
 
 func (t *TeamApi) ToSofaScoreTeam() Team {
@@ -92,9 +125,51 @@ func (t *TeamApi) ToSofaScoreTeam() Team {
 	}
 }
 
+// Translations returns the team and tournament names SofaScore provides in
+// other languages. The untranslated name is SofaScore's English source string
+// and is kept as the English translation unless one is provided.
+func (e *APIEvent) Translations() []Translation {
+	var translations []Translation
+	add := func(entityType string, id int64, source string, names map[string]string) {
+		if _, ok := names["en"]; !ok && source != "" {
+			names = maps.Clone(names)
+			if names == nil {
+				names = make(map[string]string, 1)
+			}
+			names["en"] = source
+		}
+		for locale, name := range names {
+			if name == "" {
+				continue
+			}
+			translations = append(translations, Translation{
+				EntityType: entityType,
+				EntityKey:  fmt.Sprint(id),
+				Locale:     locale,
+				Name:       name,
+				Source:     TranslationSourceScraped,
+			})
+		}
+	}
+	add(TranslationTeam, e.HomeTeam.ID, e.HomeTeam.Name, e.HomeTeam.FieldTranslations.NameTranslation)
+	add(TranslationTeam, e.AwayTeam.ID, e.AwayTeam.Name, e.AwayTeam.FieldTranslations.NameTranslation)
+	add(TranslationTournament, e.Tournament.UniqueTournament.ID, e.Tournament.UniqueTournament.Name, e.Tournament.UniqueTournament.FieldTranslations.NameTranslation)
+	return translations
+}
+
+// SportSlug returns the sport the event belongs to as reported by the payload,
+// or an empty string when SofaScore did not include it.
+func (e *APIEvent) SportSlug() string {
+	if slug := e.Tournament.Category.Sport.Slug; slug != "" {
+		return slug
+	}
+	return e.Tournament.UniqueTournament.Category.Sport.Slug
+}
+
 func (e *APIEvent) ToSofaScoreEvent() SofaScoreEvent {
 	return SofaScoreEvent{
 		SofaScoreEventId:            e.ID,
+		Sport:                       e.SportSlug(),
 		HomeScore:                   e.HomeScore.Current,
 		HomeTeamId:                  e.HomeTeam.ID,
 		AwayScore:                   e.AwayScore.Current,
@@ -103,5 +178,35 @@ func (e *APIEvent) ToSofaScoreEvent() SofaScoreEvent {
 		CurrentPeriodStartTimestamp: e.Time.CurrentPeriodStartTimestamp,
 		Slug:                        e.Slug,
 		LeagueId:                    uint(e.Tournament.UniqueTournament.ID),
+		StatusCode:                  e.Status.Code,
+		StatusType:                  e.Status.Type,
+		StatusDescription:           e.Status.Description,
+		Round:                       e.RoundInfo.Round,
+		RoundName:                   e.RoundInfo.Name,
+		Season:                      e.Season.Name,
+		Venue:                       e.venueName(),
+		VenueCity:                   e.venueCity(),
+		Referee:                     e.refereeName(),
+	}
+}
+
+func (e *APIEvent) venueName() string {
+	if e.Venue == nil {
+		return ""
+	}
+	return e.Venue.Name
+}
+
+func (e *APIEvent) venueCity() string {
+	if e.Venue == nil {
+		return ""
+	}
+	return e.Venue.City.Name
+}
+
+func (e *APIEvent) refereeName() string {
+	if e.Referee == nil {
+		return ""
 	}
+	return e.Referee.Name
 }
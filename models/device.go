@@ -1,14 +1,57 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Device struct {
 	gorm.Model
-	UserID   *uint
-	Token    string `gorm:"uniqueIndex;not null"`
-	Platform string
-	Name     string
-	LastSeen int64
-	Version  string
-	Manager  *User `gorm:"foreignKey:UserID"`
+	UserID      *uint
+	Token       string `gorm:"uniqueIndex;not null"`
+	Platform    string
+	Name        string
+	LastSeen    int64
+	Version     string
+	Country     string `gorm:"size:2"`
+	PackageName string `gorm:"size:191;index"`
+	Locale      string `gorm:"size:16"`
+	Fingerprint string `gorm:"size:128;index"`
+	BlockedAt   int64
+	BlockReason string `gorm:"size:255"`
+	// PushDisabledAt is set when the push provider rejects Token; the device
+	// gets no notifications until it registers again.
+	PushDisabledAt int64
+	Manager        *User `gorm:"foreignKey:UserID"`
+
+	// Secret signs the app requests of the device. PreviousSecret stays
+	// valid until PreviousSecretExpiresAt so requests in flight during a
+	// rotation are not rejected.
+	Secret                  string `gorm:"size:64" json:"-"`
+	PreviousSecret          string `gorm:"size:64" json:"-"`
+	PreviousSecretExpiresAt int64
+	RevokedAt               int64
+	// PairingRequired is set when a revoked device is reinstated. It gets no
+	// secret until it registers again with an enrollment code.
+	PairingRequired bool `gorm:"not null;default:false"`
+}
+
+// Blocked reports whether the device was disabled from the dashboard.
+func (d *Device) Blocked() bool {
+	return d.BlockedAt != 0
+}
+
+// Revoked reports whether the dashboard revoked the device credentials.
+func (d *Device) Revoked() bool {
+	return d.RevokedAt != 0
+}
+
+// SigningSecrets returns the secrets currently accepted for the device.
+func (d *Device) SigningSecrets(now time.Time) []string {
+	secrets := []string{d.Secret}
+	if d.PreviousSecret != "" && now.Unix() < d.PreviousSecretExpiresAt {
+		secrets = append(secrets, d.PreviousSecret)
+	}
+	return secrets
 }
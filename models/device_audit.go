@@ -0,0 +1,35 @@
+package models
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DeviceAuditBlocked    = "blocked"
+	DeviceAuditUnblocked  = "unblocked"
+	DeviceAuditRevoked    = "revoked"
+	DeviceAuditReinstated = "reinstated"
+	DeviceAuditEnrolled   = "enrolled"
+)
+
+var ErrDeviceQuotaExceeded = errors.New("device quota of the manager is exhausted")
+
+// DeviceAuditLog records who changed the access of a device and why. For
+// enrollments UserID is the manager that issued the code.
+type DeviceAuditLog struct {
+	gorm.Model
+	DeviceID uint   `gorm:"index;not null"`
+	UserID   *uint  `gorm:"index"`
+	Action   string `gorm:"size:32;not null"`
+	Reason   string `gorm:"size:255"`
+	User     *User  `gorm:"foreignKey:UserID"`
+}
+
+// DuplicateDevices groups the devices that reported the same hardware
+// fingerprint with different tokens.
+type DuplicateDevices struct {
+	Fingerprint string
+	Devices     []Device
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DeviceCommandCheckUpdate   = "check_update"
+	DeviceCommandClearCache    = "clear_cache"
+	DeviceCommandReloadConfig  = "reload_config"
+	DeviceCommandRestartPlayer = "restart_player"
+	DeviceCommandUploadLogs    = "upload_logs"
+)
+
+// A command is pending until a poll hands it to the device, delivered until
+// the device acknowledges it and expired when neither happened in time. A
+// delivered command is handed out again when it is not acknowledged within
+// DeviceCommandAckTimeout, so a poll response lost on the way does not
+// drop it.
+const (
+	DeviceCommandPending   = "pending"
+	DeviceCommandDelivered = "delivered"
+	DeviceCommandSucceeded = "succeeded"
+	DeviceCommandFailed    = "failed"
+	DeviceCommandExpired   = "expired"
+	DeviceCommandCancelled = "cancelled"
+)
+
+const (
+	DeviceCommandDefaultTTL = time.Hour
+	DeviceCommandMaxTTL     = 7 * 24 * time.Hour
+	DeviceCommandAckTimeout = 2 * time.Minute
+	// DeviceCommandMaxResult caps the result payload a device reports.
+	DeviceCommandMaxResult = 64 << 10
+)
+
+var ErrDeviceCommandClosed = errors.New("command is no longer open")
+
+// DeviceCommand is an action queued from the dashboard for a device.
+// Payload and Result are opaque to the server, usually JSON.
+type DeviceCommand struct {
+	gorm.Model
+	DeviceID    uint   `gorm:"not null;index:idx_device_command_status"`
+	UserID      *uint  `gorm:"index"`
+	Type        string `gorm:"size:32;not null"`
+	Payload     string `gorm:"type:text"`
+	Status      string `gorm:"size:16;not null;index:idx_device_command_status"`
+	ExpiresAt   int64  `gorm:"not null;index"`
+	DeliveredAt int64
+	CompletedAt int64
+	Result      string `gorm:"type:mediumtext"`
+	User        *User  `gorm:"foreignKey:UserID"`
+}
+
+// ValidDeviceCommandType reports whether the app understands commands of
+// type typ.
+func ValidDeviceCommandType(typ string) bool {
+	switch typ {
+	case DeviceCommandCheckUpdate, DeviceCommandClearCache, DeviceCommandReloadConfig, DeviceCommandRestartPlayer, DeviceCommandUploadLogs:
+		return true
+	}
+	return false
+}
+
+// Open reports whether the command still waits for the device.
+func (c *DeviceCommand) Open() bool {
+	return c.Status == DeviceCommandPending || c.Status == DeviceCommandDelivered
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+var ruleVersionPattern = regexp.MustCompile(`^[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*$`)
+
+// DeviceGroup targets settings at a set of devices. When a device belongs
+// to several groups the one with the highest Priority wins.
+//
+// Besides its manual members a group holds every device matching all of its
+// non-empty rules. RuleVersion matches the version itself and its
+// sub-versions, so "2.1" matches "2.1" and "2.1.4" but not "2.10".
+type DeviceGroup struct {
+	gorm.Model
+	Name          string `gorm:"size:100;uniqueIndex;not null"`
+	Description   string `gorm:"size:255"`
+	Priority      int
+	RulePackage   string `gorm:"size:191"`
+	RuleVersion   string `gorm:"size:32"`
+	RulePlatform  string `gorm:"size:32"`
+	RuleManagerID *uint
+}
+
+// HasRules reports whether the group selects devices by rules.
+func (g *DeviceGroup) HasRules() bool {
+	return g.RulePackage != "" || g.RuleVersion != "" || g.RulePlatform != "" || g.RuleManagerID != nil
+}
+
+// Matches reports whether the rules of the group select device. A group
+// without rules matches no device.
+func (g *DeviceGroup) Matches(device Device) bool {
+	if !g.HasRules() {
+		return false
+	}
+	if g.RulePackage != "" && device.PackageName != g.RulePackage {
+		return false
+	}
+	if g.RuleVersion != "" && device.Version != g.RuleVersion && !strings.HasPrefix(device.Version, g.RuleVersion+".") {
+		return false
+	}
+	if g.RulePlatform != "" && !strings.EqualFold(device.Platform, g.RulePlatform) {
+		return false
+	}
+	if g.RuleManagerID != nil && (device.UserID == nil || *device.UserID != *g.RuleManagerID) {
+		return false
+	}
+	return true
+}
+
+// ValidRuleVersion reports whether version can be used as a version rule.
+func ValidRuleVersion(version string) bool {
+	return len(version) <= 32 && ruleVersionPattern.MatchString(version)
+}
+
+type DeviceGroupMember struct {
+	gorm.Model
+	DeviceGroupID uint         `gorm:"not null;uniqueIndex:idx_device_group_member"`
+	DeviceID      uint         `gorm:"not null;uniqueIndex:idx_device_group_member;index"`
+	DeviceGroup   *DeviceGroup `gorm:"foreignKey:DeviceGroupID"`
+	Device        *Device      `gorm:"foreignKey:DeviceID"`
+}
+
+// DeviceGroupTournament is a tournament of the set shown to the members of
+// a group that have no tournaments of their own.
+type DeviceGroupTournament struct {
+	gorm.Model
+	DeviceGroupID uint        `gorm:"not null;uniqueIndex:idx_device_group_tournament"`
+	TournamentID  uint        `gorm:"not null;uniqueIndex:idx_device_group_tournament"`
+	Tournament    *Tournament `gorm:"foreignKey:TournamentID"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DeviceHeartbeatInterval is how often the app is asked to send a heartbeat.
+// It leaves room for a few missed beats before DeviceOfflineAfter.
+const DeviceHeartbeatInterval = time.Minute
+
+// DeviceHeartbeat is what the app reports on every heartbeat. Empty fields
+// keep the previous value.
+type DeviceHeartbeat struct {
+	Version     string
+	PackageName string
+	NetworkType string
+	Content     string
+}
+
+// DeviceSession is a period during which a device kept sending heartbeats.
+// EndedAt is zero while the session is open and is set to the last
+// heartbeat once the device goes silent.
+type DeviceSession struct {
+	gorm.Model
+	DeviceID        uint `gorm:"index;not null"`
+	StartedAt       int64
+	LastHeartbeatAt int64 `gorm:"index"`
+	EndedAt         int64 `gorm:"index"`
+	Version         string
+	PackageName     string  `gorm:"size:191"`
+	NetworkType     string  `gorm:"size:32"`
+	Content         string  `gorm:"size:255"`
+	Device          *Device `gorm:"foreignKey:DeviceID"`
+}
+
+// Open reports whether the session has not been closed yet.
+func (s *DeviceSession) Open() bool {
+	return s.EndedAt == 0
+}
+
+// Span returns the part of the session that falls in [from, to), in Unix
+// seconds. Open sessions are counted up to their last heartbeat.
+func (s *DeviceSession) Span(from, to int64) int64 {
+	end := s.EndedAt
+	if end == 0 {
+		end = s.LastHeartbeatAt
+	}
+	start := max(s.StartedAt, from)
+	end = min(end, to)
+	if end <= start {
+		return 0
+	}
+	return end - start
+}
+
+// DeviceUptime is the activity of a device over [From, To).
+type DeviceUptime struct {
+	DeviceID uint
+	From     int64
+	To       int64
+	Seconds  int64
+	Sessions []DeviceSession
+}
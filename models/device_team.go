@@ -0,0 +1,12 @@
+package models
+
+import "gorm.io/gorm"
+
+// DeviceTeam is a favorite team of a device. TeamID is the SofaScore team ID.
+type DeviceTeam struct {
+	gorm.Model
+	DeviceID uint    `gorm:"not null;index:idx_device_team,unique" json:"device_id"`
+	TeamID   int64   `gorm:"not null;index:idx_device_team,unique" json:"team_id"`
+	Device   *Device `gorm:"foreignKey:DeviceID" json:"device,omitempty"`
+	Team     *Team   `gorm:"foreignKey:TeamID;references:TeamId" json:"team,omitempty"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	EnrollmentCodeLength = 8
+	EnrollmentCodeTTL    = 15 * time.Minute
+	EnrollmentCodeMaxTTL = 24 * time.Hour
+)
+
+// EnrollmentCodeAlphabet leaves out characters that are easily confused
+// when typed from a TV screen (0/O, 1/I/L).
+const EnrollmentCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+var ErrEnrollmentCodeInvalid = errors.New("enrollment code is invalid or expired")
+
+// ErrDevicePairedElsewhere is returned when a code of a manager would take
+// over a device another manager already owns.
+var ErrDevicePairedElsewhere = errors.New("device is paired with another manager")
+
+// EnrollmentCode pairs the device that redeems it with the manager that
+// generated it. Codes are single use.
+type EnrollmentCode struct {
+	gorm.Model
+	UserID     uint   `gorm:"index;not null"`
+	Code       string `gorm:"size:16;uniqueIndex;not null"`
+	ExpiresAt  int64  `gorm:"index"`
+	RedeemedAt int64
+	DeviceID   *uint
+	User       *User   `gorm:"foreignKey:UserID"`
+	Device     *Device `gorm:"foreignKey:DeviceID"`
+}
+
+// Redeemable reports whether the code can still pair a device at now.
+func (e *EnrollmentCode) Redeemable(now time.Time) bool {
+	return e.RedeemedAt == 0 && now.Unix() < e.ExpiresAt
+}
@@ -0,0 +1,90 @@
+package models
+
+import "slices"
+
+const (
+	MatchStarted = "started"
+	MatchGoal    = "goal"
+	MatchEnded   = "ended"
+)
+
+// goalSports are the sports where a score increase is reported as a goal.
+var goalSports = []string{"football", "futsal", "ice-hockey", "handball"}
+
+// EventChange describes an event whose score or status changed during a
+// scrape. Previous is nil when the event was seen for the first time.
+type EventChange struct {
+	Event    SofaScoreEvent
+	Previous *SofaScoreEvent
+}
+
+// Transitions classifies an update of a known event into the match moments
+// partners and devices are notified about: MatchStarted, MatchGoal and
+// MatchEnded. New events have no transitions.
+func (c EventChange) Transitions() []string {
+	if c.Previous == nil {
+		return nil
+	}
+	prev, next := c.Previous, c.Event
+	var transitions []string
+	if prev.StatusType == "notstarted" && next.StatusType == "inprogress" {
+		transitions = append(transitions, MatchStarted)
+	}
+	if slices.Contains(goalSports, next.Sport) && (next.HomeScore > prev.HomeScore || next.AwayScore > prev.AwayScore) {
+		transitions = append(transitions, MatchGoal)
+	}
+	if prev.StatusType != "finished" && next.StatusType == "finished" {
+		transitions = append(transitions, MatchEnded)
+	}
+	return transitions
+}
+
+// ScoreOrStatusChanged reports whether next differs from e in any field the
+// live consumers care about. A missing sport never counts as a change.
+func (e *SofaScoreEvent) ScoreOrStatusChanged(next *SofaScoreEvent) bool {
+	return e.HomeScore != next.HomeScore ||
+		e.AwayScore != next.AwayScore ||
+		e.StatusCode != next.StatusCode ||
+		e.StatusType != next.StatusType ||
+		e.StartTimestamp != next.StartTimestamp ||
+		e.CurrentPeriodStartTimestamp != next.CurrentPeriodStartTimestamp ||
+		(next.Sport != "" && e.Sport != next.Sport)
+}
+
+// DetailsChanged reports whether next carries round, season, venue or
+// referee information that differs from e. Missing values never replace
+// stored ones.
+func (e *SofaScoreEvent) DetailsChanged(next *SofaScoreEvent) bool {
+	return (next.Round != 0 && e.Round != next.Round) ||
+		(next.RoundName != "" && e.RoundName != next.RoundName) ||
+		(next.Season != "" && e.Season != next.Season) ||
+		(next.Venue != "" && e.Venue != next.Venue) ||
+		(next.VenueCity != "" && e.VenueCity != next.VenueCity) ||
+		(next.Referee != "" && e.Referee != next.Referee)
+}
+
+// ResultFor returns the outcome of a finished event for teamID: "W", "D" or
+// "L".
+func (e *SofaScoreEvent) ResultFor(teamID int64) string {
+	own, other := e.HomeScore, e.AwayScore
+	if teamID == e.AwayTeamId {
+		own, other = other, own
+	}
+	switch {
+	case own > other:
+		return "W"
+	case own < other:
+		return "L"
+	default:
+		return "D"
+	}
+}
+
+// EventDetail is an event with the recent results of both teams and their
+// previous meetings.
+type EventDetail struct {
+	Event      SofaScoreEvent
+	HomeLast   []SofaScoreEvent
+	AwayLast   []SofaScoreEvent
+	HeadToHead []SofaScoreEvent
+}
@@ -0,0 +1,14 @@
+package models
+
+import "gorm.io/gorm"
+
+// EventTrending ranks an event inside the trending list SofaScore publishes
+// for a given country.
+type EventTrending struct {
+	gorm.Model
+	CountryCode      string          `gorm:"size:2;not null;index:idx_trending_country_event,unique" json:"country_code"`
+	SofaScoreEventId int64           `gorm:"not null;index:idx_trending_country_event,unique" json:"sofa_score_event_id"`
+	Rank             int             `gorm:"not null" json:"rank"`
+	FetchedAt        int64           `gorm:"not null;index" json:"fetched_at"`
+	Event            *SofaScoreEvent `gorm:"foreignKey:SofaScoreEventId;references:SofaScoreEventId" json:"event,omitempty"`
+}
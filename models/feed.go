@@ -0,0 +1,96 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	FeedOrderLiveFirst     = "live_first"
+	FeedOrderChronological = "chronological"
+)
+
+// FeedSettings configures the current-events feed of a device, or of every
+// device of an app package when DeviceID is nil. Device settings take
+// precedence over package settings, which take precedence over the defaults.
+type FeedSettings struct {
+	gorm.Model
+	DeviceID       *uint   `gorm:"index" json:"device_id"`
+	PackageName    string  `gorm:"size:191;index" json:"package_name"`
+	MaxItems       int     `json:"max_items"`
+	LookAheadHours int     `json:"look_ahead_hours"`
+	RecentHours    int     `json:"recent_hours"`
+	Ordering       string  `gorm:"size:16" json:"ordering"`
+	Sports         string  `json:"sports"`
+	Device         *Device `gorm:"foreignKey:DeviceID" json:"device,omitempty"`
+}
+
+// DefaultFeedSettings is used when neither the device nor its package has
+// settings.
+func DefaultFeedSettings() FeedSettings {
+	return FeedSettings{
+		MaxItems:       6,
+		LookAheadHours: 7 * 24,
+		RecentHours:    12,
+		Ordering:       FeedOrderLiveFirst,
+	}
+}
+
+// SportList returns the sports the feed is restricted to; empty means all.
+func (f *FeedSettings) SportList() []string {
+	if f.Sports == "" {
+		return nil
+	}
+	return strings.Split(f.Sports, ",")
+}
+
+// FeedCursor points after the last upcoming event of a feed page.
+type FeedCursor struct {
+	StartTimestamp int64
+	ID             uint
+}
+
+// ParseFeedCursor parses a cursor produced by FeedCursor.String. An empty
+// string is the zero cursor, which requests the first page.
+func ParseFeedCursor(s string) (FeedCursor, error) {
+	if s == "" {
+		return FeedCursor{}, nil
+	}
+	start, id, ok := strings.Cut(s, ".")
+	startTimestamp, err := strconv.ParseInt(start, 10, 64)
+	if !ok || err != nil {
+		return FeedCursor{}, errors.New("invalid feed cursor")
+	}
+	parsedID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return FeedCursor{}, errors.New("invalid feed cursor")
+	}
+	return FeedCursor{StartTimestamp: startTimestamp, ID: uint(parsedID)}, nil
+}
+
+func (c FeedCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(c.StartTimestamp, 10) + "." + strconv.FormatUint(uint64(c.ID), 10)
+}
+
+// IsZero reports whether the cursor requests the first page.
+func (c FeedCursor) IsZero() bool {
+	return c.StartTimestamp == 0 && c.ID == 0
+}
+
+// EventFeed is a page of the current-events feed. Live and Recent are only
+// filled on the first page; Upcoming is paginated with NextCursor. Data is
+// the combined list in the configured order for clients that do not use the
+// sections.
+type EventFeed struct {
+	Data       []SofaScoreEvent
+	Live       []SofaScoreEvent
+	Upcoming   []SofaScoreEvent
+	Recent     []SofaScoreEvent
+	NextCursor FeedCursor
+}
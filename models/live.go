@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// DeviceOfflineAfter is how long a device may stay silent before it is
+// considered offline.
+const DeviceOfflineAfter = 5 * time.Minute
+
+// DeviceStatusChange reports a device coming online or going offline.
+type DeviceStatusChange struct {
+	DeviceID uint
+	Name     string
+	Online   bool
+	LastSeen int64
+}
+
+// JobRun summarizes one execution of a background job.
+type JobRun struct {
+	Name      string
+	StartedAt time.Time
+	Duration  time.Duration
+	Tasks     int
+	Skipped   int
+	Err       error
+}
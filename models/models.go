@@ -15,12 +15,35 @@ func Migrate() {
 		&User{},
 		&RefreshToken{},
 		&Device{},
+		&EnrollmentCode{},
+		&DeviceAuditLog{},
+		&DeviceSession{},
+		&DeviceCommand{},
+		&DeviceGroup{},
+		&DeviceGroupMember{},
+		&DeviceGroupTournament{},
+		&ConfigKey{},
+		&ConfigValue{},
+		&Plan{},
+		&DeviceSubscription{},
 		&PlaybackLog{},
 		&ApkVersion{},
 		&DeviceTournament{},
+		&DeviceTeam{},
+		&FeedSettings{},
+		&Translation{},
 		&GlobalTournamentConfig{},
 		&ContentStat{},
 		&CrashReport{},
+		&EventTrending{},
+		&Sport{},
+		&TrendingCountry{},
+		&WebhookSubscription{},
+		&WebhookDelivery{},
+		&WebhookAttempt{},
+		&NotificationSubscription{},
+		&NotificationTemplate{},
+		&NotificationLog{},
 	); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	NotificationKickoffReminder = "kickoff_reminder"
+	NotificationKickoff         = "kickoff"
+	NotificationGoal            = "goal"
+	NotificationFinalScore      = "final_score"
+)
+
+const (
+	NotificationTargetTeam  = "team"
+	NotificationTargetEvent = "event"
+)
+
+const (
+	NotificationStatusPending = "pending"
+	NotificationStatusSent    = "sent"
+	NotificationStatusFailed  = "failed"
+)
+
+// NotificationSubscription subscribes a device to the notifications of a team
+// or of a single event. TargetID is a SofaScore team or event ID.
+type NotificationSubscription struct {
+	gorm.Model
+	DeviceID   uint   `gorm:"not null;uniqueIndex:idx_notification_subscription" json:"device_id"`
+	TargetType string `gorm:"size:16;not null;uniqueIndex:idx_notification_subscription" json:"target_type"`
+	TargetID   int64  `gorm:"not null;uniqueIndex:idx_notification_subscription" json:"target_id"`
+}
+
+// NotificationTemplate is the title and body used for a notification kind.
+// Both accept the {home}, {away}, {home_score}, {away_score}, {tournament}
+// and {minutes} placeholders.
+type NotificationTemplate struct {
+	gorm.Model
+	Kind  string `gorm:"size:32;uniqueIndex;not null" json:"kind"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// Render fills the template placeholders with values.
+func (t *NotificationTemplate) Render(values map[string]string) (string, string) {
+	pairs := make([]string, 0, len(values)*2)
+	for key, value := range values {
+		pairs = append(pairs, "{"+key+"}", value)
+	}
+	replacer := strings.NewReplacer(pairs...)
+	return replacer.Replace(t.Title), replacer.Replace(t.Body)
+}
+
+// NotificationLog records every notification sent to a device. DedupKey is
+// unique so the same notification is never sent twice. Entries are written as
+// pending before the push is attempted and updated with its outcome.
+type NotificationLog struct {
+	gorm.Model
+	DeviceID         uint    `gorm:"index" json:"device_id"`
+	SofaScoreEventId int64   `gorm:"index" json:"sofa_score_event_id"`
+	Kind             string  `gorm:"size:32" json:"kind"`
+	DedupKey         string  `gorm:"size:191;uniqueIndex;not null" json:"-"`
+	Title            string  `json:"title"`
+	Body             string  `json:"body"`
+	Provider         string  `gorm:"size:16" json:"provider"`
+	Status           string  `gorm:"size:16;index" json:"status"`
+	Error            string  `gorm:"type:text" json:"error"`
+	Device           *Device `gorm:"foreignKey:DeviceID" json:"device,omitempty"`
+}
+
+// NotificationDedupKey identifies a notification of kind about an event for a
+// device. Goals include the score so every goal is notified once.
+func NotificationDedupKey(deviceID uint, event *SofaScoreEvent, kind string) string {
+	key := strconv.FormatUint(uint64(deviceID), 10) + ":" + strconv.FormatInt(event.SofaScoreEventId, 10) + ":" + kind
+	if kind == NotificationGoal {
+		key += ":" + strconv.Itoa(event.HomeScore) + "-" + strconv.Itoa(event.AwayScore)
+	}
+	return key
+}
@@ -1,11 +1,41 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"errors"
+	"time"
 
+	"gorm.io/gorm"
+)
+
+const (
+	// PlaybackHeartbeatInterval is how often the app is asked to confirm
+	// that a playback is still running.
+	PlaybackHeartbeatInterval = 30 * time.Second
+	// PlaybackTimeout is how long a tracked playback may go without
+	// heartbeats before the sweeper closes it at its last heartbeat.
+	PlaybackTimeout = 2 * time.Minute
+)
+
+var ErrPlaybackClosed = errors.New("playback has already ended")
+
+// PlaybackLog is a playback session of a device. All timestamps are Unix
+// seconds; EndedAt is zero while the playback is running.
+//
+// Tracked playbacks were started through the playback API and send their
+// own heartbeats. Playbacks reported by older apps through /devices/viewing
+// are not tracked: their last heartbeat follows the activity of the device
+// and they are closed once the device goes offline.
 type PlaybackLog struct {
 	gorm.Model
-	DeviceID  uint   `gorm:"not null;index"`
-	Content   string `gorm:"not null"`
-	StartedAt int64
-	EndedAt   int64
+	DeviceID        uint   `gorm:"not null;index"`
+	Content         string `gorm:"not null"`
+	Tracked         bool   `gorm:"not null;default:false"`
+	StartedAt       int64
+	LastHeartbeatAt int64 `gorm:"index"`
+	EndedAt         int64 `gorm:"index"`
+}
+
+// Open reports whether the playback has not ended yet.
+func (p *PlaybackLog) Open() bool {
+	return p.EndedAt == 0
 }
@@ -0,0 +1,160 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ConfigTypeString = "string"
+	ConfigTypeInt    = "int"
+	ConfigTypeFloat  = "float"
+	ConfigTypeBool   = "bool"
+	ConfigTypeJSON   = "json"
+)
+
+// Config scopes, from the least to the most specific.
+const (
+	ConfigScopeDefault = "default"
+	ConfigScopeGlobal  = "global"
+	ConfigScopePackage = "package"
+	ConfigScopeGroup   = "group"
+	ConfigScopeDevice  = "device"
+)
+
+var configKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_.]{0,99}$`)
+
+// ConfigKey declares a remote setting the app understands.
+type ConfigKey struct {
+	gorm.Model
+	Key          string `gorm:"size:100;uniqueIndex;not null"`
+	Type         string `gorm:"size:16;not null"`
+	DefaultValue string `gorm:"type:text"`
+	Description  string `gorm:"size:255"`
+}
+
+// ConfigValue overrides a key for one scope. ScopeID is empty for the
+// global scope, the package name for packages and the numeric ID for
+// groups and devices.
+type ConfigValue struct {
+	gorm.Model
+	ConfigKeyID uint       `gorm:"not null;uniqueIndex:idx_config_value"`
+	Scope       string     `gorm:"size:16;not null;uniqueIndex:idx_config_value"`
+	ScopeID     string     `gorm:"size:191;not null;uniqueIndex:idx_config_value"`
+	Value       string     `gorm:"type:text"`
+	ConfigKey   *ConfigKey `gorm:"foreignKey:ConfigKeyID"`
+}
+
+// ValidConfigKey reports whether key is a lowercase dotted identifier.
+func ValidConfigKey(key string) bool {
+	return configKeyPattern.MatchString(key)
+}
+
+// ValidConfigScope reports whether scope can hold values.
+func ValidConfigScope(scope string) bool {
+	return scope == ConfigScopeGlobal || scope == ConfigScopePackage || scope == ConfigScopeGroup || scope == ConfigScopeDevice
+}
+
+// ValidateConfigValue checks that value can be read as typ.
+func ValidateConfigValue(typ, value string) error {
+	var err error
+	switch typ {
+	case ConfigTypeString:
+	case ConfigTypeInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case ConfigTypeFloat:
+		_, err = strconv.ParseFloat(value, 64)
+	case ConfigTypeBool:
+		_, err = strconv.ParseBool(value)
+	case ConfigTypeJSON:
+		if !json.Valid([]byte(value)) {
+			err = errors.New("invalid JSON")
+		}
+	default:
+		return fmt.Errorf("unknown config type %q", typ)
+	}
+	if err != nil {
+		return fmt.Errorf("value is not a valid %s", typ)
+	}
+	return nil
+}
+
+// ConfigEntry is the effective value of a key for a device and the scope
+// it came from.
+type ConfigEntry struct {
+	Key    string
+	Type   string
+	Value  string
+	Source string
+}
+
+// ConfigBundle is the configuration served to a device. Version changes
+// whenever any effective value does.
+type ConfigBundle struct {
+	Version string
+	Entries []ConfigEntry
+}
+
+// ResolveConfig applies values to keys for a device with precedence
+// device > group > package > global > default. groupIDs must be ordered
+// from the highest to the lowest priority.
+func ResolveConfig(keys []ConfigKey, values []ConfigValue, device Device, groupIDs []uint) ConfigBundle {
+	rank := func(v ConfigValue) int {
+		switch v.Scope {
+		case ConfigScopeDevice:
+			if v.ScopeID == strconv.FormatUint(uint64(device.ID), 10) {
+				return 1 << 20
+			}
+		case ConfigScopeGroup:
+			for i, id := range groupIDs {
+				if v.ScopeID == strconv.FormatUint(uint64(id), 10) {
+					return 1<<19 - i
+				}
+			}
+		case ConfigScopePackage:
+			if device.PackageName != "" && v.ScopeID == device.PackageName {
+				return 2
+			}
+		case ConfigScopeGlobal:
+			return 1
+		}
+		return 0
+	}
+
+	best := make(map[uint]ConfigValue, len(values))
+	for _, v := range values {
+		r := rank(v)
+		if r == 0 {
+			continue
+		}
+		if current, ok := best[v.ConfigKeyID]; !ok || r > rank(current) {
+			best[v.ConfigKeyID] = v
+		}
+	}
+
+	entries := make([]ConfigEntry, 0, len(keys))
+	for _, k := range keys {
+		entry := ConfigEntry{Key: k.Key, Type: k.Type, Value: k.DefaultValue, Source: ConfigScopeDefault}
+		if v, ok := best[k.ID]; ok {
+			entry.Value = v.Value
+			entry.Source = v.Scope
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b ConfigEntry) int { return strings.Compare(a.Key, b.Key) })
+
+	hash := sha256.New()
+	for _, e := range entries {
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\n", e.Key, e.Type, e.Value)
+	}
+	return ConfigBundle{Version: hex.EncodeToString(hash.Sum(nil))[:16], Entries: entries}
+}
@@ -2,6 +2,9 @@ package models
 
 import "gorm.io/gorm"
 
+// StatusCanceled is the status type SofaScore reports for cancelled events.
+const StatusCanceled = "canceled"
+
 type SofaScoreEvent struct {
 	gorm.Model
 	SofaScoreEventId            int64 `gorm:"uniqueIndex"`
@@ -15,6 +18,15 @@ type SofaScoreEvent struct {
 	CurrentPeriodStartTimestamp int64
 	Slug                        string
 	LeagueId                    uint
+	StatusCode                  int
+	StatusType                  string
+	StatusDescription           string
+	Round                       int
+	RoundName                   string
+	Season                      string
+	Venue                       string
+	VenueCity                   string
+	Referee                     string
 	HomeTeamModel               *Team       `gorm:"foreignKey:HomeTeamId;references:TeamId" json:"teamHome,omitempty"`
 	AwayTeamModel               *Team       `gorm:"foreignKey:AwayTeamId;references:TeamId" json:"teamAway,omitempty"`
 	League                      *Tournament `gorm:"foreignKey:LeagueId" json:"league,omitempty"`
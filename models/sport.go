@@ -0,0 +1,11 @@
+package models
+
+import "gorm.io/gorm"
+
+// Sport is a SofaScore sport slug the scheduler scrapes when enabled.
+type Sport struct {
+	gorm.Model
+	Slug    string `gorm:"size:64;not null;uniqueIndex" json:"slug"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Codes of the SubscriptionError returned to the app.
+const (
+	SubscriptionErrorRequired    = "subscription_required"
+	SubscriptionErrorExpired     = "subscription_expired"
+	SubscriptionErrorUnavailable = "plan_feature_unavailable"
+)
+
+// Plan is a product sold per device. Zero limits mean unlimited.
+type Plan struct {
+	gorm.Model
+	Name           string `gorm:"size:100;uniqueIndex;not null"`
+	DurationDays   int
+	MaxTournaments int
+	LiveUpdates    bool
+}
+
+// DeviceSubscription is the current access period of a device. Renewals
+// and extensions move ExpiresAt instead of adding rows.
+type DeviceSubscription struct {
+	gorm.Model
+	DeviceID        uint `gorm:"uniqueIndex;not null"`
+	PlanID          uint `gorm:"index;not null"`
+	StartsAt        int64
+	ExpiresAt       int64 `gorm:"index"`
+	ExpiryFlaggedAt int64
+	Device          *Device `gorm:"foreignKey:DeviceID"`
+	Plan            *Plan   `gorm:"foreignKey:PlanID"`
+}
+
+// Active reports whether the subscription grants access at now.
+func (s *DeviceSubscription) Active(now time.Time) bool {
+	return s.StartsAt <= now.Unix() && now.Unix() < s.ExpiresAt
+}
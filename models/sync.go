@@ -0,0 +1,97 @@
+package models
+
+import (
+	"errors"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyncCursor marks how far a client has synced: the updated_at of the last
+// event it received, in Unix milliseconds, and that event's ID to break ties
+// between events updated within the same millisecond. Tournaments is the
+// TournamentSetKey of the tournaments the device followed at that point, so
+// a changed set can resend the events the client never received.
+type SyncCursor struct {
+	UpdatedAt   int64
+	ID          uint
+	Tournaments string
+}
+
+// ParseSyncCursor parses a cursor produced by SyncCursor.String. An empty
+// string is the zero cursor, which requests a full sync.
+func ParseSyncCursor(s string) (SyncCursor, error) {
+	if s == "" {
+		return SyncCursor{}, nil
+	}
+	millis, rest, _ := strings.Cut(s, ".")
+	id, tournaments, _ := strings.Cut(rest, ".")
+	updatedAt, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil || updatedAt < 0 {
+		return SyncCursor{}, errors.New("invalid sync cursor")
+	}
+	cursor := SyncCursor{UpdatedAt: updatedAt, Tournaments: tournaments}
+	if id != "" {
+		parsed, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return SyncCursor{}, errors.New("invalid sync cursor")
+		}
+		cursor.ID = uint(parsed)
+	}
+	return cursor, nil
+}
+
+func (c SyncCursor) String() string {
+	if c.UpdatedAt == 0 && c.ID == 0 && c.Tournaments == "" {
+		return ""
+	}
+	s := strconv.FormatInt(c.UpdatedAt, 10) + "." + strconv.FormatUint(uint64(c.ID), 10)
+	if c.Tournaments != "" {
+		s += "." + c.Tournaments
+	}
+	return s
+}
+
+// TournamentSetKey returns a short key identifying a set of tournament IDs
+// regardless of their order.
+func TournamentSetKey(ids []uint) string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	hash := fnv.New32a()
+	for _, id := range sorted {
+		hash.Write([]byte(strconv.FormatUint(uint64(id), 10) + ","))
+	}
+	return strconv.FormatUint(uint64(hash.Sum32()), 36)
+}
+
+// Time returns the cursor timestamp.
+func (c SyncCursor) Time() time.Time {
+	return time.UnixMilli(c.UpdatedAt).UTC()
+}
+
+// IsZero reports whether the cursor requests a full sync.
+func (c SyncCursor) IsZero() bool {
+	return c.UpdatedAt == 0 && c.ID == 0
+}
+
+// Advance moves the cursor forward to t. The event ID is reset when the
+// timestamp moves.
+func (c *SyncCursor) Advance(t time.Time) {
+	if millis := t.UnixMilli(); millis > c.UpdatedAt {
+		c.UpdatedAt = millis
+		c.ID = 0
+	}
+}
+
+// SyncChanges holds everything that changed for a device since a cursor.
+type SyncChanges struct {
+	Events          []SofaScoreEvent
+	Teams           []Team
+	Tournaments     []Tournament
+	DeletedEventIDs []int64
+	TournamentIDs   []uint
+	HasMore         bool
+	Cursor          SyncCursor
+}
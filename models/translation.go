@@ -0,0 +1,38 @@
+package models
+
+import "gorm.io/gorm"
+
+const (
+	TranslationTeam       = "team"
+	TranslationTournament = "tournament"
+	TranslationStatus     = "status"
+)
+
+const (
+	TranslationSourceScraped = "scraped"
+	TranslationSourceAdmin   = "admin"
+	TranslationSourceDefault = "default"
+)
+
+// Translation is the name of a team, tournament or event status in a locale.
+// EntityKey is the SofaScore team ID, the tournament ID or the status code.
+// Admin translations are never replaced by scraped ones.
+type Translation struct {
+	gorm.Model
+	EntityType string `gorm:"size:16;not null;uniqueIndex:idx_translation" json:"entity_type"`
+	EntityKey  string `gorm:"size:64;not null;uniqueIndex:idx_translation" json:"entity_key"`
+	Locale     string `gorm:"size:16;not null;uniqueIndex:idx_translation" json:"locale"`
+	Name       string `gorm:"not null" json:"name"`
+	Source     string `gorm:"size:16" json:"source"`
+}
+
+// TranslationSet maps "type:key" to the best translated name.
+type TranslationSet map[string]string
+
+// Name returns the translation of an entity, or fallback when there is none.
+func (s TranslationSet) Name(entityType, key, fallback string) string {
+	if name, ok := s[entityType+":"+key]; ok {
+		return name
+	}
+	return fallback
+}
@@ -0,0 +1,11 @@
+package models
+
+import "gorm.io/gorm"
+
+// TrendingCountry is a country whose trending events are scraped when enabled.
+type TrendingCountry struct {
+	gorm.Model
+	Code    string `gorm:"size:2;not null;uniqueIndex" json:"code"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
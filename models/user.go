@@ -2,8 +2,23 @@ package models
 
 import "gorm.io/gorm"
 
+const (
+	UserRoleAdmin   = "admin"
+	UserRoleManager = "manager"
+)
+
 type User struct {
 	gorm.Model
 	Email    string `gorm:"uniqueIndex;not null"`
 	Password string `gorm:"not null"`
+	Role     string `gorm:"size:16;not null;default:'manager'"`
+	// DeviceQuota caps the devices that can be paired to the user; zero
+	// means unlimited.
+	DeviceQuota int
+}
+
+// IsAdmin reports whether the user manages every device instead of only
+// the ones paired to it.
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
 }
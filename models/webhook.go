@@ -0,0 +1,119 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	WebhookEventMatchStarted = "match.started"
+	WebhookEventMatchEnded   = "match.ended"
+	WebhookEventGoalScored   = "goal.scored"
+	WebhookEventTest         = "webhook.test"
+)
+
+// WebhookEventTypes maps match transitions to the webhook event they emit.
+var WebhookEventTypes = map[string]string{
+	MatchStarted: WebhookEventMatchStarted,
+	MatchGoal:    WebhookEventGoalScored,
+	MatchEnded:   WebhookEventMatchEnded,
+}
+
+// ErrWebhookDeliveryNotDead is returned when requeueing a delivery that was
+// not dead-lettered.
+var ErrWebhookDeliveryNotDead = errors.New("only dead-lettered deliveries can be requeued")
+
+const (
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	WebhookStatusDead      = "dead"
+)
+
+// WebhookSubscription is a partner endpoint notified about match events.
+// EventTypes and TournamentIDs are comma separated; an empty TournamentIDs
+// matches every tournament.
+type WebhookSubscription struct {
+	gorm.Model
+	URL           string `gorm:"not null" json:"url"`
+	Secret        string `gorm:"not null" json:"-"`
+	EventTypes    string `json:"event_types"`
+	TournamentIDs string `json:"tournament_ids"`
+	Active        bool   `json:"active"`
+}
+
+// EventTypeList returns the subscribed event types.
+func (w *WebhookSubscription) EventTypeList() []string {
+	if w.EventTypes == "" {
+		return nil
+	}
+	return strings.Split(w.EventTypes, ",")
+}
+
+// TournamentIDList returns the tournaments the subscription is limited to.
+func (w *WebhookSubscription) TournamentIDList() []uint {
+	if w.TournamentIDs == "" {
+		return nil
+	}
+	parts := strings.Split(w.TournamentIDs, ",")
+	ids := make([]uint, 0, len(parts))
+	for _, p := range parts {
+		if id, err := strconv.ParseUint(p, 10, 32); err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids
+}
+
+// Matches reports whether the subscription wants eventType for tournamentID.
+func (w *WebhookSubscription) Matches(eventType string, tournamentID uint) bool {
+	wanted := false
+	for _, t := range w.EventTypeList() {
+		if t == eventType {
+			wanted = true
+			break
+		}
+	}
+	if !wanted {
+		return false
+	}
+
+	tournaments := w.TournamentIDList()
+	if len(tournaments) == 0 {
+		return true
+	}
+	for _, id := range tournaments {
+		if id == tournamentID {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is a notification queued for a subscription. It is retried
+// with exponential backoff until delivered or dead-lettered.
+type WebhookDelivery struct {
+	gorm.Model
+	SubscriptionID uint                 `gorm:"not null;index" json:"subscription_id"`
+	EventType      string               `gorm:"not null" json:"event_type"`
+	Payload        string               `gorm:"type:text;not null" json:"payload"`
+	Status         string               `gorm:"not null;index:idx_webhook_due" json:"status"`
+	NextAttemptAt  int64                `gorm:"index:idx_webhook_due" json:"next_attempt_at"`
+	Attempts       int                  `json:"attempts"`
+	ResponseStatus int                  `json:"response_status"`
+	LastError      string               `json:"last_error"`
+	DeliveredAt    int64                `json:"delivered_at"`
+	Subscription   *WebhookSubscription `gorm:"foreignKey:SubscriptionID" json:"subscription,omitempty"`
+}
+
+// WebhookAttempt records every try of a delivery.
+type WebhookAttempt struct {
+	gorm.Model
+	DeliveryID     uint   `gorm:"not null;index" json:"delivery_id"`
+	Attempt        int    `json:"attempt"`
+	ResponseStatus int    `json:"response_status"`
+	DurationMs     int64  `json:"duration_ms"`
+	Error          string `json:"error"`
+}
@@ -211,6 +211,7 @@ type AuthResponse struct {
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -273,19 +274,204 @@ func (x *AuthResponse) GetRefreshToken() string {
 	return ""
 }
 
-type DeviceRegisterRequest struct {
+func (x *AuthResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	DeviceQuota   int32                  `protobuf:"varint,5,opt,name=device_quota,json=deviceQuota,proto3" json:"device_quota,omitempty"`
+	DeviceCount   int64                  `protobuf:"varint,6,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *User) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetDeviceQuota() int32 {
+	if x != nil {
+		return x.DeviceQuota
+	}
+	return 0
+}
+
+func (x *User) GetDeviceCount() int64 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+type UserList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*User                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserList) Reset() {
+	*x = UserList{}
+	mi := &file_proto_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserList) ProtoMessage() {}
+
+func (x *UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserList.ProtoReflect.Descriptor instead.
+func (*UserList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserList) GetData() []*User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceQuota   int32                  `protobuf:"varint,1,opt,name=device_quota,json=deviceQuota,proto3" json:"device_quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *UserQuotaRequest) Reset() {
+	*x = UserQuotaRequest{}
+	mi := &file_proto_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserQuotaRequest) ProtoMessage() {}
+
+func (x *UserQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UserQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserQuotaRequest) GetDeviceQuota() int32 {
+	if x != nil {
+		return x.DeviceQuota
+	}
+	return 0
+}
+
+type DeviceRegisterRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Token       string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Platform    string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version     string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Country     string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	PackageName string                 `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Locale      string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	Fingerprint string                 `protobuf:"bytes,8,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Required when a reinstated device registers again.
+	EnrollmentCode string `protobuf:"bytes,9,opt,name=enrollment_code,json=enrollmentCode,proto3" json:"enrollment_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *DeviceRegisterRequest) Reset() {
 	*x = DeviceRegisterRequest{}
-	mi := &file_proto_api_proto_msgTypes[5]
+	mi := &file_proto_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +483,7 @@ func (x *DeviceRegisterRequest) String() string {
 func (*DeviceRegisterRequest) ProtoMessage() {}
 
 func (x *DeviceRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[5]
+	mi := &file_proto_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +496,7 @@ func (x *DeviceRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceRegisterRequest.ProtoReflect.Descriptor instead.
 func (*DeviceRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{5}
+	return file_proto_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeviceRegisterRequest) GetToken() string {
@@ -341,6 +527,41 @@ func (x *DeviceRegisterRequest) GetVersion() string {
 	return ""
 }
 
+func (x *DeviceRegisterRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *DeviceRegisterRequest) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *DeviceRegisterRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *DeviceRegisterRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *DeviceRegisterRequest) GetEnrollmentCode() string {
+	if x != nil {
+		return x.EnrollmentCode
+	}
+	return ""
+}
+
 type Device struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -352,13 +573,23 @@ type Device struct {
 	LastSeen      int64                  `protobuf:"varint,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
 	Version       string                 `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
 	IptvUrl       string                 `protobuf:"bytes,9,opt,name=iptv_url,json=iptvUrl,proto3" json:"iptv_url,omitempty"`
+	Country       string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	PackageName   string                 `protobuf:"bytes,11,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	Locale        string                 `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"`
+	Secret        string                 `protobuf:"bytes,13,opt,name=secret,proto3" json:"secret,omitempty"`
+	RevokedAt     int64                  `protobuf:"varint,14,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	ManagerId     uint32                 `protobuf:"varint,15,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	ManagerEmail  string                 `protobuf:"bytes,16,opt,name=manager_email,json=managerEmail,proto3" json:"manager_email,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,17,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	BlockedAt     int64                  `protobuf:"varint,18,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	BlockReason   string                 `protobuf:"bytes,19,opt,name=block_reason,json=blockReason,proto3" json:"block_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Device) Reset() {
 	*x = Device{}
-	mi := &file_proto_api_proto_msgTypes[6]
+	mi := &file_proto_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +601,7 @@ func (x *Device) String() string {
 func (*Device) ProtoMessage() {}
 
 func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[6]
+	mi := &file_proto_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +614,7 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Device.ProtoReflect.Descriptor instead.
 func (*Device) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{6}
+	return file_proto_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Device) GetId() uint32 {
@@ -449,6 +680,76 @@ func (x *Device) GetIptvUrl() string {
 	return ""
 }
 
+func (x *Device) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Device) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *Device) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Device) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Device) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+func (x *Device) GetManagerId() uint32 {
+	if x != nil {
+		return x.ManagerId
+	}
+	return 0
+}
+
+func (x *Device) GetManagerEmail() string {
+	if x != nil {
+		return x.ManagerEmail
+	}
+	return ""
+}
+
+func (x *Device) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *Device) GetBlockedAt() int64 {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return 0
+}
+
+func (x *Device) GetBlockReason() string {
+	if x != nil {
+		return x.BlockReason
+	}
+	return ""
+}
+
 type DeviceList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*Device              `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -462,7 +763,7 @@ type DeviceList struct {
 
 func (x *DeviceList) Reset() {
 	*x = DeviceList{}
-	mi := &file_proto_api_proto_msgTypes[7]
+	mi := &file_proto_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +775,7 @@ func (x *DeviceList) String() string {
 func (*DeviceList) ProtoMessage() {}
 
 func (x *DeviceList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[7]
+	mi := &file_proto_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +788,7 @@ func (x *DeviceList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceList.ProtoReflect.Descriptor instead.
 func (*DeviceList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{7}
+	return file_proto_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeviceList) GetData() []*Device {
@@ -534,7 +835,7 @@ type DeviceUrl struct {
 
 func (x *DeviceUrl) Reset() {
 	*x = DeviceUrl{}
-	mi := &file_proto_api_proto_msgTypes[8]
+	mi := &file_proto_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +847,7 @@ func (x *DeviceUrl) String() string {
 func (*DeviceUrl) ProtoMessage() {}
 
 func (x *DeviceUrl) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[8]
+	mi := &file_proto_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +860,7 @@ func (x *DeviceUrl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceUrl.ProtoReflect.Descriptor instead.
 func (*DeviceUrl) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{8}
+	return file_proto_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeviceUrl) GetUrl() string {
@@ -569,29 +870,31 @@ func (x *DeviceUrl) GetUrl() string {
 	return ""
 }
 
-type TournamentRequest struct {
+type HeartbeatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	PackageName   string                 `protobuf:"bytes,2,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	NetworkType   string                 `protobuf:"bytes,3,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentRequest) Reset() {
-	*x = TournamentRequest{}
-	mi := &file_proto_api_proto_msgTypes[9]
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_proto_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentRequest) String() string {
+func (x *HeartbeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentRequest) ProtoMessage() {}
+func (*HeartbeatRequest) ProtoMessage() {}
 
-func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[9]
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,52 +905,63 @@ func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
-func (*TournamentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TournamentRequest) GetName() string {
+func (x *HeartbeatRequest) GetVersion() string {
 	if x != nil {
-		return x.Name
+		return x.Version
 	}
 	return ""
 }
 
-func (x *TournamentRequest) GetSlug() string {
+func (x *HeartbeatRequest) GetPackageName() string {
 	if x != nil {
-		return x.Slug
+		return x.PackageName
 	}
 	return ""
 }
 
-type Tournament struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
-	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HeartbeatRequest) GetNetworkType() string {
+	if x != nil {
+		return x.NetworkType
+	}
+	return ""
 }
 
-func (x *Tournament) Reset() {
-	*x = Tournament{}
-	mi := &file_proto_api_proto_msgTypes[10]
+func (x *HeartbeatRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       uint32                 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ServerTime      int64                  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_proto_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tournament) String() string {
+func (x *HeartbeatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tournament) ProtoMessage() {}
+func (*HeartbeatResponse) ProtoMessage() {}
 
-func (x *Tournament) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[10]
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,75 +972,174 @@ func (x *Tournament) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
-func (*Tournament) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Tournament) GetId() uint32 {
+func (x *HeartbeatResponse) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type DeviceSession struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId        uint32                 `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceName      string                 `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	StartedAt       int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LastHeartbeatAt int64                  `protobuf:"varint,5,opt,name=last_heartbeat_at,json=lastHeartbeatAt,proto3" json:"last_heartbeat_at,omitempty"`
+	EndedAt         int64                  `protobuf:"varint,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Version         string                 `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	PackageName     string                 `protobuf:"bytes,8,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	NetworkType     string                 `protobuf:"bytes,9,opt,name=network_type,json=networkType,proto3" json:"network_type,omitempty"`
+	Content         string                 `protobuf:"bytes,10,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeviceSession) Reset() {
+	*x = DeviceSession{}
+	mi := &file_proto_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSession) ProtoMessage() {}
+
+func (x *DeviceSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSession.ProtoReflect.Descriptor instead.
+func (*DeviceSession) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeviceSession) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Tournament) GetCreatedAt() string {
+func (x *DeviceSession) GetDeviceId() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceSession) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
 	}
 	return ""
 }
 
-func (x *Tournament) GetUpdatedAt() string {
+func (x *DeviceSession) GetStartedAt() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *DeviceSession) GetLastHeartbeatAt() int64 {
+	if x != nil {
+		return x.LastHeartbeatAt
+	}
+	return 0
+}
+
+func (x *DeviceSession) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *DeviceSession) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-func (x *Tournament) GetName() string {
+func (x *DeviceSession) GetPackageName() string {
 	if x != nil {
-		return x.Name
+		return x.PackageName
 	}
 	return ""
 }
 
-func (x *Tournament) GetSlug() string {
+func (x *DeviceSession) GetNetworkType() string {
 	if x != nil {
-		return x.Slug
+		return x.NetworkType
 	}
 	return ""
 }
 
-func (x *Tournament) GetRegion() string {
+func (x *DeviceSession) GetContent() string {
 	if x != nil {
-		return x.Region
+		return x.Content
 	}
 	return ""
 }
 
-type TournamentList struct {
+type DeviceSessionList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tournaments   []*Tournament          `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	Data          []*DeviceSession       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TournamentList) Reset() {
-	*x = TournamentList{}
-	mi := &file_proto_api_proto_msgTypes[11]
+func (x *DeviceSessionList) Reset() {
+	*x = DeviceSessionList{}
+	mi := &file_proto_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TournamentList) String() string {
+func (x *DeviceSessionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TournamentList) ProtoMessage() {}
+func (*DeviceSessionList) ProtoMessage() {}
 
-func (x *TournamentList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[11]
+func (x *DeviceSessionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,41 +1150,73 @@ func (x *TournamentList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TournamentList.ProtoReflect.Descriptor instead.
-func (*TournamentList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeviceSessionList.ProtoReflect.Descriptor instead.
+func (*DeviceSessionList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TournamentList) GetTournaments() []*Tournament {
+func (x *DeviceSessionList) GetData() []*DeviceSession {
 	if x != nil {
-		return x.Tournaments
+		return x.Data
 	}
 	return nil
 }
 
-type AssignTournamentRequest struct {
+func (x *DeviceSessionList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DeviceSessionList) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DeviceSessionList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DeviceSessionList) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type DeviceUptime struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeviceId      uint32                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	TournamentId  uint32                 `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	UptimeSeconds int64                  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	UptimeRatio   float64                `protobuf:"fixed64,5,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	Sessions      []*DeviceSession       `protobuf:"bytes,6,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignTournamentRequest) Reset() {
-	*x = AssignTournamentRequest{}
-	mi := &file_proto_api_proto_msgTypes[12]
+func (x *DeviceUptime) Reset() {
+	*x = DeviceUptime{}
+	mi := &file_proto_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignTournamentRequest) String() string {
+func (x *DeviceUptime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignTournamentRequest) ProtoMessage() {}
+func (*DeviceUptime) ProtoMessage() {}
 
-func (x *AssignTournamentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[12]
+func (x *DeviceUptime) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,47 +1227,75 @@ func (x *AssignTournamentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignTournamentRequest.ProtoReflect.Descriptor instead.
-func (*AssignTournamentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeviceUptime.ProtoReflect.Descriptor instead.
+func (*DeviceUptime) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AssignTournamentRequest) GetDeviceId() uint32 {
+func (x *DeviceUptime) GetDeviceId() uint32 {
 	if x != nil {
 		return x.DeviceId
 	}
 	return 0
 }
 
-func (x *AssignTournamentRequest) GetTournamentId() uint32 {
+func (x *DeviceUptime) GetFrom() int64 {
 	if x != nil {
-		return x.TournamentId
+		return x.From
 	}
 	return 0
 }
 
-type SetTournamentIdsRequest struct {
+func (x *DeviceUptime) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *DeviceUptime) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DeviceUptime) GetUptimeRatio() float64 {
+	if x != nil {
+		return x.UptimeRatio
+	}
+	return 0
+}
+
+func (x *DeviceUptime) GetSessions() []*DeviceSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type BlockDeviceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TournamentIds []uint32               `protobuf:"varint,1,rep,packed,name=tournament_ids,json=tournamentIds,proto3" json:"tournament_ids,omitempty"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetTournamentIdsRequest) Reset() {
-	*x = SetTournamentIdsRequest{}
-	mi := &file_proto_api_proto_msgTypes[13]
+func (x *BlockDeviceRequest) Reset() {
+	*x = BlockDeviceRequest{}
+	mi := &file_proto_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetTournamentIdsRequest) String() string {
+func (x *BlockDeviceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetTournamentIdsRequest) ProtoMessage() {}
+func (*BlockDeviceRequest) ProtoMessage() {}
 
-func (x *SetTournamentIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[13]
+func (x *BlockDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,46 +1306,46 @@ func (x *SetTournamentIdsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetTournamentIdsRequest.ProtoReflect.Descriptor instead.
-func (*SetTournamentIdsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BlockDeviceRequest.ProtoReflect.Descriptor instead.
+func (*BlockDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SetTournamentIdsRequest) GetTournamentIds() []uint32 {
+func (x *BlockDeviceRequest) GetReason() string {
 	if x != nil {
-		return x.TournamentIds
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type DeviceTournament struct {
+type DeviceAuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeviceId      uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	TournamentId  uint32                 `protobuf:"varint,5,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Device        *Device                `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
-	Tournament    *Tournament            `protobuf:"bytes,7,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,5,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeviceTournament) Reset() {
-	*x = DeviceTournament{}
-	mi := &file_proto_api_proto_msgTypes[14]
+func (x *DeviceAuditEntry) Reset() {
+	*x = DeviceAuditEntry{}
+	mi := &file_proto_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeviceTournament) String() string {
+func (x *DeviceAuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeviceTournament) ProtoMessage() {}
+func (*DeviceAuditEntry) ProtoMessage() {}
 
-func (x *DeviceTournament) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[14]
+func (x *DeviceAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,82 +1356,82 @@ func (x *DeviceTournament) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeviceTournament.ProtoReflect.Descriptor instead.
-func (*DeviceTournament) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeviceAuditEntry.ProtoReflect.Descriptor instead.
+func (*DeviceAuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeviceTournament) GetId() uint32 {
+func (x *DeviceAuditEntry) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *DeviceTournament) GetCreatedAt() string {
+func (x *DeviceAuditEntry) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *DeviceTournament) GetUpdatedAt() string {
+func (x *DeviceAuditEntry) GetDeviceId() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DeviceId
 	}
-	return ""
+	return 0
 }
 
-func (x *DeviceTournament) GetDeviceId() uint32 {
+func (x *DeviceAuditEntry) GetUserId() uint32 {
 	if x != nil {
-		return x.DeviceId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *DeviceTournament) GetTournamentId() uint32 {
+func (x *DeviceAuditEntry) GetUserEmail() string {
 	if x != nil {
-		return x.TournamentId
+		return x.UserEmail
 	}
-	return 0
+	return ""
 }
 
-func (x *DeviceTournament) GetDevice() *Device {
+func (x *DeviceAuditEntry) GetAction() string {
 	if x != nil {
-		return x.Device
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-func (x *DeviceTournament) GetTournament() *Tournament {
+func (x *DeviceAuditEntry) GetReason() string {
 	if x != nil {
-		return x.Tournament
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type DeviceTournamentList struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DeviceTournaments []*DeviceTournament    `protobuf:"bytes,1,rep,name=device_tournaments,json=deviceTournaments,proto3" json:"device_tournaments,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DeviceAuditList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeviceAuditEntry    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeviceTournamentList) Reset() {
-	*x = DeviceTournamentList{}
-	mi := &file_proto_api_proto_msgTypes[15]
+func (x *DeviceAuditList) Reset() {
+	*x = DeviceAuditList{}
+	mi := &file_proto_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeviceTournamentList) String() string {
+func (x *DeviceAuditList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeviceTournamentList) ProtoMessage() {}
+func (*DeviceAuditList) ProtoMessage() {}
 
-func (x *DeviceTournamentList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[15]
+func (x *DeviceAuditList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,44 +1442,41 @@ func (x *DeviceTournamentList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeviceTournamentList.ProtoReflect.Descriptor instead.
-func (*DeviceTournamentList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeviceAuditList.ProtoReflect.Descriptor instead.
+func (*DeviceAuditList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeviceTournamentList) GetDeviceTournaments() []*DeviceTournament {
+func (x *DeviceAuditList) GetData() []*DeviceAuditEntry {
 	if x != nil {
-		return x.DeviceTournaments
+		return x.Data
 	}
 	return nil
 }
 
-type GlobalTournamentConfig struct {
+type DuplicateDeviceGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	TournamentId  uint32                 `protobuf:"varint,4,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
-	Tournament    *Tournament            `protobuf:"bytes,5,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Fingerprint   string                 `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Devices       []*Device              `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalTournamentConfig) Reset() {
-	*x = GlobalTournamentConfig{}
-	mi := &file_proto_api_proto_msgTypes[16]
+func (x *DuplicateDeviceGroup) Reset() {
+	*x = DuplicateDeviceGroup{}
+	mi := &file_proto_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalTournamentConfig) String() string {
+func (x *DuplicateDeviceGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalTournamentConfig) ProtoMessage() {}
+func (*DuplicateDeviceGroup) ProtoMessage() {}
 
-func (x *GlobalTournamentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[16]
+func (x *DuplicateDeviceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,68 +1487,91 @@ func (x *GlobalTournamentConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalTournamentConfig.ProtoReflect.Descriptor instead.
-func (*GlobalTournamentConfig) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DuplicateDeviceGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateDeviceGroup) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GlobalTournamentConfig) GetId() uint32 {
+func (x *DuplicateDeviceGroup) GetFingerprint() string {
 	if x != nil {
-		return x.Id
+		return x.Fingerprint
 	}
-	return 0
+	return ""
 }
 
-func (x *GlobalTournamentConfig) GetCreatedAt() string {
+func (x *DuplicateDeviceGroup) GetDevices() []*Device {
 	if x != nil {
-		return x.CreatedAt
+		return x.Devices
 	}
-	return ""
+	return nil
 }
 
-func (x *GlobalTournamentConfig) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
+type DuplicateDeviceGroupList struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*DuplicateDeviceGroup `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalTournamentConfig) GetTournamentId() uint32 {
+func (x *DuplicateDeviceGroupList) Reset() {
+	*x = DuplicateDeviceGroupList{}
+	mi := &file_proto_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateDeviceGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateDeviceGroupList) ProtoMessage() {}
+
+func (x *DuplicateDeviceGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[21]
 	if x != nil {
-		return x.TournamentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GlobalTournamentConfig) GetTournament() *Tournament {
+// Deprecated: Use DuplicateDeviceGroupList.ProtoReflect.Descriptor instead.
+func (*DuplicateDeviceGroupList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DuplicateDeviceGroupList) GetData() []*DuplicateDeviceGroup {
 	if x != nil {
-		return x.Tournament
+		return x.Data
 	}
 	return nil
 }
 
-type GlobalTournamentConfigList struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Configs       []*GlobalTournamentConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+type EnrollmentCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TtlMinutes    int32                  `protobuf:"varint,1,opt,name=ttl_minutes,json=ttlMinutes,proto3" json:"ttl_minutes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalTournamentConfigList) Reset() {
-	*x = GlobalTournamentConfigList{}
-	mi := &file_proto_api_proto_msgTypes[17]
+func (x *EnrollmentCodeRequest) Reset() {
+	*x = EnrollmentCodeRequest{}
+	mi := &file_proto_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalTournamentConfigList) String() string {
+func (x *EnrollmentCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalTournamentConfigList) ProtoMessage() {}
+func (*EnrollmentCodeRequest) ProtoMessage() {}
 
-func (x *GlobalTournamentConfigList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[17]
+func (x *EnrollmentCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,46 +1582,47 @@ func (x *GlobalTournamentConfigList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalTournamentConfigList.ProtoReflect.Descriptor instead.
-func (*GlobalTournamentConfigList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EnrollmentCodeRequest.ProtoReflect.Descriptor instead.
+func (*EnrollmentCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GlobalTournamentConfigList) GetConfigs() []*GlobalTournamentConfig {
+func (x *EnrollmentCodeRequest) GetTtlMinutes() int32 {
 	if x != nil {
-		return x.Configs
+		return x.TtlMinutes
 	}
-	return nil
+	return 0
 }
 
-type Team struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeamId         int64                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	LogoUrl        string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	PrimaryColor   string                 `protobuf:"bytes,4,opt,name=primary_color,json=primaryColor,proto3" json:"primary_color,omitempty"`
-	SecondaryColor string                 `protobuf:"bytes,5,opt,name=secondary_color,json=secondaryColor,proto3" json:"secondary_color,omitempty"`
-	TextColor      string                 `protobuf:"bytes,6,opt,name=text_color,json=textColor,proto3" json:"text_color,omitempty"`
-	Name           string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type EnrollmentCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RedeemedAt    int64                  `protobuf:"varint,5,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceName    string                 `protobuf:"bytes,7,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	QrPayload     string                 `protobuf:"bytes,8,opt,name=qr_payload,json=qrPayload,proto3" json:"qr_payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Team) Reset() {
-	*x = Team{}
-	mi := &file_proto_api_proto_msgTypes[18]
+func (x *EnrollmentCode) Reset() {
+	*x = EnrollmentCode{}
+	mi := &file_proto_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Team) String() string {
+func (x *EnrollmentCode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Team) ProtoMessage() {}
+func (*EnrollmentCode) ProtoMessage() {}
 
-func (x *Team) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[18]
+func (x *EnrollmentCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,98 +1633,5157 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Team.ProtoReflect.Descriptor instead.
-func (*Team) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use EnrollmentCode.ProtoReflect.Descriptor instead.
+func (*EnrollmentCode) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Team) GetId() uint32 {
+func (x *EnrollmentCode) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Team) GetTeamId() int64 {
+func (x *EnrollmentCode) GetCreatedAt() string {
 	if x != nil {
-		return x.TeamId
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *Team) GetLogoUrl() string {
+func (x *EnrollmentCode) GetCode() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Team) GetPrimaryColor() string {
+func (x *EnrollmentCode) GetExpiresAt() int64 {
 	if x != nil {
-		return x.PrimaryColor
+		return x.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Team) GetSecondaryColor() string {
+func (x *EnrollmentCode) GetRedeemedAt() int64 {
 	if x != nil {
-		return x.SecondaryColor
+		return x.RedeemedAt
+	}
+	return 0
+}
+
+func (x *EnrollmentCode) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *EnrollmentCode) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
 	}
 	return ""
 }
 
-func (x *Team) GetTextColor() string {
+func (x *EnrollmentCode) GetQrPayload() string {
 	if x != nil {
-		return x.TextColor
+		return x.QrPayload
 	}
 	return ""
 }
 
-func (x *Team) GetName() string {
+type EnrollmentCodeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*EnrollmentCode      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollmentCodeList) Reset() {
+	*x = EnrollmentCodeList{}
+	mi := &file_proto_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollmentCodeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollmentCodeList) ProtoMessage() {}
+
+func (x *EnrollmentCodeList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[24]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollmentCodeList.ProtoReflect.Descriptor instead.
+func (*EnrollmentCodeList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EnrollmentCodeList) GetData() []*EnrollmentCode {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type EnrollDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollDeviceRequest) Reset() {
+	*x = EnrollDeviceRequest{}
+	mi := &file_proto_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollDeviceRequest) ProtoMessage() {}
+
+func (x *EnrollDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollDeviceRequest.ProtoReflect.Descriptor instead.
+func (*EnrollDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EnrollDeviceRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type SofaScoreEvent struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	Id                          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt                   string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt                   string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	SofaScoreEventId            int64                  `protobuf:"varint,4,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
-	Sport                       string                 `protobuf:"bytes,5,opt,name=sport,proto3" json:"sport,omitempty"`
-	HomeScore                   int32                  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
-	HomeTeamId                  int64                  `protobuf:"varint,7,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
-	AwayScore                   int32                  `protobuf:"varint,8,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
-	AwayTeamId                  int64                  `protobuf:"varint,9,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
-	ScrapedAt                   int64                  `protobuf:"varint,10,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
-	Category                    string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
-	StartTimestamp              int64                  `protobuf:"varint,12,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	CurrentPeriodStartTimestamp int64                  `protobuf:"varint,13,opt,name=current_period_start_timestamp,json=currentPeriodStartTimestamp,proto3" json:"current_period_start_timestamp,omitempty"`
-	Slug                        string                 `protobuf:"bytes,14,opt,name=slug,proto3" json:"slug,omitempty"`
-	TeamHome                    *Team                  `protobuf:"bytes,15,opt,name=team_home,json=teamHome,proto3" json:"team_home,omitempty"`
-	TeamAway                    *Team                  `protobuf:"bytes,16,opt,name=team_away,json=teamAway,proto3" json:"team_away,omitempty"`
-	League                      *Tournament            `protobuf:"bytes,17,opt,name=league,proto3" json:"league,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type DeviceCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	TtlMinutes    int32                  `protobuf:"varint,3,opt,name=ttl_minutes,json=ttlMinutes,proto3" json:"ttl_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SofaScoreEvent) Reset() {
-	*x = SofaScoreEvent{}
-	mi := &file_proto_api_proto_msgTypes[19]
+func (x *DeviceCommandRequest) Reset() {
+	*x = DeviceCommandRequest{}
+	mi := &file_proto_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SofaScoreEvent) String() string {
+func (x *DeviceCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommandRequest) ProtoMessage() {}
+
+func (x *DeviceCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCommandRequest.ProtoReflect.Descriptor instead.
+func (*DeviceCommandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeviceCommandRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeviceCommandRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeviceCommandRequest) GetTtlMinutes() int32 {
+	if x != nil {
+		return x.TtlMinutes
+	}
+	return 0
+}
+
+type DeviceCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Payload       string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DeliveredAt   int64                  `protobuf:"varint,8,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Result        string                 `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCommand) Reset() {
+	*x = DeviceCommand{}
+	mi := &file_proto_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommand) ProtoMessage() {}
+
+func (x *DeviceCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCommand.ProtoReflect.Descriptor instead.
+func (*DeviceCommand) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeviceCommand) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeviceCommand) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceCommand) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DeviceCommand) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *DeviceCommand) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *DeviceCommand) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *DeviceCommand) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type DeviceCommandList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeviceCommand       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCommandList) Reset() {
+	*x = DeviceCommandList{}
+	mi := &file_proto_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCommandList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommandList) ProtoMessage() {}
+
+func (x *DeviceCommandList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCommandList.ProtoReflect.Descriptor instead.
+func (*DeviceCommandList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeviceCommandList) GetData() []*DeviceCommand {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeviceCommandList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DeviceCommandList) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DeviceCommandList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DeviceCommandList) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type DeviceCommandAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceCommandAck) Reset() {
+	*x = DeviceCommandAck{}
+	mi := &file_proto_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceCommandAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCommandAck) ProtoMessage() {}
+
+func (x *DeviceCommandAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCommandAck.ProtoReflect.Descriptor instead.
+func (*DeviceCommandAck) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeviceCommandAck) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeviceCommandAck) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type PlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DurationDays   int32                  `protobuf:"varint,2,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	MaxTournaments int32                  `protobuf:"varint,3,opt,name=max_tournaments,json=maxTournaments,proto3" json:"max_tournaments,omitempty"`
+	LiveUpdates    bool                   `protobuf:"varint,4,opt,name=live_updates,json=liveUpdates,proto3" json:"live_updates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlanRequest) Reset() {
+	*x = PlanRequest{}
+	mi := &file_proto_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRequest) ProtoMessage() {}
+
+func (x *PlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
+func (*PlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetMaxTournaments() int32 {
+	if x != nil {
+		return x.MaxTournaments
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetLiveUpdates() bool {
+	if x != nil {
+		return x.LiveUpdates
+	}
+	return false
+}
+
+type Plan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DurationDays   int32                  `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	MaxTournaments int32                  `protobuf:"varint,5,opt,name=max_tournaments,json=maxTournaments,proto3" json:"max_tournaments,omitempty"`
+	LiveUpdates    bool                   `protobuf:"varint,6,opt,name=live_updates,json=liveUpdates,proto3" json:"live_updates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_proto_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Plan) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Plan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *Plan) GetMaxTournaments() int32 {
+	if x != nil {
+		return x.MaxTournaments
+	}
+	return 0
+}
+
+func (x *Plan) GetLiveUpdates() bool {
+	if x != nil {
+		return x.LiveUpdates
+	}
+	return false
+}
+
+type PlanList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Plan                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanList) Reset() {
+	*x = PlanList{}
+	mi := &file_proto_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanList) ProtoMessage() {}
+
+func (x *PlanList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanList.ProtoReflect.Descriptor instead.
+func (*PlanList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlanList) GetData() []*Plan {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        uint32                 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionRequest) Reset() {
+	*x = SubscriptionRequest{}
+	mi := &file_proto_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionRequest) ProtoMessage() {}
+
+func (x *SubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubscriptionRequest) GetPlanId() uint32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *SubscriptionRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type DeviceSubscription struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId        uint32                 `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceName      string                 `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	Plan            *Plan                  `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	StartsAt        int64                  `protobuf:"varint,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Active          bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	ExpiryFlaggedAt int64                  `protobuf:"varint,8,opt,name=expiry_flagged_at,json=expiryFlaggedAt,proto3" json:"expiry_flagged_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeviceSubscription) Reset() {
+	*x = DeviceSubscription{}
+	mi := &file_proto_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSubscription) ProtoMessage() {}
+
+func (x *DeviceSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSubscription.ProtoReflect.Descriptor instead.
+func (*DeviceSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeviceSubscription) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeviceSubscription) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceSubscription) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *DeviceSubscription) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *DeviceSubscription) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *DeviceSubscription) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DeviceSubscription) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *DeviceSubscription) GetExpiryFlaggedAt() int64 {
+	if x != nil {
+		return x.ExpiryFlaggedAt
+	}
+	return 0
+}
+
+type DeviceSubscriptionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeviceSubscription  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceSubscriptionList) Reset() {
+	*x = DeviceSubscriptionList{}
+	mi := &file_proto_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceSubscriptionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceSubscriptionList) ProtoMessage() {}
+
+func (x *DeviceSubscriptionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceSubscriptionList.ProtoReflect.Descriptor instead.
+func (*DeviceSubscriptionList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeviceSubscriptionList) GetData() []*DeviceSubscription {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeviceSubscriptionList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DeviceSubscriptionList) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DeviceSubscriptionList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DeviceSubscriptionList) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// SubscriptionError is returned with 402 Payment Required when the device
+// subscription does not grant access to the requested resource.
+type SubscriptionError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionError) Reset() {
+	*x = SubscriptionError{}
+	mi := &file_proto_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionError) ProtoMessage() {}
+
+func (x *SubscriptionError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionError.ProtoReflect.Descriptor instead.
+func (*SubscriptionError) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubscriptionError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SubscriptionError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubscriptionError) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type DeviceGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	RulePackage   string                 `protobuf:"bytes,4,opt,name=rule_package,json=rulePackage,proto3" json:"rule_package,omitempty"`
+	RuleVersion   string                 `protobuf:"bytes,5,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"`
+	RulePlatform  string                 `protobuf:"bytes,6,opt,name=rule_platform,json=rulePlatform,proto3" json:"rule_platform,omitempty"`
+	RuleManagerId uint32                 `protobuf:"varint,7,opt,name=rule_manager_id,json=ruleManagerId,proto3" json:"rule_manager_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceGroupRequest) Reset() {
+	*x = DeviceGroupRequest{}
+	mi := &file_proto_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroupRequest) ProtoMessage() {}
+
+func (x *DeviceGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeviceGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeviceGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeviceGroupRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *DeviceGroupRequest) GetRulePackage() string {
+	if x != nil {
+		return x.RulePackage
+	}
+	return ""
+}
+
+func (x *DeviceGroupRequest) GetRuleVersion() string {
+	if x != nil {
+		return x.RuleVersion
+	}
+	return ""
+}
+
+func (x *DeviceGroupRequest) GetRulePlatform() string {
+	if x != nil {
+		return x.RulePlatform
+	}
+	return ""
+}
+
+func (x *DeviceGroupRequest) GetRuleManagerId() uint32 {
+	if x != nil {
+		return x.RuleManagerId
+	}
+	return 0
+}
+
+type DeviceGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	DeviceCount   int64                  `protobuf:"varint,6,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	RulePackage   string                 `protobuf:"bytes,7,opt,name=rule_package,json=rulePackage,proto3" json:"rule_package,omitempty"`
+	RuleVersion   string                 `protobuf:"bytes,8,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"`
+	RulePlatform  string                 `protobuf:"bytes,9,opt,name=rule_platform,json=rulePlatform,proto3" json:"rule_platform,omitempty"`
+	RuleManagerId uint32                 `protobuf:"varint,10,opt,name=rule_manager_id,json=ruleManagerId,proto3" json:"rule_manager_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceGroup) Reset() {
+	*x = DeviceGroup{}
+	mi := &file_proto_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroup) ProtoMessage() {}
+
+func (x *DeviceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroup.ProtoReflect.Descriptor instead.
+func (*DeviceGroup) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeviceGroup) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeviceGroup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *DeviceGroup) GetDeviceCount() int64 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+func (x *DeviceGroup) GetRulePackage() string {
+	if x != nil {
+		return x.RulePackage
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetRuleVersion() string {
+	if x != nil {
+		return x.RuleVersion
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetRulePlatform() string {
+	if x != nil {
+		return x.RulePlatform
+	}
+	return ""
+}
+
+func (x *DeviceGroup) GetRuleManagerId() uint32 {
+	if x != nil {
+		return x.RuleManagerId
+	}
+	return 0
+}
+
+type DeviceGroupList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeviceGroup         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceGroupList) Reset() {
+	*x = DeviceGroupList{}
+	mi := &file_proto_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroupList) ProtoMessage() {}
+
+func (x *DeviceGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroupList.ProtoReflect.Descriptor instead.
+func (*DeviceGroupList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeviceGroupList) GetData() []*DeviceGroup {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeviceGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceIds     []uint32               `protobuf:"varint,1,rep,packed,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceGroupMembersRequest) Reset() {
+	*x = DeviceGroupMembersRequest{}
+	mi := &file_proto_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroupMembersRequest) ProtoMessage() {}
+
+func (x *DeviceGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*DeviceGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeviceGroupMembersRequest) GetDeviceIds() []uint32 {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+type DeviceGroupMembersResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Affected          int64                  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
+	RejectedDeviceIds []uint32               `protobuf:"varint,2,rep,packed,name=rejected_device_ids,json=rejectedDeviceIds,proto3" json:"rejected_device_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeviceGroupMembersResult) Reset() {
+	*x = DeviceGroupMembersResult{}
+	mi := &file_proto_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroupMembersResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroupMembersResult) ProtoMessage() {}
+
+func (x *DeviceGroupMembersResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroupMembersResult.ProtoReflect.Descriptor instead.
+func (*DeviceGroupMembersResult) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeviceGroupMembersResult) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *DeviceGroupMembersResult) GetRejectedDeviceIds() []uint32 {
+	if x != nil {
+		return x.RejectedDeviceIds
+	}
+	return nil
+}
+
+type DeviceGroupTournamentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceGroupId uint32                 `protobuf:"varint,1,opt,name=device_group_id,json=deviceGroupId,proto3" json:"device_group_id,omitempty"`
+	Tournaments   []*Tournament          `protobuf:"bytes,2,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceGroupTournamentList) Reset() {
+	*x = DeviceGroupTournamentList{}
+	mi := &file_proto_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceGroupTournamentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceGroupTournamentList) ProtoMessage() {}
+
+func (x *DeviceGroupTournamentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceGroupTournamentList.ProtoReflect.Descriptor instead.
+func (*DeviceGroupTournamentList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeviceGroupTournamentList) GetDeviceGroupId() uint32 {
+	if x != nil {
+		return x.DeviceGroupId
+	}
+	return 0
+}
+
+func (x *DeviceGroupTournamentList) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+type ConfigKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigKeyRequest) Reset() {
+	*x = ConfigKeyRequest{}
+	mi := &file_proto_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigKeyRequest) ProtoMessage() {}
+
+func (x *ConfigKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigKeyRequest.ProtoReflect.Descriptor instead.
+func (*ConfigKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ConfigKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigKeyRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfigKeyRequest) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ConfigKeyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ConfigKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,6,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigKey) Reset() {
+	*x = ConfigKey{}
+	mi := &file_proto_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigKey) ProtoMessage() {}
+
+func (x *ConfigKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigKey.ProtoReflect.Descriptor instead.
+func (*ConfigKey) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfigKey) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ConfigKey) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ConfigKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfigKey) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ConfigKey) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ConfigKeyList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ConfigKey           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigKeyList) Reset() {
+	*x = ConfigKeyList{}
+	mi := &file_proto_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigKeyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigKeyList) ProtoMessage() {}
+
+func (x *ConfigKeyList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigKeyList.ProtoReflect.Descriptor instead.
+func (*ConfigKeyList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ConfigKeyList) GetData() []*ConfigKey {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ConfigValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,3,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValueRequest) Reset() {
+	*x = ConfigValueRequest{}
+	mi := &file_proto_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValueRequest) ProtoMessage() {}
+
+func (x *ConfigValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValueRequest.ProtoReflect.Descriptor instead.
+func (*ConfigValueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConfigValueRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigValueRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ConfigValueRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *ConfigValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ConfigValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Scope         string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	ScopeId       string                 `protobuf:"bytes,5,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	Value         string                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValue) Reset() {
+	*x = ConfigValue{}
+	mi := &file_proto_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValue) ProtoMessage() {}
+
+func (x *ConfigValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValue.ProtoReflect.Descriptor instead.
+func (*ConfigValue) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConfigValue) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigValue) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ConfigValueList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ConfigValue         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValueList) Reset() {
+	*x = ConfigValueList{}
+	mi := &file_proto_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValueList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValueList) ProtoMessage() {}
+
+func (x *ConfigValueList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValueList.ProtoReflect.Descriptor instead.
+func (*ConfigValueList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ConfigValueList) GetData() []*ConfigValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ConfigEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigEntry) Reset() {
+	*x = ConfigEntry{}
+	mi := &file_proto_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigEntry) ProtoMessage() {}
+
+func (x *ConfigEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigEntry.ProtoReflect.Descriptor instead.
+func (*ConfigEntry) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ConfigEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfigEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// ConfigBundle is the effective configuration of a device. version changes
+// whenever any value does.
+type ConfigBundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Entries       []*ConfigEntry         `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigBundle) Reset() {
+	*x = ConfigBundle{}
+	mi := &file_proto_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigBundle) ProtoMessage() {}
+
+func (x *ConfigBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigBundle.ProtoReflect.Descriptor instead.
+func (*ConfigBundle) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConfigBundle) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ConfigBundle) GetEntries() []*ConfigEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type TournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRequest) Reset() {
+	*x = TournamentRequest{}
+	mi := &file_proto_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRequest) ProtoMessage() {}
+
+func (x *TournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRequest.ProtoReflect.Descriptor instead.
+func (*TournamentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type Tournament struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_proto_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tournament) ProtoMessage() {}
+
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Tournament) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tournament) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Tournament) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Tournament) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type TournamentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournaments   []*Tournament          `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentList) Reset() {
+	*x = TournamentList{}
+	mi := &file_proto_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentList) ProtoMessage() {}
+
+func (x *TournamentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentList.ProtoReflect.Descriptor instead.
+func (*TournamentList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TournamentList) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+type AssignTournamentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      uint32                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	TournamentId  uint32                 `protobuf:"varint,2,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTournamentRequest) Reset() {
+	*x = AssignTournamentRequest{}
+	mi := &file_proto_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTournamentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTournamentRequest) ProtoMessage() {}
+
+func (x *AssignTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTournamentRequest.ProtoReflect.Descriptor instead.
+func (*AssignTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AssignTournamentRequest) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *AssignTournamentRequest) GetTournamentId() uint32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+type SetTournamentIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentIds []uint32               `protobuf:"varint,1,rep,packed,name=tournament_ids,json=tournamentIds,proto3" json:"tournament_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTournamentIdsRequest) Reset() {
+	*x = SetTournamentIdsRequest{}
+	mi := &file_proto_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTournamentIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTournamentIdsRequest) ProtoMessage() {}
+
+func (x *SetTournamentIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTournamentIdsRequest.ProtoReflect.Descriptor instead.
+func (*SetTournamentIdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetTournamentIdsRequest) GetTournamentIds() []uint32 {
+	if x != nil {
+		return x.TournamentIds
+	}
+	return nil
+}
+
+type DeviceTournament struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	TournamentId  uint32                 `protobuf:"varint,5,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Device        *Device                `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+	Tournament    *Tournament            `protobuf:"bytes,7,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceTournament) Reset() {
+	*x = DeviceTournament{}
+	mi := &file_proto_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceTournament) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceTournament) ProtoMessage() {}
+
+func (x *DeviceTournament) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceTournament.ProtoReflect.Descriptor instead.
+func (*DeviceTournament) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeviceTournament) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeviceTournament) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeviceTournament) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DeviceTournament) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceTournament) GetTournamentId() uint32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *DeviceTournament) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DeviceTournament) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type DeviceTournamentList struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DeviceTournaments []*DeviceTournament    `protobuf:"bytes,1,rep,name=device_tournaments,json=deviceTournaments,proto3" json:"device_tournaments,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeviceTournamentList) Reset() {
+	*x = DeviceTournamentList{}
+	mi := &file_proto_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceTournamentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceTournamentList) ProtoMessage() {}
+
+func (x *DeviceTournamentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceTournamentList.ProtoReflect.Descriptor instead.
+func (*DeviceTournamentList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeviceTournamentList) GetDeviceTournaments() []*DeviceTournament {
+	if x != nil {
+		return x.DeviceTournaments
+	}
+	return nil
+}
+
+type AssignTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      uint32                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	TeamId        int64                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTeamRequest) Reset() {
+	*x = AssignTeamRequest{}
+	mi := &file_proto_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTeamRequest) ProtoMessage() {}
+
+func (x *AssignTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTeamRequest.ProtoReflect.Descriptor instead.
+func (*AssignTeamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AssignTeamRequest) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *AssignTeamRequest) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type SetTeamIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamIds       []int64                `protobuf:"varint,1,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTeamIdsRequest) Reset() {
+	*x = SetTeamIdsRequest{}
+	mi := &file_proto_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamIdsRequest) ProtoMessage() {}
+
+func (x *SetTeamIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamIdsRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamIdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetTeamIdsRequest) GetTeamIds() []int64 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+type DeviceTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	TeamId        int64                  `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Device        *Device                `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+	Team          *Team                  `protobuf:"bytes,7,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceTeam) Reset() {
+	*x = DeviceTeam{}
+	mi := &file_proto_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceTeam) ProtoMessage() {}
+
+func (x *DeviceTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceTeam.ProtoReflect.Descriptor instead.
+func (*DeviceTeam) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeviceTeam) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeviceTeam) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DeviceTeam) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *DeviceTeam) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceTeam) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *DeviceTeam) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DeviceTeam) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeviceTeamList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceTeams   []*DeviceTeam          `protobuf:"bytes,1,rep,name=device_teams,json=deviceTeams,proto3" json:"device_teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceTeamList) Reset() {
+	*x = DeviceTeamList{}
+	mi := &file_proto_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceTeamList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceTeamList) ProtoMessage() {}
+
+func (x *DeviceTeamList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceTeamList.ProtoReflect.Descriptor instead.
+func (*DeviceTeamList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeviceTeamList) GetDeviceTeams() []*DeviceTeam {
+	if x != nil {
+		return x.DeviceTeams
+	}
+	return nil
+}
+
+type GlobalTournamentConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TournamentId  uint32                 `protobuf:"varint,4,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Tournament    *Tournament            `protobuf:"bytes,5,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalTournamentConfig) Reset() {
+	*x = GlobalTournamentConfig{}
+	mi := &file_proto_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalTournamentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalTournamentConfig) ProtoMessage() {}
+
+func (x *GlobalTournamentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalTournamentConfig.ProtoReflect.Descriptor instead.
+func (*GlobalTournamentConfig) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GlobalTournamentConfig) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GlobalTournamentConfig) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GlobalTournamentConfig) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GlobalTournamentConfig) GetTournamentId() uint32 {
+	if x != nil {
+		return x.TournamentId
+	}
+	return 0
+}
+
+func (x *GlobalTournamentConfig) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+type GlobalTournamentConfigList struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Configs       []*GlobalTournamentConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalTournamentConfigList) Reset() {
+	*x = GlobalTournamentConfigList{}
+	mi := &file_proto_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalTournamentConfigList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalTournamentConfigList) ProtoMessage() {}
+
+func (x *GlobalTournamentConfigList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalTournamentConfigList.ProtoReflect.Descriptor instead.
+func (*GlobalTournamentConfigList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GlobalTournamentConfigList) GetConfigs() []*GlobalTournamentConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SportRequest) Reset() {
+	*x = SportRequest{}
+	mi := &file_proto_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SportRequest) ProtoMessage() {}
+
+func (x *SportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SportRequest.ProtoReflect.Descriptor instead.
+func (*SportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SportRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SportRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type Sport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sport) Reset() {
+	*x = Sport{}
+	mi := &file_proto_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sport) ProtoMessage() {}
+
+func (x *Sport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sport.ProtoReflect.Descriptor instead.
+func (*Sport) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Sport) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Sport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Sport) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Sport) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Sport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Sport) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SportList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sports        []*Sport               `protobuf:"bytes,1,rep,name=sports,proto3" json:"sports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SportList) Reset() {
+	*x = SportList{}
+	mi := &file_proto_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SportList) ProtoMessage() {}
+
+func (x *SportList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SportList.ProtoReflect.Descriptor instead.
+func (*SportList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SportList) GetSports() []*Sport {
+	if x != nil {
+		return x.Sports
+	}
+	return nil
+}
+
+type TrendingCountryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingCountryRequest) Reset() {
+	*x = TrendingCountryRequest{}
+	mi := &file_proto_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingCountryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCountryRequest) ProtoMessage() {}
+
+func (x *TrendingCountryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCountryRequest.ProtoReflect.Descriptor instead.
+func (*TrendingCountryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *TrendingCountryRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TrendingCountryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingCountryRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type TrendingCountry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingCountry) Reset() {
+	*x = TrendingCountry{}
+	mi := &file_proto_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingCountry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCountry) ProtoMessage() {}
+
+func (x *TrendingCountry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCountry.ProtoReflect.Descriptor instead.
+func (*TrendingCountry) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TrendingCountry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrendingCountry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TrendingCountry) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *TrendingCountry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TrendingCountry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingCountry) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type TrendingCountryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*TrendingCountry     `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingCountryList) Reset() {
+	*x = TrendingCountryList{}
+	mi := &file_proto_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingCountryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingCountryList) ProtoMessage() {}
+
+func (x *TrendingCountryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingCountryList.ProtoReflect.Descriptor instead.
+func (*TrendingCountryList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TrendingCountryList) GetCountries() []*TrendingCountry {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type Team struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId         int64                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	LogoUrl        string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	PrimaryColor   string                 `protobuf:"bytes,4,opt,name=primary_color,json=primaryColor,proto3" json:"primary_color,omitempty"`
+	SecondaryColor string                 `protobuf:"bytes,5,opt,name=secondary_color,json=secondaryColor,proto3" json:"secondary_color,omitempty"`
+	TextColor      string                 `protobuf:"bytes,6,opt,name=text_color,json=textColor,proto3" json:"text_color,omitempty"`
+	Name           string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_proto_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Team) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Team) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *Team) GetPrimaryColor() string {
+	if x != nil {
+		return x.PrimaryColor
+	}
+	return ""
+}
+
+func (x *Team) GetSecondaryColor() string {
+	if x != nil {
+		return x.SecondaryColor
+	}
+	return ""
+}
+
+func (x *Team) GetTextColor() string {
+	if x != nil {
+		return x.TextColor
+	}
+	return ""
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SofaScoreEvent struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Id                          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt                   string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                   string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SofaScoreEventId            int64                  `protobuf:"varint,4,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
+	Sport                       string                 `protobuf:"bytes,5,opt,name=sport,proto3" json:"sport,omitempty"`
+	HomeScore                   int32                  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	HomeTeamId                  int64                  `protobuf:"varint,7,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayScore                   int32                  `protobuf:"varint,8,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	AwayTeamId                  int64                  `protobuf:"varint,9,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	ScrapedAt                   int64                  `protobuf:"varint,10,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	Category                    string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	StartTimestamp              int64                  `protobuf:"varint,12,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	CurrentPeriodStartTimestamp int64                  `protobuf:"varint,13,opt,name=current_period_start_timestamp,json=currentPeriodStartTimestamp,proto3" json:"current_period_start_timestamp,omitempty"`
+	Slug                        string                 `protobuf:"bytes,14,opt,name=slug,proto3" json:"slug,omitempty"`
+	TeamHome                    *Team                  `protobuf:"bytes,15,opt,name=team_home,json=teamHome,proto3" json:"team_home,omitempty"`
+	TeamAway                    *Team                  `protobuf:"bytes,16,opt,name=team_away,json=teamAway,proto3" json:"team_away,omitempty"`
+	League                      *Tournament            `protobuf:"bytes,17,opt,name=league,proto3" json:"league,omitempty"`
+	StatusCode                  int32                  `protobuf:"varint,18,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusType                  string                 `protobuf:"bytes,19,opt,name=status_type,json=statusType,proto3" json:"status_type,omitempty"`
+	StatusDescription           string                 `protobuf:"bytes,20,opt,name=status_description,json=statusDescription,proto3" json:"status_description,omitempty"`
+	LeagueId                    uint32                 `protobuf:"varint,21,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SofaScoreEvent) Reset() {
+	*x = SofaScoreEvent{}
+	mi := &file_proto_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SofaScoreEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SofaScoreEvent) ProtoMessage() {}
+
+func (x *SofaScoreEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SofaScoreEvent.ProtoReflect.Descriptor instead.
+func (*SofaScoreEvent) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SofaScoreEvent) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetSofaScoreEventId() int64 {
+	if x != nil {
+		return x.SofaScoreEventId
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetHomeTeamId() int64 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetAwayScore() int32 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetAwayTeamId() int64 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetScrapedAt() int64 {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetCurrentPeriodStartTimestamp() int64 {
+	if x != nil {
+		return x.CurrentPeriodStartTimestamp
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetTeamHome() *Team {
+	if x != nil {
+		return x.TeamHome
+	}
+	return nil
+}
+
+func (x *SofaScoreEvent) GetTeamAway() *Team {
+	if x != nil {
+		return x.TeamAway
+	}
+	return nil
+}
+
+func (x *SofaScoreEvent) GetLeague() *Tournament {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *SofaScoreEvent) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *SofaScoreEvent) GetStatusType() string {
+	if x != nil {
+		return x.StatusType
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetStatusDescription() string {
+	if x != nil {
+		return x.StatusDescription
+	}
+	return ""
+}
+
+func (x *SofaScoreEvent) GetLeagueId() uint32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+type EventsList struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Data       []*SofaScoreEvent      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Page       int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit      int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total      int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TotalPages int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Sections of the current-events feed. live and recent are only sent on
+	// the first page; upcoming continues with next_cursor.
+	Live          []*SofaScoreEvent `protobuf:"bytes,6,rep,name=live,proto3" json:"live,omitempty"`
+	Upcoming      []*SofaScoreEvent `protobuf:"bytes,7,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Recent        []*SofaScoreEvent `protobuf:"bytes,8,rep,name=recent,proto3" json:"recent,omitempty"`
+	NextCursor    string            `protobuf:"bytes,9,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsList) Reset() {
+	*x = EventsList{}
+	mi := &file_proto_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsList) ProtoMessage() {}
+
+func (x *EventsList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsList.ProtoReflect.Descriptor instead.
+func (*EventsList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *EventsList) GetData() []*SofaScoreEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EventsList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *EventsList) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *EventsList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *EventsList) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *EventsList) GetLive() []*SofaScoreEvent {
+	if x != nil {
+		return x.Live
+	}
+	return nil
+}
+
+func (x *EventsList) GetUpcoming() []*SofaScoreEvent {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+func (x *EventsList) GetRecent() []*SofaScoreEvent {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+func (x *EventsList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type EventDelta struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	SofaScoreEventId            int64                  `protobuf:"varint,1,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
+	LeagueId                    uint32                 `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	HomeScore                   int32                  `protobuf:"varint,3,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore                   int32                  `protobuf:"varint,4,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	StatusCode                  int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusType                  string                 `protobuf:"bytes,6,opt,name=status_type,json=statusType,proto3" json:"status_type,omitempty"`
+	StatusDescription           string                 `protobuf:"bytes,7,opt,name=status_description,json=statusDescription,proto3" json:"status_description,omitempty"`
+	StartTimestamp              int64                  `protobuf:"varint,8,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	CurrentPeriodStartTimestamp int64                  `protobuf:"varint,9,opt,name=current_period_start_timestamp,json=currentPeriodStartTimestamp,proto3" json:"current_period_start_timestamp,omitempty"`
+	UpdatedAt                   string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsNew                       bool                   `protobuf:"varint,11,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *EventDelta) Reset() {
+	*x = EventDelta{}
+	mi := &file_proto_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDelta) ProtoMessage() {}
+
+func (x *EventDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDelta.ProtoReflect.Descriptor instead.
+func (*EventDelta) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *EventDelta) GetSofaScoreEventId() int64 {
+	if x != nil {
+		return x.SofaScoreEventId
+	}
+	return 0
+}
+
+func (x *EventDelta) GetLeagueId() uint32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+func (x *EventDelta) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *EventDelta) GetAwayScore() int32 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *EventDelta) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *EventDelta) GetStatusType() string {
+	if x != nil {
+		return x.StatusType
+	}
+	return ""
+}
+
+func (x *EventDelta) GetStatusDescription() string {
+	if x != nil {
+		return x.StatusDescription
+	}
+	return ""
+}
+
+func (x *EventDelta) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *EventDelta) GetCurrentPeriodStartTimestamp() int64 {
+	if x != nil {
+		return x.CurrentPeriodStartTimestamp
+	}
+	return 0
+}
+
+func (x *EventDelta) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *EventDelta) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+type TrendingEventsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	FetchedAt     int64                  `protobuf:"varint,2,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Data          []*SofaScoreEvent      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingEventsList) Reset() {
+	*x = TrendingEventsList{}
+	mi := &file_proto_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingEventsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingEventsList) ProtoMessage() {}
+
+func (x *TrendingEventsList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingEventsList.ProtoReflect.Descriptor instead.
+func (*TrendingEventsList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TrendingEventsList) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *TrendingEventsList) GetFetchedAt() int64 {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return 0
+}
+
+func (x *TrendingEventsList) GetData() []*SofaScoreEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeviceStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      uint32                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online        bool                   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeen      int64                  `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceStatusChange) Reset() {
+	*x = DeviceStatusChange{}
+	mi := &file_proto_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceStatusChange) ProtoMessage() {}
+
+func (x *DeviceStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceStatusChange.ProtoReflect.Descriptor instead.
+func (*DeviceStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeviceStatusChange) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeviceStatusChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceStatusChange) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *DeviceStatusChange) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type CrashReportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Fatal         bool                   `protobuf:"varint,3,opt,name=fatal,proto3" json:"fatal,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	AppName       string                 `protobuf:"bytes,5,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,6,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Platform      string                 `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrashReportSummary) Reset() {
+	*x = CrashReportSummary{}
+	mi := &file_proto_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrashReportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportSummary) ProtoMessage() {}
+
+func (x *CrashReportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportSummary.ProtoReflect.Descriptor instead.
+func (*CrashReportSummary) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CrashReportSummary) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CrashReportSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CrashReportSummary) GetFatal() bool {
+	if x != nil {
+		return x.Fatal
+	}
+	return false
+}
+
+func (x *CrashReportSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CrashReportSummary) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *CrashReportSummary) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *CrashReportSummary) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+type JobRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Tasks         int32                  `protobuf:"varint,4,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Skipped       int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_proto_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *JobRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobRun) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *JobRun) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	TournamentIds []uint32               `protobuf:"varint,4,rep,packed,name=tournament_ids,json=tournamentIds,proto3" json:"tournament_ids,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_proto_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetTournamentIds() []uint32 {
+	if x != nil {
+		return x.TournamentIds
+	}
+	return nil
+}
+
+func (x *WebhookRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	TournamentIds []uint32               `protobuf:"varint,6,rep,packed,name=tournament_ids,json=tournamentIds,proto3" json:"tournament_ids,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	// Only populated when the secret is generated by the server.
+	Secret        string `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Webhook) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Webhook) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetTournamentIds() []uint32 {
+	if x != nil {
+		return x.TournamentIds
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type WebhookList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookList) Reset() {
+	*x = WebhookList{}
+	mi := &file_proto_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookList) ProtoMessage() {}
+
+func (x *WebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookList.ProtoReflect.Descriptor instead.
+func (*WebhookList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WebhookList) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SubscriptionId uint32                 `protobuf:"varint,3,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int64                  `protobuf:"varint,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt    int64                  `protobuf:"varint,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	Payload        string                 `protobuf:"bytes,11,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *WebhookDelivery) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() uint32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type WebhookDeliveryList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryList) Reset() {
+	*x = WebhookDeliveryList{}
+	mi := &file_proto_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryList) ProtoMessage() {}
+
+func (x *WebhookDeliveryList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryList.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *WebhookDeliveryList) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryList) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type LogPlaybackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceToken   string                 `protobuf:"bytes,1,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogPlaybackRequest) Reset() {
+	*x = LogPlaybackRequest{}
+	mi := &file_proto_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPlaybackRequest) ProtoMessage() {}
+
+func (x *LogPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*LogPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *LogPlaybackRequest) GetDeviceToken() string {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return ""
+}
+
+func (x *LogPlaybackRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LogPlaybackRequest) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+type UpdatePlaybackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndedAt       int64                  `protobuf:"varint,1,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaybackRequest) Reset() {
+	*x = UpdatePlaybackRequest{}
+	mi := &file_proto_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaybackRequest) ProtoMessage() {}
+
+func (x *UpdatePlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaybackRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdatePlaybackRequest) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+type PlaybackLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeviceId      uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       int64                  `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaybackLog) Reset() {
+	*x = PlaybackLog{}
+	mi := &file_proto_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackLog) ProtoMessage() {}
+
+func (x *PlaybackLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackLog.ProtoReflect.Descriptor instead.
+func (*PlaybackLog) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PlaybackLog) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaybackLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PlaybackLog) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PlaybackLog) GetDeviceId() uint32 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *PlaybackLog) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PlaybackLog) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PlaybackLog) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+type PlaybackSession struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Playback                 *PlaybackLog           `protobuf:"bytes,1,opt,name=playback,proto3" json:"playback,omitempty"`
+	HeartbeatIntervalSeconds int32                  `protobuf:"varint,2,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PlaybackSession) Reset() {
+	*x = PlaybackSession{}
+	mi := &file_proto_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackSession) ProtoMessage() {}
+
+func (x *PlaybackSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackSession.ProtoReflect.Descriptor instead.
+func (*PlaybackSession) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PlaybackSession) GetPlayback() *PlaybackLog {
+	if x != nil {
+		return x.Playback
+	}
+	return nil
+}
+
+func (x *PlaybackSession) GetHeartbeatIntervalSeconds() int32 {
+	if x != nil {
+		return x.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+type PlaybackLogList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*PlaybackLog         `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaybackLogList) Reset() {
+	*x = PlaybackLogList{}
+	mi := &file_proto_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackLogList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackLogList) ProtoMessage() {}
+
+func (x *PlaybackLogList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackLogList.ProtoReflect.Descriptor instead.
+func (*PlaybackLogList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PlaybackLogList) GetList() []*PlaybackLog {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *PlaybackLogList) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type EventStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SofaScoreEventId int64                  `protobuf:"varint,1,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
+	ViewCount        int64                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EventStats) Reset() {
+	*x = EventStats{}
+	mi := &file_proto_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStats) ProtoMessage() {}
+
+func (x *EventStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStats.ProtoReflect.Descriptor instead.
+func (*EventStats) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *EventStats) GetSofaScoreEventId() int64 {
+	if x != nil {
+		return x.SofaScoreEventId
+	}
+	return 0
+}
+
+func (x *EventStats) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type TopEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*EventStats          `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopEventsResponse) Reset() {
+	*x = TopEventsResponse{}
+	mi := &file_proto_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopEventsResponse) ProtoMessage() {}
+
+func (x *TopEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopEventsResponse.ProtoReflect.Descriptor instead.
+func (*TopEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *TopEventsResponse) GetStats() []*EventStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ApkInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	FileName         string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize         int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PackageName      string                 `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	VersionCode      int32                  `protobuf:"varint,7,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
+	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
+	DownloadToken    string                 `protobuf:"bytes,10,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
+	DownloadUrl      string                 `protobuf:"bytes,11,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsActive         bool                   `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Downloads        int32                  `protobuf:"varint,14,opt,name=downloads,proto3" json:"downloads,omitempty"`
+	PanelUrl         string                 `protobuf:"bytes,15,opt,name=panel_url,json=panelUrl,proto3" json:"panel_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApkInfo) Reset() {
+	*x = ApkInfo{}
+	mi := &file_proto_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApkInfo) ProtoMessage() {}
+
+func (x *ApkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApkInfo.ProtoReflect.Descriptor instead.
+func (*ApkInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ApkInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetVersionCode() int32 {
+	if x != nil {
+		return x.VersionCode
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetMinSdkVersion() int32 {
+	if x != nil {
+		return x.MinSdkVersion
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetTargetSdkVersion() int32 {
+	if x != nil {
+		return x.TargetSdkVersion
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetDownloadToken() string {
+	if x != nil {
+		return x.DownloadToken
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApkInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ApkInfo) GetDownloads() int32 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
+
+func (x *ApkInfo) GetPanelUrl() string {
+	if x != nil {
+		return x.PanelUrl
+	}
+	return ""
+}
+
+type ApkList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ApkInfo             `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApkList) Reset() {
+	*x = ApkList{}
+	mi := &file_proto_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApkList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApkList) ProtoMessage() {}
+
+func (x *ApkList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApkList.ProtoReflect.Descriptor instead.
+func (*ApkList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ApkList) GetVersions() []*ApkInfo {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ApkUploadResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	FileName         string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize         int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PackageName      string                 `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	VersionCode      int32                  `protobuf:"varint,7,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
+	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
+	DownloadToken    string                 `protobuf:"bytes,10,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
+	DownloadUrl      string                 `protobuf:"bytes,11,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApkUploadResponse) Reset() {
+	*x = ApkUploadResponse{}
+	mi := &file_proto_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApkUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApkUploadResponse) ProtoMessage() {}
+
+func (x *ApkUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApkUploadResponse.ProtoReflect.Descriptor instead.
+func (*ApkUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ApkUploadResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApkUploadResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ApkUploadResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetVersionCode() int32 {
+	if x != nil {
+		return x.VersionCode
+	}
+	return 0
+}
+
+func (x *ApkUploadResponse) GetMinSdkVersion() int32 {
+	if x != nil {
+		return x.MinSdkVersion
+	}
+	return 0
+}
+
+func (x *ApkUploadResponse) GetTargetSdkVersion() int32 {
+	if x != nil {
+		return x.TargetSdkVersion
+	}
+	return 0
+}
+
+func (x *ApkUploadResponse) GetDownloadToken() string {
+	if x != nil {
+		return x.DownloadToken
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ApkUploadResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ApkUpdateCheckResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UpdateAvailable  bool                   `protobuf:"varint,1,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
+	LatestVersion    string                 `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	PackageName      string                 `protobuf:"bytes,3,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	VersionCode      int32                  `protobuf:"varint,4,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	DownloadUrl      string                 `protobuf:"bytes,5,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	FileSize         int64                  `protobuf:"varint,7,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
+	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApkUpdateCheckResponse) Reset() {
+	*x = ApkUpdateCheckResponse{}
+	mi := &file_proto_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApkUpdateCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApkUpdateCheckResponse) ProtoMessage() {}
+
+func (x *ApkUpdateCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApkUpdateCheckResponse.ProtoReflect.Descriptor instead.
+func (*ApkUpdateCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ApkUpdateCheckResponse) GetUpdateAvailable() bool {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return false
+}
+
+func (x *ApkUpdateCheckResponse) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+func (x *ApkUpdateCheckResponse) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *ApkUpdateCheckResponse) GetVersionCode() int32 {
+	if x != nil {
+		return x.VersionCode
+	}
+	return 0
+}
+
+func (x *ApkUpdateCheckResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ApkUpdateCheckResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApkUpdateCheckResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *ApkUpdateCheckResponse) GetMinSdkVersion() int32 {
+	if x != nil {
+		return x.MinSdkVersion
+	}
+	return 0
+}
+
+func (x *ApkUpdateCheckResponse) GetTargetSdkVersion() int32 {
+	if x != nil {
+		return x.TargetSdkVersion
+	}
+	return 0
+}
+
+type ApkVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApkVersion) Reset() {
+	*x = ApkVersion{}
+	mi := &file_proto_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApkVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApkVersion) ProtoMessage() {}
+
+func (x *ApkVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApkVersion.ProtoReflect.Descriptor instead.
+func (*ApkVersion) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ApkVersion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApkVersion) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type NotificationSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSubscriptionRequest) Reset() {
+	*x = NotificationSubscriptionRequest{}
+	mi := &file_proto_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SofaScoreEvent) ProtoMessage() {}
+func (*NotificationSubscriptionRequest) ProtoMessage() {}
 
-func (x *SofaScoreEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[19]
+func (x *NotificationSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,156 +6794,160 @@ func (x *SofaScoreEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SofaScoreEvent.ProtoReflect.Descriptor instead.
-func (*SofaScoreEvent) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *SofaScoreEvent) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use NotificationSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*NotificationSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SofaScoreEvent) GetCreatedAt() string {
+func (x *NotificationSubscriptionRequest) GetTargetType() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TargetType
 	}
 	return ""
 }
 
-func (x *SofaScoreEvent) GetUpdatedAt() string {
+func (x *NotificationSubscriptionRequest) GetTargetId() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TargetId
 	}
-	return ""
+	return 0
 }
 
-func (x *SofaScoreEvent) GetSofaScoreEventId() int64 {
-	if x != nil {
-		return x.SofaScoreEventId
-	}
-	return 0
+type NotificationSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TargetType    string                 `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SofaScoreEvent) GetSport() string {
-	if x != nil {
-		return x.Sport
-	}
-	return ""
+func (x *NotificationSubscription) Reset() {
+	*x = NotificationSubscription{}
+	mi := &file_proto_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SofaScoreEvent) GetHomeScore() int32 {
-	if x != nil {
-		return x.HomeScore
-	}
-	return 0
+func (x *NotificationSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SofaScoreEvent) GetHomeTeamId() int64 {
+func (*NotificationSubscription) ProtoMessage() {}
+
+func (x *NotificationSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[96]
 	if x != nil {
-		return x.HomeTeamId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SofaScoreEvent) GetAwayScore() int32 {
-	if x != nil {
-		return x.AwayScore
-	}
-	return 0
+// Deprecated: Use NotificationSubscription.ProtoReflect.Descriptor instead.
+func (*NotificationSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *SofaScoreEvent) GetAwayTeamId() int64 {
+func (x *NotificationSubscription) GetId() uint32 {
 	if x != nil {
-		return x.AwayTeamId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SofaScoreEvent) GetScrapedAt() int64 {
+func (x *NotificationSubscription) GetCreatedAt() string {
 	if x != nil {
-		return x.ScrapedAt
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *SofaScoreEvent) GetCategory() string {
+func (x *NotificationSubscription) GetTargetType() string {
 	if x != nil {
-		return x.Category
+		return x.TargetType
 	}
 	return ""
 }
 
-func (x *SofaScoreEvent) GetStartTimestamp() int64 {
+func (x *NotificationSubscription) GetTargetId() int64 {
 	if x != nil {
-		return x.StartTimestamp
+		return x.TargetId
 	}
 	return 0
 }
 
-func (x *SofaScoreEvent) GetCurrentPeriodStartTimestamp() int64 {
-	if x != nil {
-		return x.CurrentPeriodStartTimestamp
-	}
-	return 0
+type NotificationSubscriptionList struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Subscriptions []*NotificationSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SofaScoreEvent) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
+func (x *NotificationSubscriptionList) Reset() {
+	*x = NotificationSubscriptionList{}
+	mi := &file_proto_api_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SofaScoreEvent) GetTeamHome() *Team {
-	if x != nil {
-		return x.TeamHome
-	}
-	return nil
+func (x *NotificationSubscriptionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SofaScoreEvent) GetTeamAway() *Team {
+func (*NotificationSubscriptionList) ProtoMessage() {}
+
+func (x *NotificationSubscriptionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[97]
 	if x != nil {
-		return x.TeamAway
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SofaScoreEvent) GetLeague() *Tournament {
+// Deprecated: Use NotificationSubscriptionList.ProtoReflect.Descriptor instead.
+func (*NotificationSubscriptionList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NotificationSubscriptionList) GetSubscriptions() []*NotificationSubscription {
 	if x != nil {
-		return x.League
+		return x.Subscriptions
 	}
 	return nil
 }
 
-type EventsList struct {
+type NotificationTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*SofaScoreEvent      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventsList) Reset() {
-	*x = EventsList{}
-	mi := &file_proto_api_proto_msgTypes[20]
+func (x *NotificationTemplateRequest) Reset() {
+	*x = NotificationTemplateRequest{}
+	mi := &file_proto_api_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventsList) String() string {
+func (x *NotificationTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsList) ProtoMessage() {}
+func (*NotificationTemplateRequest) ProtoMessage() {}
 
-func (x *EventsList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[20]
+func (x *NotificationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,70 +6958,50 @@ func (x *EventsList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsList.ProtoReflect.Descriptor instead.
-func (*EventsList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *EventsList) GetData() []*SofaScoreEvent {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *EventsList) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *EventsList) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use NotificationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*NotificationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *EventsList) GetTotal() int64 {
+func (x *NotificationTemplateRequest) GetTitle() string {
 	if x != nil {
-		return x.Total
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *EventsList) GetTotalPages() int32 {
+func (x *NotificationTemplateRequest) GetBody() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Body
 	}
-	return 0
+	return ""
 }
 
-type LogPlaybackRequest struct {
+type NotificationTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeviceToken   string                 `protobuf:"bytes,1,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogPlaybackRequest) Reset() {
-	*x = LogPlaybackRequest{}
-	mi := &file_proto_api_proto_msgTypes[21]
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	mi := &file_proto_api_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogPlaybackRequest) String() string {
+func (x *NotificationTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogPlaybackRequest) ProtoMessage() {}
+func (*NotificationTemplate) ProtoMessage() {}
 
-func (x *LogPlaybackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[21]
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,54 +7012,61 @@ func (x *LogPlaybackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogPlaybackRequest.ProtoReflect.Descriptor instead.
-func (*LogPlaybackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *LogPlaybackRequest) GetDeviceToken() string {
+func (x *NotificationTemplate) GetKind() string {
 	if x != nil {
-		return x.DeviceToken
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *LogPlaybackRequest) GetContent() string {
+func (x *NotificationTemplate) GetTitle() string {
 	if x != nil {
-		return x.Content
+		return x.Title
 	}
 	return ""
 }
 
-func (x *LogPlaybackRequest) GetStartedAt() int64 {
+func (x *NotificationTemplate) GetBody() string {
 	if x != nil {
-		return x.StartedAt
+		return x.Body
 	}
-	return 0
+	return ""
 }
 
-type UpdatePlaybackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EndedAt       int64                  `protobuf:"varint,1,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+func (x *NotificationTemplate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type NotificationTemplateList struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Templates     []*NotificationTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlaybackRequest) Reset() {
-	*x = UpdatePlaybackRequest{}
-	mi := &file_proto_api_proto_msgTypes[22]
+func (x *NotificationTemplateList) Reset() {
+	*x = NotificationTemplateList{}
+	mi := &file_proto_api_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlaybackRequest) String() string {
+func (x *NotificationTemplateList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlaybackRequest) ProtoMessage() {}
+func (*NotificationTemplateList) ProtoMessage() {}
 
-func (x *UpdatePlaybackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[22]
+func (x *NotificationTemplateList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,46 +7077,50 @@ func (x *UpdatePlaybackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlaybackRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlaybackRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use NotificationTemplateList.ProtoReflect.Descriptor instead.
+func (*NotificationTemplateList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UpdatePlaybackRequest) GetEndedAt() int64 {
+func (x *NotificationTemplateList) GetTemplates() []*NotificationTemplate {
 	if x != nil {
-		return x.EndedAt
+		return x.Templates
 	}
-	return 0
+	return nil
 }
 
-type PlaybackLog struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	DeviceId      uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	StartedAt     int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	EndedAt       int64                  `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type NotificationLogEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeviceId         uint32                 `protobuf:"varint,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceName       string                 `protobuf:"bytes,4,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	SofaScoreEventId int64                  `protobuf:"varint,5,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
+	Kind             string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Title            string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Body             string                 `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Provider         string                 `protobuf:"bytes,9,opt,name=provider,proto3" json:"provider,omitempty"`
+	Status           string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Error            string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *PlaybackLog) Reset() {
-	*x = PlaybackLog{}
-	mi := &file_proto_api_proto_msgTypes[23]
+func (x *NotificationLogEntry) Reset() {
+	*x = NotificationLogEntry{}
+	mi := &file_proto_api_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlaybackLog) String() string {
+func (x *NotificationLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaybackLog) ProtoMessage() {}
+func (*NotificationLogEntry) ProtoMessage() {}
 
-func (x *PlaybackLog) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[23]
+func (x *NotificationLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,83 +7131,111 @@ func (x *PlaybackLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaybackLog.ProtoReflect.Descriptor instead.
-func (*PlaybackLog) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use NotificationLogEntry.ProtoReflect.Descriptor instead.
+func (*NotificationLogEntry) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *PlaybackLog) GetId() uint32 {
+func (x *NotificationLogEntry) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *PlaybackLog) GetCreatedAt() string {
+func (x *NotificationLogEntry) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *PlaybackLog) GetUpdatedAt() string {
+func (x *NotificationLogEntry) GetDeviceId() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *NotificationLogEntry) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
 	}
 	return ""
 }
 
-func (x *PlaybackLog) GetDeviceId() uint32 {
+func (x *NotificationLogEntry) GetSofaScoreEventId() int64 {
 	if x != nil {
-		return x.DeviceId
+		return x.SofaScoreEventId
 	}
 	return 0
 }
 
-func (x *PlaybackLog) GetContent() string {
+func (x *NotificationLogEntry) GetKind() string {
 	if x != nil {
-		return x.Content
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *PlaybackLog) GetStartedAt() int64 {
+func (x *NotificationLogEntry) GetTitle() string {
 	if x != nil {
-		return x.StartedAt
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *PlaybackLog) GetEndedAt() int64 {
+func (x *NotificationLogEntry) GetBody() string {
 	if x != nil {
-		return x.EndedAt
+		return x.Body
 	}
-	return 0
+	return ""
+}
+
+func (x *NotificationLogEntry) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *NotificationLogEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationLogEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-type PlaybackLogList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*PlaybackLog         `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+type NotificationLogList struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*NotificationLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlaybackLogList) Reset() {
-	*x = PlaybackLogList{}
-	mi := &file_proto_api_proto_msgTypes[24]
+func (x *NotificationLogList) Reset() {
+	*x = NotificationLogList{}
+	mi := &file_proto_api_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlaybackLogList) String() string {
+func (x *NotificationLogList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaybackLogList) ProtoMessage() {}
+func (*NotificationLogList) ProtoMessage() {}
 
-func (x *PlaybackLogList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[24]
+func (x *NotificationLogList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,48 +7246,57 @@ func (x *PlaybackLogList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaybackLogList.ProtoReflect.Descriptor instead.
-func (*PlaybackLogList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use NotificationLogList.ProtoReflect.Descriptor instead.
+func (*NotificationLogList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *PlaybackLogList) GetList() []*PlaybackLog {
+func (x *NotificationLogList) GetEntries() []*NotificationLogEntry {
 	if x != nil {
-		return x.List
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *PlaybackLogList) GetTotal() uint32 {
+func (x *NotificationLogList) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type EventStats struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SofaScoreEventId int64                  `protobuf:"varint,1,opt,name=sofa_score_event_id,json=sofaScoreEventId,proto3" json:"sofa_score_event_id,omitempty"`
-	ViewCount        int64                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque cursor to send on the next sync.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// More changes are pending; sync again right away with the new cursor.
+	HasMore         bool              `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Events          []*SofaScoreEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Teams           []*Team           `protobuf:"bytes,4,rep,name=teams,proto3" json:"teams,omitempty"`
+	Tournaments     []*Tournament     `protobuf:"bytes,5,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	DeletedEventIds []int64           `protobuf:"varint,6,rep,packed,name=deleted_event_ids,json=deletedEventIds,proto3" json:"deleted_event_ids,omitempty"`
+	// Tournaments the device currently follows; cached events of any other
+	// tournament can be dropped.
+	TournamentIds []uint32 `protobuf:"varint,7,rep,packed,name=tournament_ids,json=tournamentIds,proto3" json:"tournament_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStats) Reset() {
-	*x = EventStats{}
-	mi := &file_proto_api_proto_msgTypes[25]
+func (x *SyncResponse) Reset() {
+	*x = SyncResponse{}
+	mi := &file_proto_api_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStats) String() string {
+func (x *SyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStats) ProtoMessage() {}
+func (*SyncResponse) ProtoMessage() {}
 
-func (x *EventStats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[25]
+func (x *SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,47 +7307,86 @@ func (x *EventStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStats.ProtoReflect.Descriptor instead.
-func (*EventStats) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SyncResponse.ProtoReflect.Descriptor instead.
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *EventStats) GetSofaScoreEventId() int64 {
+func (x *SyncResponse) GetCursor() string {
 	if x != nil {
-		return x.SofaScoreEventId
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-func (x *EventStats) GetViewCount() int64 {
+func (x *SyncResponse) GetHasMore() bool {
 	if x != nil {
-		return x.ViewCount
+		return x.HasMore
 	}
-	return 0
+	return false
 }
 
-type TopEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         []*EventStats          `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SyncResponse) GetEvents() []*SofaScoreEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
 
-func (x *TopEventsResponse) Reset() {
-	*x = TopEventsResponse{}
-	mi := &file_proto_api_proto_msgTypes[26]
+func (x *SyncResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetTournaments() []*Tournament {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetDeletedEventIds() []int64 {
+	if x != nil {
+		return x.DeletedEventIds
+	}
+	return nil
+}
+
+func (x *SyncResponse) GetTournamentIds() []uint32 {
+	if x != nil {
+		return x.TournamentIds
+	}
+	return nil
+}
+
+type FeedSettingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxItems       int32                  `protobuf:"varint,1,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	LookAheadHours int32                  `protobuf:"varint,2,opt,name=look_ahead_hours,json=lookAheadHours,proto3" json:"look_ahead_hours,omitempty"`
+	RecentHours    int32                  `protobuf:"varint,3,opt,name=recent_hours,json=recentHours,proto3" json:"recent_hours,omitempty"`
+	Ordering       string                 `protobuf:"bytes,4,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	Sports         []string               `protobuf:"bytes,5,rep,name=sports,proto3" json:"sports,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FeedSettingsRequest) Reset() {
+	*x = FeedSettingsRequest{}
+	mi := &file_proto_api_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopEventsResponse) String() string {
+func (x *FeedSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopEventsResponse) ProtoMessage() {}
+func (*FeedSettingsRequest) ProtoMessage() {}
 
-func (x *TopEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[26]
+func (x *FeedSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1773,54 +7397,78 @@ func (x *TopEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopEventsResponse.ProtoReflect.Descriptor instead.
-func (*TopEventsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use FeedSettingsRequest.ProtoReflect.Descriptor instead.
+func (*FeedSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *TopEventsResponse) GetStats() []*EventStats {
+func (x *FeedSettingsRequest) GetMaxItems() int32 {
 	if x != nil {
-		return x.Stats
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *FeedSettingsRequest) GetLookAheadHours() int32 {
+	if x != nil {
+		return x.LookAheadHours
+	}
+	return 0
+}
+
+func (x *FeedSettingsRequest) GetRecentHours() int32 {
+	if x != nil {
+		return x.RecentHours
+	}
+	return 0
+}
+
+func (x *FeedSettingsRequest) GetOrdering() string {
+	if x != nil {
+		return x.Ordering
+	}
+	return ""
+}
+
+func (x *FeedSettingsRequest) GetSports() []string {
+	if x != nil {
+		return x.Sports
 	}
 	return nil
 }
 
-type ApkInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	FileName         string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileSize         int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PackageName      string                 `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	VersionCode      int32                  `protobuf:"varint,7,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
-	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
-	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
-	DownloadToken    string                 `protobuf:"bytes,10,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
-	DownloadUrl      string                 `protobuf:"bytes,11,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsActive         bool                   `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Downloads        int32                  `protobuf:"varint,14,opt,name=downloads,proto3" json:"downloads,omitempty"`
-	PanelUrl         string                 `protobuf:"bytes,15,opt,name=panel_url,json=panelUrl,proto3" json:"panel_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type FeedSettings struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeviceId       uint32                 `protobuf:"varint,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	PackageName    string                 `protobuf:"bytes,5,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	MaxItems       int32                  `protobuf:"varint,6,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	LookAheadHours int32                  `protobuf:"varint,7,opt,name=look_ahead_hours,json=lookAheadHours,proto3" json:"look_ahead_hours,omitempty"`
+	RecentHours    int32                  `protobuf:"varint,8,opt,name=recent_hours,json=recentHours,proto3" json:"recent_hours,omitempty"`
+	Ordering       string                 `protobuf:"bytes,9,opt,name=ordering,proto3" json:"ordering,omitempty"`
+	Sports         []string               `protobuf:"bytes,10,rep,name=sports,proto3" json:"sports,omitempty"`
+	Device         *Device                `protobuf:"bytes,11,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ApkInfo) Reset() {
-	*x = ApkInfo{}
-	mi := &file_proto_api_proto_msgTypes[27]
+func (x *FeedSettings) Reset() {
+	*x = FeedSettings{}
+	mi := &file_proto_api_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApkInfo) String() string {
+func (x *FeedSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApkInfo) ProtoMessage() {}
+func (*FeedSettings) ProtoMessage() {}
 
-func (x *ApkInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[27]
+func (x *FeedSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,138 +7479,110 @@ func (x *ApkInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApkInfo.ProtoReflect.Descriptor instead.
-func (*ApkInfo) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use FeedSettings.ProtoReflect.Descriptor instead.
+func (*FeedSettings) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ApkInfo) GetId() uint32 {
+func (x *FeedSettings) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ApkInfo) GetVersion() string {
+func (x *FeedSettings) GetCreatedAt() string {
 	if x != nil {
-		return x.Version
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ApkInfo) GetFileName() string {
+func (x *FeedSettings) GetUpdatedAt() string {
 	if x != nil {
-		return x.FileName
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ApkInfo) GetFileSize() int64 {
+func (x *FeedSettings) GetDeviceId() uint32 {
 	if x != nil {
-		return x.FileSize
+		return x.DeviceId
 	}
 	return 0
 }
 
-func (x *ApkInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *ApkInfo) GetPackageName() string {
+func (x *FeedSettings) GetPackageName() string {
 	if x != nil {
 		return x.PackageName
 	}
 	return ""
 }
 
-func (x *ApkInfo) GetVersionCode() int32 {
+func (x *FeedSettings) GetMaxItems() int32 {
 	if x != nil {
-		return x.VersionCode
+		return x.MaxItems
 	}
 	return 0
 }
 
-func (x *ApkInfo) GetMinSdkVersion() int32 {
+func (x *FeedSettings) GetLookAheadHours() int32 {
 	if x != nil {
-		return x.MinSdkVersion
+		return x.LookAheadHours
 	}
 	return 0
 }
 
-func (x *ApkInfo) GetTargetSdkVersion() int32 {
+func (x *FeedSettings) GetRecentHours() int32 {
 	if x != nil {
-		return x.TargetSdkVersion
+		return x.RecentHours
 	}
 	return 0
 }
 
-func (x *ApkInfo) GetDownloadToken() string {
-	if x != nil {
-		return x.DownloadToken
-	}
-	return ""
-}
-
-func (x *ApkInfo) GetDownloadUrl() string {
-	if x != nil {
-		return x.DownloadUrl
-	}
-	return ""
-}
-
-func (x *ApkInfo) GetCreatedAt() string {
+func (x *FeedSettings) GetOrdering() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Ordering
 	}
 	return ""
 }
 
-func (x *ApkInfo) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *ApkInfo) GetDownloads() int32 {
+func (x *FeedSettings) GetSports() []string {
 	if x != nil {
-		return x.Downloads
+		return x.Sports
 	}
-	return 0
+	return nil
 }
 
-func (x *ApkInfo) GetPanelUrl() string {
+func (x *FeedSettings) GetDevice() *Device {
 	if x != nil {
-		return x.PanelUrl
+		return x.Device
 	}
-	return ""
+	return nil
 }
 
-type ApkList struct {
+type FeedSettingsList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Versions      []*ApkInfo             `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Settings      []*FeedSettings        `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApkList) Reset() {
-	*x = ApkList{}
-	mi := &file_proto_api_proto_msgTypes[28]
+func (x *FeedSettingsList) Reset() {
+	*x = FeedSettingsList{}
+	mi := &file_proto_api_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApkList) String() string {
+func (x *FeedSettingsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApkList) ProtoMessage() {}
+func (*FeedSettingsList) ProtoMessage() {}
 
-func (x *ApkList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[28]
+func (x *FeedSettingsList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,51 +7593,59 @@ func (x *ApkList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApkList.ProtoReflect.Descriptor instead.
-func (*ApkList) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use FeedSettingsList.ProtoReflect.Descriptor instead.
+func (*FeedSettingsList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ApkList) GetVersions() []*ApkInfo {
+func (x *FeedSettingsList) GetSettings() []*FeedSettings {
 	if x != nil {
-		return x.Versions
+		return x.Settings
 	}
 	return nil
 }
 
-type ApkUploadResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	FileName         string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileSize         int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PackageName      string                 `protobuf:"bytes,6,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	VersionCode      int32                  `protobuf:"varint,7,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
-	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
-	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
-	DownloadToken    string                 `protobuf:"bytes,10,opt,name=download_token,json=downloadToken,proto3" json:"download_token,omitempty"`
-	DownloadUrl      string                 `protobuf:"bytes,11,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type EventDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event with both teams and its tournament.
+	Event     *SofaScoreEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Round     int32           `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	RoundName string          `protobuf:"bytes,3,opt,name=round_name,json=roundName,proto3" json:"round_name,omitempty"`
+	Season    string          `protobuf:"bytes,4,opt,name=season,proto3" json:"season,omitempty"`
+	Venue     string          `protobuf:"bytes,5,opt,name=venue,proto3" json:"venue,omitempty"`
+	VenueCity string          `protobuf:"bytes,6,opt,name=venue_city,json=venueCity,proto3" json:"venue_city,omitempty"`
+	Referee   string          `protobuf:"bytes,7,opt,name=referee,proto3" json:"referee,omitempty"`
+	// Latest finished events of each team, most recent first, and their
+	// results from that team's point of view, e.g. "WWDLW".
+	HomeLastEvents []*SofaScoreEvent `protobuf:"bytes,8,rep,name=home_last_events,json=homeLastEvents,proto3" json:"home_last_events,omitempty"`
+	HomeForm       string            `protobuf:"bytes,9,opt,name=home_form,json=homeForm,proto3" json:"home_form,omitempty"`
+	AwayLastEvents []*SofaScoreEvent `protobuf:"bytes,10,rep,name=away_last_events,json=awayLastEvents,proto3" json:"away_last_events,omitempty"`
+	AwayForm       string            `protobuf:"bytes,11,opt,name=away_form,json=awayForm,proto3" json:"away_form,omitempty"`
+	// Previous meetings between both teams, most recent first. Wins are
+	// counted from the point of view of this event's teams.
+	HeadToHead    []*SofaScoreEvent `protobuf:"bytes,12,rep,name=head_to_head,json=headToHead,proto3" json:"head_to_head,omitempty"`
+	HomeWins      int32             `protobuf:"varint,13,opt,name=home_wins,json=homeWins,proto3" json:"home_wins,omitempty"`
+	AwayWins      int32             `protobuf:"varint,14,opt,name=away_wins,json=awayWins,proto3" json:"away_wins,omitempty"`
+	Draws         int32             `protobuf:"varint,15,opt,name=draws,proto3" json:"draws,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApkUploadResponse) Reset() {
-	*x = ApkUploadResponse{}
-	mi := &file_proto_api_proto_msgTypes[29]
+func (x *EventDetail) Reset() {
+	*x = EventDetail{}
+	mi := &file_proto_api_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApkUploadResponse) String() string {
+func (x *EventDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApkUploadResponse) ProtoMessage() {}
+func (*EventDetail) ProtoMessage() {}
 
-func (x *ApkUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[29]
+func (x *EventDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,125 +7656,213 @@ func (x *ApkUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApkUploadResponse.ProtoReflect.Descriptor instead.
-func (*ApkUploadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use EventDetail.ProtoReflect.Descriptor instead.
+func (*EventDetail) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *EventDetail) GetEvent() *SofaScoreEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventDetail) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *EventDetail) GetRoundName() string {
+	if x != nil {
+		return x.RoundName
+	}
+	return ""
+}
+
+func (x *EventDetail) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
 }
 
-func (x *ApkUploadResponse) GetId() uint32 {
+func (x *EventDetail) GetVenue() string {
 	if x != nil {
-		return x.Id
+		return x.Venue
 	}
-	return 0
+	return ""
 }
 
-func (x *ApkUploadResponse) GetVersion() string {
+func (x *EventDetail) GetVenueCity() string {
 	if x != nil {
-		return x.Version
+		return x.VenueCity
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetFileName() string {
+func (x *EventDetail) GetReferee() string {
 	if x != nil {
-		return x.FileName
+		return x.Referee
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetFileSize() int64 {
+func (x *EventDetail) GetHomeLastEvents() []*SofaScoreEvent {
 	if x != nil {
-		return x.FileSize
+		return x.HomeLastEvents
 	}
-	return 0
+	return nil
 }
 
-func (x *ApkUploadResponse) GetDescription() string {
+func (x *EventDetail) GetHomeForm() string {
 	if x != nil {
-		return x.Description
+		return x.HomeForm
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetPackageName() string {
+func (x *EventDetail) GetAwayLastEvents() []*SofaScoreEvent {
 	if x != nil {
-		return x.PackageName
+		return x.AwayLastEvents
+	}
+	return nil
+}
+
+func (x *EventDetail) GetAwayForm() string {
+	if x != nil {
+		return x.AwayForm
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetVersionCode() int32 {
+func (x *EventDetail) GetHeadToHead() []*SofaScoreEvent {
 	if x != nil {
-		return x.VersionCode
+		return x.HeadToHead
+	}
+	return nil
+}
+
+func (x *EventDetail) GetHomeWins() int32 {
+	if x != nil {
+		return x.HomeWins
 	}
 	return 0
 }
 
-func (x *ApkUploadResponse) GetMinSdkVersion() int32 {
+func (x *EventDetail) GetAwayWins() int32 {
 	if x != nil {
-		return x.MinSdkVersion
+		return x.AwayWins
 	}
 	return 0
 }
 
-func (x *ApkUploadResponse) GetTargetSdkVersion() int32 {
+func (x *EventDetail) GetDraws() int32 {
 	if x != nil {
-		return x.TargetSdkVersion
+		return x.Draws
 	}
 	return 0
 }
 
-func (x *ApkUploadResponse) GetDownloadToken() string {
+type TranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityKey     string                 `protobuf:"bytes,2,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranslationRequest) Reset() {
+	*x = TranslationRequest{}
+	mi := &file_proto_api_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationRequest) ProtoMessage() {}
+
+func (x *TranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[108]
 	if x != nil {
-		return x.DownloadToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationRequest.ProtoReflect.Descriptor instead.
+func (*TranslationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TranslationRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetDownloadUrl() string {
+func (x *TranslationRequest) GetEntityKey() string {
 	if x != nil {
-		return x.DownloadUrl
+		return x.EntityKey
 	}
 	return ""
 }
 
-func (x *ApkUploadResponse) GetCreatedAt() string {
+func (x *TranslationRequest) GetLocale() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Locale
 	}
 	return ""
 }
 
-type ApkUpdateCheckResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UpdateAvailable  bool                   `protobuf:"varint,1,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
-	LatestVersion    string                 `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
-	PackageName      string                 `protobuf:"bytes,3,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	VersionCode      int32                  `protobuf:"varint,4,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
-	DownloadUrl      string                 `protobuf:"bytes,5,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	FileSize         int64                  `protobuf:"varint,7,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	MinSdkVersion    int32                  `protobuf:"varint,8,opt,name=min_sdk_version,json=minSdkVersion,proto3" json:"min_sdk_version,omitempty"`
-	TargetSdkVersion int32                  `protobuf:"varint,9,opt,name=target_sdk_version,json=targetSdkVersion,proto3" json:"target_sdk_version,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *TranslationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ApkUpdateCheckResponse) Reset() {
-	*x = ApkUpdateCheckResponse{}
-	mi := &file_proto_api_proto_msgTypes[30]
+type Translation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityKey     string                 `protobuf:"bytes,5,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Source        string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	mi := &file_proto_api_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApkUpdateCheckResponse) String() string {
+func (x *Translation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApkUpdateCheckResponse) ProtoMessage() {}
+func (*Translation) ProtoMessage() {}
 
-func (x *ApkUpdateCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[30]
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,97 +7873,90 @@ func (x *ApkUpdateCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApkUpdateCheckResponse.ProtoReflect.Descriptor instead.
-func (*ApkUpdateCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ApkUpdateCheckResponse) GetUpdateAvailable() bool {
+func (x *Translation) GetId() uint32 {
 	if x != nil {
-		return x.UpdateAvailable
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *ApkUpdateCheckResponse) GetLatestVersion() string {
+func (x *Translation) GetCreatedAt() string {
 	if x != nil {
-		return x.LatestVersion
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetPackageName() string {
+func (x *Translation) GetUpdatedAt() string {
 	if x != nil {
-		return x.PackageName
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetVersionCode() int32 {
-	if x != nil {
-		return x.VersionCode
-	}
-	return 0
-}
-
-func (x *ApkUpdateCheckResponse) GetDownloadUrl() string {
+func (x *Translation) GetEntityType() string {
 	if x != nil {
-		return x.DownloadUrl
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetDescription() string {
+func (x *Translation) GetEntityKey() string {
 	if x != nil {
-		return x.Description
+		return x.EntityKey
 	}
 	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetFileSize() int64 {
+func (x *Translation) GetLocale() string {
 	if x != nil {
-		return x.FileSize
+		return x.Locale
 	}
-	return 0
+	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetMinSdkVersion() int32 {
+func (x *Translation) GetName() string {
 	if x != nil {
-		return x.MinSdkVersion
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ApkUpdateCheckResponse) GetTargetSdkVersion() int32 {
+func (x *Translation) GetSource() string {
 	if x != nil {
-		return x.TargetSdkVersion
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-type ApkVersion struct {
+type TranslationList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Translations  []*Translation         `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApkVersion) Reset() {
-	*x = ApkVersion{}
-	mi := &file_proto_api_proto_msgTypes[31]
+func (x *TranslationList) Reset() {
+	*x = TranslationList{}
+	mi := &file_proto_api_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApkVersion) String() string {
+func (x *TranslationList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApkVersion) ProtoMessage() {}
+func (*TranslationList) ProtoMessage() {}
 
-func (x *ApkVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_proto_msgTypes[31]
+func (x *TranslationList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,23 +7967,23 @@ func (x *ApkVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApkVersion.ProtoReflect.Descriptor instead.
-func (*ApkVersion) Descriptor() ([]byte, []int) {
-	return file_proto_api_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use TranslationList.ProtoReflect.Descriptor instead.
+func (*TranslationList) Descriptor() ([]byte, []int) {
+	return file_proto_api_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ApkVersion) GetId() uint32 {
+func (x *TranslationList) GetTranslations() []*Translation {
 	if x != nil {
-		return x.Id
+		return x.Translations
 	}
-	return 0
+	return nil
 }
 
-func (x *ApkVersion) GetUrl() string {
+func (x *TranslationList) GetTotal() int64 {
 	if x != nil {
-		return x.Url
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
 var File_proto_api_proto protoreflect.FileDescriptor
@@ -2290,17 +7999,35 @@ const file_proto_api_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\tR\x06status\"?\n" +
 	"\vAuthRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"o\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x83\x01\n" +
 	"\fAuthResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
 	"\x05token\x18\x03 \x01(\tR\x05token\x12#\n" +
-	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\"w\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\"\xa5\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12!\n" +
+	"\fdevice_quota\x18\x05 \x01(\x05R\vdeviceQuota\x12!\n" +
+	"\fdevice_count\x18\x06 \x01(\x03R\vdeviceCount\"/\n" +
+	"\bUserList\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.sofascore.UserR\x04data\"5\n" +
+	"\x10UserQuotaRequest\x12!\n" +
+	"\fdevice_quota\x18\x01 \x01(\x05R\vdeviceQuota\"\x97\x02\n" +
 	"\x15DeviceRegisterRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x04 \x01(\tR\aversion\"\xee\x01\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12!\n" +
+	"\fpackage_name\x18\x06 \x01(\tR\vpackageName\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12 \n" +
+	"\vfingerprint\x18\b \x01(\tR\vfingerprint\x12'\n" +
+	"\x0fenrollment_code\x18\t \x01(\tR\x0eenrollmentCode\"\xa2\x04\n" +
 	"\x06Device\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2312,7 +8039,21 @@ const file_proto_api_proto_rawDesc = "" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1b\n" +
 	"\tlast_seen\x18\a \x01(\x03R\blastSeen\x12\x18\n" +
 	"\aversion\x18\b \x01(\tR\aversion\x12\x19\n" +
-	"\biptv_url\x18\t \x01(\tR\aiptvUrl\"\x94\x01\n" +
+	"\biptv_url\x18\t \x01(\tR\aiptvUrl\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12!\n" +
+	"\fpackage_name\x18\v \x01(\tR\vpackageName\x12\x16\n" +
+	"\x06locale\x18\f \x01(\tR\x06locale\x12\x16\n" +
+	"\x06secret\x18\r \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\x0e \x01(\x03R\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x0f \x01(\rR\tmanagerId\x12#\n" +
+	"\rmanager_email\x18\x10 \x01(\tR\fmanagerEmail\x12 \n" +
+	"\vfingerprint\x18\x11 \x01(\tR\vfingerprint\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x12 \x01(\x03R\tblockedAt\x12!\n" +
+	"\fblock_reason\x18\x13 \x01(\tR\vblockReason\"\x94\x01\n" +
 	"\n" +
 	"DeviceList\x12%\n" +
 	"\x04data\x18\x01 \x03(\v2\x11.sofascore.DeviceR\x04data\x12\x12\n" +
@@ -2322,7 +8063,230 @@ const file_proto_api_proto_rawDesc = "" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
 	"totalPages\"\x1d\n" +
 	"\tDeviceUrl\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\";\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\x8c\x01\n" +
+	"\x10HeartbeatRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
+	"\fpackage_name\x18\x02 \x01(\tR\vpackageName\x12!\n" +
+	"\fnetwork_type\x18\x03 \x01(\tR\vnetworkType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"~\n" +
+	"\x11HeartbeatResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\rR\tsessionId\x12\x1f\n" +
+	"\vserver_time\x18\x02 \x01(\x03R\n" +
+	"serverTime\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\"\xbd\x02\n" +
+	"\rDeviceSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\rR\bdeviceId\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12*\n" +
+	"\x11last_heartbeat_at\x18\x05 \x01(\x03R\x0flastHeartbeatAt\x12\x19\n" +
+	"\bended_at\x18\x06 \x01(\x03R\aendedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\tR\aversion\x12!\n" +
+	"\fpackage_name\x18\b \x01(\tR\vpackageName\x12!\n" +
+	"\fnetwork_type\x18\t \x01(\tR\vnetworkType\x12\x18\n" +
+	"\acontent\x18\n" +
+	" \x01(\tR\acontent\"\xa2\x01\n" +
+	"\x11DeviceSessionList\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.sofascore.DeviceSessionR\x04data\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"\xcf\x01\n" +
+	"\fDeviceUptime\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\rR\bdeviceId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12%\n" +
+	"\x0euptime_seconds\x18\x04 \x01(\x03R\ruptimeSeconds\x12!\n" +
+	"\fuptime_ratio\x18\x05 \x01(\x01R\vuptimeRatio\x124\n" +
+	"\bsessions\x18\x06 \x03(\v2\x18.sofascore.DeviceSessionR\bsessions\",\n" +
+	"\x12BlockDeviceRequest\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xc6\x01\n" +
+	"\x10DeviceAuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\rR\bdeviceId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x05 \x01(\tR\tuserEmail\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"B\n" +
+	"\x0fDeviceAuditList\x12/\n" +
+	"\x04data\x18\x01 \x03(\v2\x1b.sofascore.DeviceAuditEntryR\x04data\"e\n" +
+	"\x14DuplicateDeviceGroup\x12 \n" +
+	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12+\n" +
+	"\adevices\x18\x02 \x03(\v2\x11.sofascore.DeviceR\adevices\"O\n" +
+	"\x18DuplicateDeviceGroupList\x123\n" +
+	"\x04data\x18\x01 \x03(\v2\x1f.sofascore.DuplicateDeviceGroupR\x04data\"8\n" +
+	"\x15EnrollmentCodeRequest\x12\x1f\n" +
+	"\vttl_minutes\x18\x01 \x01(\x05R\n" +
+	"ttlMinutes\"\xf0\x01\n" +
+	"\x0eEnrollmentCode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vredeemed_at\x18\x05 \x01(\x03R\n" +
+	"redeemedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x06 \x01(\rR\bdeviceId\x12\x1f\n" +
+	"\vdevice_name\x18\a \x01(\tR\n" +
+	"deviceName\x12\x1d\n" +
+	"\n" +
+	"qr_payload\x18\b \x01(\tR\tqrPayload\"C\n" +
+	"\x12EnrollmentCodeList\x12-\n" +
+	"\x04data\x18\x01 \x03(\v2\x19.sofascore.EnrollmentCodeR\x04data\")\n" +
+	"\x13EnrollDeviceRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"e\n" +
+	"\x14DeviceCommandRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12\x1f\n" +
+	"\vttl_minutes\x18\x03 \x01(\x05R\n" +
+	"ttlMinutes\"\xbd\x02\n" +
+	"\rDeviceCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\rR\bdeviceId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03R\texpiresAt\x12!\n" +
+	"\fdelivered_at\x18\b \x01(\x03R\vdeliveredAt\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\x03R\vcompletedAt\x12\x16\n" +
+	"\x06result\x18\n" +
+	" \x01(\tR\x06result\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\"\xa2\x01\n" +
+	"\x11DeviceCommandList\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.sofascore.DeviceCommandR\x04data\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"D\n" +
+	"\x10DeviceCommandAck\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06result\x18\x02 \x01(\tR\x06result\"\x92\x01\n" +
+	"\vPlanRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rduration_days\x18\x02 \x01(\x05R\fdurationDays\x12'\n" +
+	"\x0fmax_tournaments\x18\x03 \x01(\x05R\x0emaxTournaments\x12!\n" +
+	"\flive_updates\x18\x04 \x01(\bR\vliveUpdates\"\xba\x01\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rduration_days\x18\x04 \x01(\x05R\fdurationDays\x12'\n" +
+	"\x0fmax_tournaments\x18\x05 \x01(\x05R\x0emaxTournaments\x12!\n" +
+	"\flive_updates\x18\x06 \x01(\bR\vliveUpdates\"/\n" +
+	"\bPlanList\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.sofascore.PlanR\x04data\"B\n" +
+	"\x13SubscriptionRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\rR\x06planId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\x87\x02\n" +
+	"\x12DeviceSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\rR\bdeviceId\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12#\n" +
+	"\x04plan\x18\x04 \x01(\v2\x0f.sofascore.PlanR\x04plan\x12\x1b\n" +
+	"\tstarts_at\x18\x05 \x01(\x03R\bstartsAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12*\n" +
+	"\x11expiry_flagged_at\x18\b \x01(\x03R\x0fexpiryFlaggedAt\"\xac\x01\n" +
+	"\x16DeviceSubscriptionList\x121\n" +
+	"\x04data\x18\x01 \x03(\v2\x1d.sofascore.DeviceSubscriptionR\x04data\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"`\n" +
+	"\x11SubscriptionError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\xf9\x01\n" +
+	"\x12DeviceGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12!\n" +
+	"\frule_package\x18\x04 \x01(\tR\vrulePackage\x12!\n" +
+	"\frule_version\x18\x05 \x01(\tR\vruleVersion\x12#\n" +
+	"\rrule_platform\x18\x06 \x01(\tR\frulePlatform\x12&\n" +
+	"\x0frule_manager_id\x18\a \x01(\rR\rruleManagerId\"\xc4\x02\n" +
+	"\vDeviceGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12!\n" +
+	"\fdevice_count\x18\x06 \x01(\x03R\vdeviceCount\x12!\n" +
+	"\frule_package\x18\a \x01(\tR\vrulePackage\x12!\n" +
+	"\frule_version\x18\b \x01(\tR\vruleVersion\x12#\n" +
+	"\rrule_platform\x18\t \x01(\tR\frulePlatform\x12&\n" +
+	"\x0frule_manager_id\x18\n" +
+	" \x01(\rR\rruleManagerId\"=\n" +
+	"\x0fDeviceGroupList\x12*\n" +
+	"\x04data\x18\x01 \x03(\v2\x16.sofascore.DeviceGroupR\x04data\":\n" +
+	"\x19DeviceGroupMembersRequest\x12\x1d\n" +
+	"\n" +
+	"device_ids\x18\x01 \x03(\rR\tdeviceIds\"f\n" +
+	"\x18DeviceGroupMembersResult\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x03R\baffected\x12.\n" +
+	"\x13rejected_device_ids\x18\x02 \x03(\rR\x11rejectedDeviceIds\"|\n" +
+	"\x19DeviceGroupTournamentList\x12&\n" +
+	"\x0fdevice_group_id\x18\x01 \x01(\rR\rdeviceGroupId\x127\n" +
+	"\vtournaments\x18\x02 \x03(\v2\x15.sofascore.TournamentR\vtournaments\"\x7f\n" +
+	"\x10ConfigKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\xc6\x01\n" +
+	"\tConfigKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12#\n" +
+	"\rdefault_value\x18\x06 \x01(\tR\fdefaultValue\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"9\n" +
+	"\rConfigKeyList\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.sofascore.ConfigKeyR\x04data\"m\n" +
+	"\x12ConfigValueRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\x03 \x01(\tR\ascopeId\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\x95\x01\n" +
+	"\vConfigValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\tR\tupdatedAt\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\x05 \x01(\tR\ascopeId\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\tR\x05value\"=\n" +
+	"\x0fConfigValueList\x12*\n" +
+	"\x04data\x18\x01 \x03(\v2\x16.sofascore.ConfigValueR\x04data\"a\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"Z\n" +
+	"\fConfigBundle\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x120\n" +
+	"\aentries\x18\x02 \x03(\v2\x16.sofascore.ConfigEntryR\aentries\";\n" +
 	"\x11TournamentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\"\x9a\x01\n" +
@@ -2356,7 +8320,25 @@ const file_proto_api_proto_rawDesc = "" +
 	"tournament\x18\a \x01(\v2\x15.sofascore.TournamentR\n" +
 	"tournament\"b\n" +
 	"\x14DeviceTournamentList\x12J\n" +
-	"\x12device_tournaments\x18\x01 \x03(\v2\x1b.sofascore.DeviceTournamentR\x11deviceTournaments\"\xc2\x01\n" +
+	"\x12device_tournaments\x18\x01 \x03(\v2\x1b.sofascore.DeviceTournamentR\x11deviceTournaments\"I\n" +
+	"\x11AssignTeamRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\rR\bdeviceId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x03R\x06teamId\".\n" +
+	"\x11SetTeamIdsRequest\x12\x19\n" +
+	"\bteam_ids\x18\x01 \x03(\x03R\ateamIds\"\xe0\x01\n" +
+	"\n" +
+	"DeviceTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x04 \x01(\rR\bdeviceId\x12\x17\n" +
+	"\ateam_id\x18\x05 \x01(\x03R\x06teamId\x12)\n" +
+	"\x06device\x18\x06 \x01(\v2\x11.sofascore.DeviceR\x06device\x12#\n" +
+	"\x04team\x18\a \x01(\v2\x0f.sofascore.TeamR\x04team\"J\n" +
+	"\x0eDeviceTeamList\x128\n" +
+	"\fdevice_teams\x18\x01 \x03(\v2\x15.sofascore.DeviceTeamR\vdeviceTeams\"\xc2\x01\n" +
 	"\x16GlobalTournamentConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2368,7 +8350,37 @@ const file_proto_api_proto_rawDesc = "" +
 	"tournament\x18\x05 \x01(\v2\x15.sofascore.TournamentR\n" +
 	"tournament\"Y\n" +
 	"\x1aGlobalTournamentConfigList\x12;\n" +
-	"\aconfigs\x18\x01 \x03(\v2!.sofascore.GlobalTournamentConfigR\aconfigs\"\xcb\x01\n" +
+	"\aconfigs\x18\x01 \x03(\v2!.sofascore.GlobalTournamentConfigR\aconfigs\"P\n" +
+	"\fSportRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\x97\x01\n" +
+	"\x05Sport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"5\n" +
+	"\tSportList\x12(\n" +
+	"\x06sports\x18\x01 \x03(\v2\x10.sofascore.SportR\x06sports\"Z\n" +
+	"\x16TrendingCountryRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\xa1\x01\n" +
+	"\x0fTrendingCountry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"O\n" +
+	"\x13TrendingCountryList\x128\n" +
+	"\tcountries\x18\x01 \x03(\v2\x1a.sofascore.TrendingCountryR\tcountries\"\xcb\x01\n" +
 	"\x04Team\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
 	"\ateam_id\x18\x02 \x01(\x03R\x06teamId\x12\x19\n" +
@@ -2377,7 +8389,7 @@ const file_proto_api_proto_rawDesc = "" +
 	"\x0fsecondary_color\x18\x05 \x01(\tR\x0esecondaryColor\x12\x1d\n" +
 	"\n" +
 	"text_color\x18\x06 \x01(\tR\ttextColor\x12\x12\n" +
-	"\x04name\x18\a \x01(\tR\x04name\"\xed\x04\n" +
+	"\x04name\x18\a \x01(\tR\x04name\"\xfb\x05\n" +
 	"\x0eSofaScoreEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2403,7 +8415,13 @@ const file_proto_api_proto_rawDesc = "" +
 	"\x04slug\x18\x0e \x01(\tR\x04slug\x12,\n" +
 	"\tteam_home\x18\x0f \x01(\v2\x0f.sofascore.TeamR\bteamHome\x12,\n" +
 	"\tteam_away\x18\x10 \x01(\v2\x0f.sofascore.TeamR\bteamAway\x12-\n" +
-	"\x06league\x18\x11 \x01(\v2\x15.sofascore.TournamentR\x06league\"\x9c\x01\n" +
+	"\x06league\x18\x11 \x01(\v2\x15.sofascore.TournamentR\x06league\x12\x1f\n" +
+	"\vstatus_code\x18\x12 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vstatus_type\x18\x13 \x01(\tR\n" +
+	"statusType\x12-\n" +
+	"\x12status_description\x18\x14 \x01(\tR\x11statusDescription\x12\x1b\n" +
+	"\tleague_id\x18\x15 \x01(\rR\bleagueId\"\xd6\x02\n" +
 	"\n" +
 	"EventsList\x12-\n" +
 	"\x04data\x18\x01 \x03(\v2\x19.sofascore.SofaScoreEventR\x04data\x12\x12\n" +
@@ -2411,7 +8429,102 @@ const file_proto_api_proto_rawDesc = "" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x03R\x05total\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"p\n" +
+	"totalPages\x12-\n" +
+	"\x04live\x18\x06 \x03(\v2\x19.sofascore.SofaScoreEventR\x04live\x125\n" +
+	"\bupcoming\x18\a \x03(\v2\x19.sofascore.SofaScoreEventR\bupcoming\x121\n" +
+	"\x06recent\x18\b \x03(\v2\x19.sofascore.SofaScoreEventR\x06recent\x12\x1f\n" +
+	"\vnext_cursor\x18\t \x01(\tR\n" +
+	"nextCursor\"\xab\x03\n" +
+	"\n" +
+	"EventDelta\x12-\n" +
+	"\x13sofa_score_event_id\x18\x01 \x01(\x03R\x10sofaScoreEventId\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\rR\bleagueId\x12\x1d\n" +
+	"\n" +
+	"home_score\x18\x03 \x01(\x05R\thomeScore\x12\x1d\n" +
+	"\n" +
+	"away_score\x18\x04 \x01(\x05R\tawayScore\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vstatus_type\x18\x06 \x01(\tR\n" +
+	"statusType\x12-\n" +
+	"\x12status_description\x18\a \x01(\tR\x11statusDescription\x12'\n" +
+	"\x0fstart_timestamp\x18\b \x01(\x03R\x0estartTimestamp\x12C\n" +
+	"\x1ecurrent_period_start_timestamp\x18\t \x01(\x03R\x1bcurrentPeriodStartTimestamp\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x15\n" +
+	"\x06is_new\x18\v \x01(\bR\x05isNew\"\x85\x01\n" +
+	"\x12TrendingEventsList\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\x02 \x01(\x03R\tfetchedAt\x12-\n" +
+	"\x04data\x18\x03 \x03(\v2\x19.sofascore.SofaScoreEventR\x04data\"z\n" +
+	"\x12DeviceStatusChange\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\rR\bdeviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06online\x18\x03 \x01(\bR\x06online\x12\x1b\n" +
+	"\tlast_seen\x18\x04 \x01(\x03R\blastSeen\"\xc7\x01\n" +
+	"\x12CrashReportSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05fatal\x18\x03 \x01(\bR\x05fatal\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x19\n" +
+	"\bapp_name\x18\x05 \x01(\tR\aappName\x12\x1f\n" +
+	"\vapp_version\x18\x06 \x01(\tR\n" +
+	"appVersion\x12\x1a\n" +
+	"\bplatform\x18\a \x01(\tR\bplatform\"\xa2\x01\n" +
+	"\x06JobRun\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05tasks\x18\x04 \x01(\x05R\x05tasks\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x9a\x01\n" +
+	"\x0eWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\x0etournament_ids\x18\x04 \x03(\rR\rtournamentIds\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"\xe1\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12%\n" +
+	"\x0etournament_ids\x18\x06 \x03(\rR\rtournamentIds\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12\x16\n" +
+	"\x06secret\x18\b \x01(\tR\x06secret\"=\n" +
+	"\vWebhookList\x12.\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x12.sofascore.WebhookR\bwebhooks\"\xe9\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12'\n" +
+	"\x0fsubscription_id\x18\x03 \x01(\rR\x0esubscriptionId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\x03R\rnextAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\x03R\vdeliveredAt\x12\x18\n" +
+	"\apayload\x18\v \x01(\tR\apayload\"g\n" +
+	"\x13WebhookDeliveryList\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.sofascore.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"p\n" +
 	"\x12LogPlaybackRequest\x12!\n" +
 	"\fdevice_token\x18\x01 \x01(\tR\vdeviceToken\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
@@ -2429,7 +8542,10 @@ const file_proto_api_proto_rawDesc = "" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"started_at\x18\x06 \x01(\x03R\tstartedAt\x12\x19\n" +
-	"\bended_at\x18\a \x01(\x03R\aendedAt\"S\n" +
+	"\bended_at\x18\a \x01(\x03R\aendedAt\"\x83\x01\n" +
+	"\x0fPlaybackSession\x122\n" +
+	"\bplayback\x18\x01 \x01(\v2\x16.sofascore.PlaybackLogR\bplayback\x12<\n" +
+	"\x1aheartbeat_interval_seconds\x18\x02 \x01(\x05R\x18heartbeatIntervalSeconds\"S\n" +
 	"\x0fPlaybackLogList\x12*\n" +
 	"\x04list\x18\x01 \x03(\v2\x16.sofascore.PlaybackLogR\x04list\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\rR\x05total\"Z\n" +
@@ -2488,7 +8604,123 @@ const file_proto_api_proto_rawDesc = "" +
 	"\n" +
 	"ApkVersion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03urlB6Z4github.com/jeriveromartinez/sofascore-scrapper/pb;pbb\x06proto3"
+	"\x03url\x18\x02 \x01(\tR\x03url\"_\n" +
+	"\x1fNotificationSubscriptionRequest\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\"\x87\x01\n" +
+	"\x18NotificationSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vtarget_type\x18\x03 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\x03R\btargetId\"i\n" +
+	"\x1cNotificationSubscriptionList\x12I\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2#.sofascore.NotificationSubscriptionR\rsubscriptions\"G\n" +
+	"\x1bNotificationTemplateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"s\n" +
+	"\x14NotificationTemplate\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"Y\n" +
+	"\x18NotificationTemplateList\x12=\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x1f.sofascore.NotificationTemplateR\ttemplates\"\xba\x02\n" +
+	"\x14NotificationLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\rR\bdeviceId\x12\x1f\n" +
+	"\vdevice_name\x18\x04 \x01(\tR\n" +
+	"deviceName\x12-\n" +
+	"\x13sofa_score_event_id\x18\x05 \x01(\x03R\x10sofaScoreEventId\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\b \x01(\tR\x04body\x12\x1a\n" +
+	"\bprovider\x18\t \x01(\tR\bprovider\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\"f\n" +
+	"\x13NotificationLogList\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.sofascore.NotificationLogEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa7\x02\n" +
+	"\fSyncResponse\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x121\n" +
+	"\x06events\x18\x03 \x03(\v2\x19.sofascore.SofaScoreEventR\x06events\x12%\n" +
+	"\x05teams\x18\x04 \x03(\v2\x0f.sofascore.TeamR\x05teams\x127\n" +
+	"\vtournaments\x18\x05 \x03(\v2\x15.sofascore.TournamentR\vtournaments\x12*\n" +
+	"\x11deleted_event_ids\x18\x06 \x03(\x03R\x0fdeletedEventIds\x12%\n" +
+	"\x0etournament_ids\x18\a \x03(\rR\rtournamentIds\"\xb3\x01\n" +
+	"\x13FeedSettingsRequest\x12\x1b\n" +
+	"\tmax_items\x18\x01 \x01(\x05R\bmaxItems\x12(\n" +
+	"\x10look_ahead_hours\x18\x02 \x01(\x05R\x0elookAheadHours\x12!\n" +
+	"\frecent_hours\x18\x03 \x01(\x05R\vrecentHours\x12\x1a\n" +
+	"\bordering\x18\x04 \x01(\tR\bordering\x12\x16\n" +
+	"\x06sports\x18\x05 \x03(\tR\x06sports\"\xe5\x02\n" +
+	"\fFeedSettings\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tdevice_id\x18\x04 \x01(\rR\bdeviceId\x12!\n" +
+	"\fpackage_name\x18\x05 \x01(\tR\vpackageName\x12\x1b\n" +
+	"\tmax_items\x18\x06 \x01(\x05R\bmaxItems\x12(\n" +
+	"\x10look_ahead_hours\x18\a \x01(\x05R\x0elookAheadHours\x12!\n" +
+	"\frecent_hours\x18\b \x01(\x05R\vrecentHours\x12\x1a\n" +
+	"\bordering\x18\t \x01(\tR\bordering\x12\x16\n" +
+	"\x06sports\x18\n" +
+	" \x03(\tR\x06sports\x12)\n" +
+	"\x06device\x18\v \x01(\v2\x11.sofascore.DeviceR\x06device\"G\n" +
+	"\x10FeedSettingsList\x123\n" +
+	"\bsettings\x18\x01 \x03(\v2\x17.sofascore.FeedSettingsR\bsettings\"\xab\x04\n" +
+	"\vEventDetail\x12/\n" +
+	"\x05event\x18\x01 \x01(\v2\x19.sofascore.SofaScoreEventR\x05event\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12\x1d\n" +
+	"\n" +
+	"round_name\x18\x03 \x01(\tR\troundName\x12\x16\n" +
+	"\x06season\x18\x04 \x01(\tR\x06season\x12\x14\n" +
+	"\x05venue\x18\x05 \x01(\tR\x05venue\x12\x1d\n" +
+	"\n" +
+	"venue_city\x18\x06 \x01(\tR\tvenueCity\x12\x18\n" +
+	"\areferee\x18\a \x01(\tR\areferee\x12C\n" +
+	"\x10home_last_events\x18\b \x03(\v2\x19.sofascore.SofaScoreEventR\x0ehomeLastEvents\x12\x1b\n" +
+	"\thome_form\x18\t \x01(\tR\bhomeForm\x12C\n" +
+	"\x10away_last_events\x18\n" +
+	" \x03(\v2\x19.sofascore.SofaScoreEventR\x0eawayLastEvents\x12\x1b\n" +
+	"\taway_form\x18\v \x01(\tR\bawayForm\x12;\n" +
+	"\fhead_to_head\x18\f \x03(\v2\x19.sofascore.SofaScoreEventR\n" +
+	"headToHead\x12\x1b\n" +
+	"\thome_wins\x18\r \x01(\x05R\bhomeWins\x12\x1b\n" +
+	"\taway_wins\x18\x0e \x01(\x05R\bawayWins\x12\x14\n" +
+	"\x05draws\x18\x0f \x01(\x05R\x05draws\"\x80\x01\n" +
+	"\x12TranslationRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1d\n" +
+	"\n" +
+	"entity_key\x18\x02 \x01(\tR\tentityKey\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\xdf\x01\n" +
+	"\vTranslation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x12\x1d\n" +
+	"\n" +
+	"entity_key\x18\x05 \x01(\tR\tentityKey\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06source\"c\n" +
+	"\x0fTranslationList\x12:\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x16.sofascore.TranslationR\ftranslations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05totalB6Z4github.com/jeriveromartinez/sofascore-scrapper/pb;pbb\x06proto3"
 
 var (
 	file_proto_api_proto_rawDescOnce sync.Once
@@ -2502,61 +8734,181 @@ func file_proto_api_proto_rawDescGZIP() []byte {
 	return file_proto_api_proto_rawDescData
 }
 
-var file_proto_api_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_api_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_proto_api_proto_goTypes = []any{
-	(*ErrorResponse)(nil),              // 0: sofascore.ErrorResponse
-	(*StatusMessage)(nil),              // 1: sofascore.StatusMessage
-	(*StatusResponse)(nil),             // 2: sofascore.StatusResponse
-	(*AuthRequest)(nil),                // 3: sofascore.AuthRequest
-	(*AuthResponse)(nil),               // 4: sofascore.AuthResponse
-	(*DeviceRegisterRequest)(nil),      // 5: sofascore.DeviceRegisterRequest
-	(*Device)(nil),                     // 6: sofascore.Device
-	(*DeviceList)(nil),                 // 7: sofascore.DeviceList
-	(*DeviceUrl)(nil),                  // 8: sofascore.DeviceUrl
-	(*TournamentRequest)(nil),          // 9: sofascore.TournamentRequest
-	(*Tournament)(nil),                 // 10: sofascore.Tournament
-	(*TournamentList)(nil),             // 11: sofascore.TournamentList
-	(*AssignTournamentRequest)(nil),    // 12: sofascore.AssignTournamentRequest
-	(*SetTournamentIdsRequest)(nil),    // 13: sofascore.SetTournamentIdsRequest
-	(*DeviceTournament)(nil),           // 14: sofascore.DeviceTournament
-	(*DeviceTournamentList)(nil),       // 15: sofascore.DeviceTournamentList
-	(*GlobalTournamentConfig)(nil),     // 16: sofascore.GlobalTournamentConfig
-	(*GlobalTournamentConfigList)(nil), // 17: sofascore.GlobalTournamentConfigList
-	(*Team)(nil),                       // 18: sofascore.Team
-	(*SofaScoreEvent)(nil),             // 19: sofascore.SofaScoreEvent
-	(*EventsList)(nil),                 // 20: sofascore.EventsList
-	(*LogPlaybackRequest)(nil),         // 21: sofascore.LogPlaybackRequest
-	(*UpdatePlaybackRequest)(nil),      // 22: sofascore.UpdatePlaybackRequest
-	(*PlaybackLog)(nil),                // 23: sofascore.PlaybackLog
-	(*PlaybackLogList)(nil),            // 24: sofascore.PlaybackLogList
-	(*EventStats)(nil),                 // 25: sofascore.EventStats
-	(*TopEventsResponse)(nil),          // 26: sofascore.TopEventsResponse
-	(*ApkInfo)(nil),                    // 27: sofascore.ApkInfo
-	(*ApkList)(nil),                    // 28: sofascore.ApkList
-	(*ApkUploadResponse)(nil),          // 29: sofascore.ApkUploadResponse
-	(*ApkUpdateCheckResponse)(nil),     // 30: sofascore.ApkUpdateCheckResponse
-	(*ApkVersion)(nil),                 // 31: sofascore.ApkVersion
+	(*ErrorResponse)(nil),                   // 0: sofascore.ErrorResponse
+	(*StatusMessage)(nil),                   // 1: sofascore.StatusMessage
+	(*StatusResponse)(nil),                  // 2: sofascore.StatusResponse
+	(*AuthRequest)(nil),                     // 3: sofascore.AuthRequest
+	(*AuthResponse)(nil),                    // 4: sofascore.AuthResponse
+	(*User)(nil),                            // 5: sofascore.User
+	(*UserList)(nil),                        // 6: sofascore.UserList
+	(*UserQuotaRequest)(nil),                // 7: sofascore.UserQuotaRequest
+	(*DeviceRegisterRequest)(nil),           // 8: sofascore.DeviceRegisterRequest
+	(*Device)(nil),                          // 9: sofascore.Device
+	(*DeviceList)(nil),                      // 10: sofascore.DeviceList
+	(*DeviceUrl)(nil),                       // 11: sofascore.DeviceUrl
+	(*HeartbeatRequest)(nil),                // 12: sofascore.HeartbeatRequest
+	(*HeartbeatResponse)(nil),               // 13: sofascore.HeartbeatResponse
+	(*DeviceSession)(nil),                   // 14: sofascore.DeviceSession
+	(*DeviceSessionList)(nil),               // 15: sofascore.DeviceSessionList
+	(*DeviceUptime)(nil),                    // 16: sofascore.DeviceUptime
+	(*BlockDeviceRequest)(nil),              // 17: sofascore.BlockDeviceRequest
+	(*DeviceAuditEntry)(nil),                // 18: sofascore.DeviceAuditEntry
+	(*DeviceAuditList)(nil),                 // 19: sofascore.DeviceAuditList
+	(*DuplicateDeviceGroup)(nil),            // 20: sofascore.DuplicateDeviceGroup
+	(*DuplicateDeviceGroupList)(nil),        // 21: sofascore.DuplicateDeviceGroupList
+	(*EnrollmentCodeRequest)(nil),           // 22: sofascore.EnrollmentCodeRequest
+	(*EnrollmentCode)(nil),                  // 23: sofascore.EnrollmentCode
+	(*EnrollmentCodeList)(nil),              // 24: sofascore.EnrollmentCodeList
+	(*EnrollDeviceRequest)(nil),             // 25: sofascore.EnrollDeviceRequest
+	(*DeviceCommandRequest)(nil),            // 26: sofascore.DeviceCommandRequest
+	(*DeviceCommand)(nil),                   // 27: sofascore.DeviceCommand
+	(*DeviceCommandList)(nil),               // 28: sofascore.DeviceCommandList
+	(*DeviceCommandAck)(nil),                // 29: sofascore.DeviceCommandAck
+	(*PlanRequest)(nil),                     // 30: sofascore.PlanRequest
+	(*Plan)(nil),                            // 31: sofascore.Plan
+	(*PlanList)(nil),                        // 32: sofascore.PlanList
+	(*SubscriptionRequest)(nil),             // 33: sofascore.SubscriptionRequest
+	(*DeviceSubscription)(nil),              // 34: sofascore.DeviceSubscription
+	(*DeviceSubscriptionList)(nil),          // 35: sofascore.DeviceSubscriptionList
+	(*SubscriptionError)(nil),               // 36: sofascore.SubscriptionError
+	(*DeviceGroupRequest)(nil),              // 37: sofascore.DeviceGroupRequest
+	(*DeviceGroup)(nil),                     // 38: sofascore.DeviceGroup
+	(*DeviceGroupList)(nil),                 // 39: sofascore.DeviceGroupList
+	(*DeviceGroupMembersRequest)(nil),       // 40: sofascore.DeviceGroupMembersRequest
+	(*DeviceGroupMembersResult)(nil),        // 41: sofascore.DeviceGroupMembersResult
+	(*DeviceGroupTournamentList)(nil),       // 42: sofascore.DeviceGroupTournamentList
+	(*ConfigKeyRequest)(nil),                // 43: sofascore.ConfigKeyRequest
+	(*ConfigKey)(nil),                       // 44: sofascore.ConfigKey
+	(*ConfigKeyList)(nil),                   // 45: sofascore.ConfigKeyList
+	(*ConfigValueRequest)(nil),              // 46: sofascore.ConfigValueRequest
+	(*ConfigValue)(nil),                     // 47: sofascore.ConfigValue
+	(*ConfigValueList)(nil),                 // 48: sofascore.ConfigValueList
+	(*ConfigEntry)(nil),                     // 49: sofascore.ConfigEntry
+	(*ConfigBundle)(nil),                    // 50: sofascore.ConfigBundle
+	(*TournamentRequest)(nil),               // 51: sofascore.TournamentRequest
+	(*Tournament)(nil),                      // 52: sofascore.Tournament
+	(*TournamentList)(nil),                  // 53: sofascore.TournamentList
+	(*AssignTournamentRequest)(nil),         // 54: sofascore.AssignTournamentRequest
+	(*SetTournamentIdsRequest)(nil),         // 55: sofascore.SetTournamentIdsRequest
+	(*DeviceTournament)(nil),                // 56: sofascore.DeviceTournament
+	(*DeviceTournamentList)(nil),            // 57: sofascore.DeviceTournamentList
+	(*AssignTeamRequest)(nil),               // 58: sofascore.AssignTeamRequest
+	(*SetTeamIdsRequest)(nil),               // 59: sofascore.SetTeamIdsRequest
+	(*DeviceTeam)(nil),                      // 60: sofascore.DeviceTeam
+	(*DeviceTeamList)(nil),                  // 61: sofascore.DeviceTeamList
+	(*GlobalTournamentConfig)(nil),          // 62: sofascore.GlobalTournamentConfig
+	(*GlobalTournamentConfigList)(nil),      // 63: sofascore.GlobalTournamentConfigList
+	(*SportRequest)(nil),                    // 64: sofascore.SportRequest
+	(*Sport)(nil),                           // 65: sofascore.Sport
+	(*SportList)(nil),                       // 66: sofascore.SportList
+	(*TrendingCountryRequest)(nil),          // 67: sofascore.TrendingCountryRequest
+	(*TrendingCountry)(nil),                 // 68: sofascore.TrendingCountry
+	(*TrendingCountryList)(nil),             // 69: sofascore.TrendingCountryList
+	(*Team)(nil),                            // 70: sofascore.Team
+	(*SofaScoreEvent)(nil),                  // 71: sofascore.SofaScoreEvent
+	(*EventsList)(nil),                      // 72: sofascore.EventsList
+	(*EventDelta)(nil),                      // 73: sofascore.EventDelta
+	(*TrendingEventsList)(nil),              // 74: sofascore.TrendingEventsList
+	(*DeviceStatusChange)(nil),              // 75: sofascore.DeviceStatusChange
+	(*CrashReportSummary)(nil),              // 76: sofascore.CrashReportSummary
+	(*JobRun)(nil),                          // 77: sofascore.JobRun
+	(*WebhookRequest)(nil),                  // 78: sofascore.WebhookRequest
+	(*Webhook)(nil),                         // 79: sofascore.Webhook
+	(*WebhookList)(nil),                     // 80: sofascore.WebhookList
+	(*WebhookDelivery)(nil),                 // 81: sofascore.WebhookDelivery
+	(*WebhookDeliveryList)(nil),             // 82: sofascore.WebhookDeliveryList
+	(*LogPlaybackRequest)(nil),              // 83: sofascore.LogPlaybackRequest
+	(*UpdatePlaybackRequest)(nil),           // 84: sofascore.UpdatePlaybackRequest
+	(*PlaybackLog)(nil),                     // 85: sofascore.PlaybackLog
+	(*PlaybackSession)(nil),                 // 86: sofascore.PlaybackSession
+	(*PlaybackLogList)(nil),                 // 87: sofascore.PlaybackLogList
+	(*EventStats)(nil),                      // 88: sofascore.EventStats
+	(*TopEventsResponse)(nil),               // 89: sofascore.TopEventsResponse
+	(*ApkInfo)(nil),                         // 90: sofascore.ApkInfo
+	(*ApkList)(nil),                         // 91: sofascore.ApkList
+	(*ApkUploadResponse)(nil),               // 92: sofascore.ApkUploadResponse
+	(*ApkUpdateCheckResponse)(nil),          // 93: sofascore.ApkUpdateCheckResponse
+	(*ApkVersion)(nil),                      // 94: sofascore.ApkVersion
+	(*NotificationSubscriptionRequest)(nil), // 95: sofascore.NotificationSubscriptionRequest
+	(*NotificationSubscription)(nil),        // 96: sofascore.NotificationSubscription
+	(*NotificationSubscriptionList)(nil),    // 97: sofascore.NotificationSubscriptionList
+	(*NotificationTemplateRequest)(nil),     // 98: sofascore.NotificationTemplateRequest
+	(*NotificationTemplate)(nil),            // 99: sofascore.NotificationTemplate
+	(*NotificationTemplateList)(nil),        // 100: sofascore.NotificationTemplateList
+	(*NotificationLogEntry)(nil),            // 101: sofascore.NotificationLogEntry
+	(*NotificationLogList)(nil),             // 102: sofascore.NotificationLogList
+	(*SyncResponse)(nil),                    // 103: sofascore.SyncResponse
+	(*FeedSettingsRequest)(nil),             // 104: sofascore.FeedSettingsRequest
+	(*FeedSettings)(nil),                    // 105: sofascore.FeedSettings
+	(*FeedSettingsList)(nil),                // 106: sofascore.FeedSettingsList
+	(*EventDetail)(nil),                     // 107: sofascore.EventDetail
+	(*TranslationRequest)(nil),              // 108: sofascore.TranslationRequest
+	(*Translation)(nil),                     // 109: sofascore.Translation
+	(*TranslationList)(nil),                 // 110: sofascore.TranslationList
 }
 var file_proto_api_proto_depIdxs = []int32{
-	6,  // 0: sofascore.DeviceList.data:type_name -> sofascore.Device
-	10, // 1: sofascore.TournamentList.tournaments:type_name -> sofascore.Tournament
-	6,  // 2: sofascore.DeviceTournament.device:type_name -> sofascore.Device
-	10, // 3: sofascore.DeviceTournament.tournament:type_name -> sofascore.Tournament
-	14, // 4: sofascore.DeviceTournamentList.device_tournaments:type_name -> sofascore.DeviceTournament
-	10, // 5: sofascore.GlobalTournamentConfig.tournament:type_name -> sofascore.Tournament
-	16, // 6: sofascore.GlobalTournamentConfigList.configs:type_name -> sofascore.GlobalTournamentConfig
-	18, // 7: sofascore.SofaScoreEvent.team_home:type_name -> sofascore.Team
-	18, // 8: sofascore.SofaScoreEvent.team_away:type_name -> sofascore.Team
-	10, // 9: sofascore.SofaScoreEvent.league:type_name -> sofascore.Tournament
-	19, // 10: sofascore.EventsList.data:type_name -> sofascore.SofaScoreEvent
-	23, // 11: sofascore.PlaybackLogList.list:type_name -> sofascore.PlaybackLog
-	25, // 12: sofascore.TopEventsResponse.stats:type_name -> sofascore.EventStats
-	27, // 13: sofascore.ApkList.versions:type_name -> sofascore.ApkInfo
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,   // 0: sofascore.UserList.data:type_name -> sofascore.User
+	9,   // 1: sofascore.DeviceList.data:type_name -> sofascore.Device
+	14,  // 2: sofascore.DeviceSessionList.data:type_name -> sofascore.DeviceSession
+	14,  // 3: sofascore.DeviceUptime.sessions:type_name -> sofascore.DeviceSession
+	18,  // 4: sofascore.DeviceAuditList.data:type_name -> sofascore.DeviceAuditEntry
+	9,   // 5: sofascore.DuplicateDeviceGroup.devices:type_name -> sofascore.Device
+	20,  // 6: sofascore.DuplicateDeviceGroupList.data:type_name -> sofascore.DuplicateDeviceGroup
+	23,  // 7: sofascore.EnrollmentCodeList.data:type_name -> sofascore.EnrollmentCode
+	27,  // 8: sofascore.DeviceCommandList.data:type_name -> sofascore.DeviceCommand
+	31,  // 9: sofascore.PlanList.data:type_name -> sofascore.Plan
+	31,  // 10: sofascore.DeviceSubscription.plan:type_name -> sofascore.Plan
+	34,  // 11: sofascore.DeviceSubscriptionList.data:type_name -> sofascore.DeviceSubscription
+	38,  // 12: sofascore.DeviceGroupList.data:type_name -> sofascore.DeviceGroup
+	52,  // 13: sofascore.DeviceGroupTournamentList.tournaments:type_name -> sofascore.Tournament
+	44,  // 14: sofascore.ConfigKeyList.data:type_name -> sofascore.ConfigKey
+	47,  // 15: sofascore.ConfigValueList.data:type_name -> sofascore.ConfigValue
+	49,  // 16: sofascore.ConfigBundle.entries:type_name -> sofascore.ConfigEntry
+	52,  // 17: sofascore.TournamentList.tournaments:type_name -> sofascore.Tournament
+	9,   // 18: sofascore.DeviceTournament.device:type_name -> sofascore.Device
+	52,  // 19: sofascore.DeviceTournament.tournament:type_name -> sofascore.Tournament
+	56,  // 20: sofascore.DeviceTournamentList.device_tournaments:type_name -> sofascore.DeviceTournament
+	9,   // 21: sofascore.DeviceTeam.device:type_name -> sofascore.Device
+	70,  // 22: sofascore.DeviceTeam.team:type_name -> sofascore.Team
+	60,  // 23: sofascore.DeviceTeamList.device_teams:type_name -> sofascore.DeviceTeam
+	52,  // 24: sofascore.GlobalTournamentConfig.tournament:type_name -> sofascore.Tournament
+	62,  // 25: sofascore.GlobalTournamentConfigList.configs:type_name -> sofascore.GlobalTournamentConfig
+	65,  // 26: sofascore.SportList.sports:type_name -> sofascore.Sport
+	68,  // 27: sofascore.TrendingCountryList.countries:type_name -> sofascore.TrendingCountry
+	70,  // 28: sofascore.SofaScoreEvent.team_home:type_name -> sofascore.Team
+	70,  // 29: sofascore.SofaScoreEvent.team_away:type_name -> sofascore.Team
+	52,  // 30: sofascore.SofaScoreEvent.league:type_name -> sofascore.Tournament
+	71,  // 31: sofascore.EventsList.data:type_name -> sofascore.SofaScoreEvent
+	71,  // 32: sofascore.EventsList.live:type_name -> sofascore.SofaScoreEvent
+	71,  // 33: sofascore.EventsList.upcoming:type_name -> sofascore.SofaScoreEvent
+	71,  // 34: sofascore.EventsList.recent:type_name -> sofascore.SofaScoreEvent
+	71,  // 35: sofascore.TrendingEventsList.data:type_name -> sofascore.SofaScoreEvent
+	79,  // 36: sofascore.WebhookList.webhooks:type_name -> sofascore.Webhook
+	81,  // 37: sofascore.WebhookDeliveryList.deliveries:type_name -> sofascore.WebhookDelivery
+	85,  // 38: sofascore.PlaybackSession.playback:type_name -> sofascore.PlaybackLog
+	85,  // 39: sofascore.PlaybackLogList.list:type_name -> sofascore.PlaybackLog
+	88,  // 40: sofascore.TopEventsResponse.stats:type_name -> sofascore.EventStats
+	90,  // 41: sofascore.ApkList.versions:type_name -> sofascore.ApkInfo
+	96,  // 42: sofascore.NotificationSubscriptionList.subscriptions:type_name -> sofascore.NotificationSubscription
+	99,  // 43: sofascore.NotificationTemplateList.templates:type_name -> sofascore.NotificationTemplate
+	101, // 44: sofascore.NotificationLogList.entries:type_name -> sofascore.NotificationLogEntry
+	71,  // 45: sofascore.SyncResponse.events:type_name -> sofascore.SofaScoreEvent
+	70,  // 46: sofascore.SyncResponse.teams:type_name -> sofascore.Team
+	52,  // 47: sofascore.SyncResponse.tournaments:type_name -> sofascore.Tournament
+	9,   // 48: sofascore.FeedSettings.device:type_name -> sofascore.Device
+	105, // 49: sofascore.FeedSettingsList.settings:type_name -> sofascore.FeedSettings
+	71,  // 50: sofascore.EventDetail.event:type_name -> sofascore.SofaScoreEvent
+	71,  // 51: sofascore.EventDetail.home_last_events:type_name -> sofascore.SofaScoreEvent
+	71,  // 52: sofascore.EventDetail.away_last_events:type_name -> sofascore.SofaScoreEvent
+	71,  // 53: sofascore.EventDetail.head_to_head:type_name -> sofascore.SofaScoreEvent
+	109, // 54: sofascore.TranslationList.translations:type_name -> sofascore.Translation
+	55,  // [55:55] is the sub-list for method output_type
+	55,  // [55:55] is the sub-list for method input_type
+	55,  // [55:55] is the sub-list for extension type_name
+	55,  // [55:55] is the sub-list for extension extendee
+	0,   // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_proto_init() }
@@ -2570,7 +8922,7 @@ func file_proto_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_proto_rawDesc), len(file_proto_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/httpcli"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+// SeedCatalog fills the sports and trending countries tables with the
+// built-in defaults the first time they are empty.
+func SeedCatalog() error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	if err := db.Model(&models.Sport{}).Unscoped().Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		for _, slug := range httpcli.GET_SPORTS() {
+			if err := db.Create(&models.Sport{Slug: slug, Name: SportNameFromSlug(slug), Enabled: true}).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := db.Model(&models.TrendingCountry{}).Unscoped().Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		for _, code := range httpcli.GET_COUNTRIES() {
+			if err := db.Create(&models.TrendingCountry{Code: code, Name: code, Enabled: true}).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SportNameFromSlug builds a readable name such as "Ice hockey" from a
+// SofaScore slug like "ice-hockey".
+func SportNameFromSlug(slug string) string {
+	name := strings.ReplaceAll(slug, "-", " ")
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// GetAllSports retrieves every configured sport
+func GetAllSports() ([]models.Sport, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var sports []models.Sport
+	result := db.Order("slug ASC").Find(&sports)
+	return sports, result.Error
+}
+
+// GetEnabledSportSlugs returns the slugs of the sports the scheduler must scrape
+func GetEnabledSportSlugs() ([]string, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var slugs []string
+	result := db.Model(&models.Sport{}).Where("enabled = ?", true).Order("slug ASC").Pluck("slug", &slugs)
+	return slugs, result.Error
+}
+
+// CreateSport creates a new sport
+func CreateSport(slug, name string, enabled bool) (*models.Sport, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	sport := &models.Sport{Slug: slug, Name: name, Enabled: enabled}
+	result := db.Create(sport)
+	return sport, result.Error
+}
+
+// UpdateSport updates an existing sport
+func UpdateSport(id uint, slug, name string, enabled bool) (*models.Sport, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var sport models.Sport
+	if err := db.First(&sport, id).Error; err != nil {
+		return nil, err
+	}
+	sport.Slug = slug
+	sport.Name = name
+	sport.Enabled = enabled
+	result := db.Save(&sport)
+	return &sport, result.Error
+}
+
+// DeleteSport deletes a sport
+func DeleteSport(id uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Unscoped().Delete(&models.Sport{}, id).Error
+}
+
+// DiscoverSports registers the given upstream slugs that are not known yet.
+// New sports are created disabled so operators decide what gets scraped.
+func DiscoverSports(slugs []string) ([]models.Sport, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]models.Sport, 0)
+	for _, slug := range slugs {
+		sport := models.Sport{Slug: slug, Name: SportNameFromSlug(slug)}
+		result := db.Where(models.Sport{Slug: slug}).Attrs(models.Sport{Name: sport.Name}).FirstOrCreate(&sport)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected > 0 {
+			created = append(created, sport)
+		}
+	}
+	return created, nil
+}
+
+// GetAllTrendingCountries retrieves every configured trending country
+func GetAllTrendingCountries() ([]models.TrendingCountry, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var countries []models.TrendingCountry
+	result := db.Order("code ASC").Find(&countries)
+	return countries, result.Error
+}
+
+// GetEnabledCountryCodes returns the codes of the countries whose trending
+// events the scheduler must scrape
+func GetEnabledCountryCodes() ([]string, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var codes []string
+	result := db.Model(&models.TrendingCountry{}).Where("enabled = ?", true).Order("code ASC").Pluck("code", &codes)
+	return codes, result.Error
+}
+
+// CreateTrendingCountry creates a new trending country
+func CreateTrendingCountry(code, name string, enabled bool) (*models.TrendingCountry, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	country := &models.TrendingCountry{Code: strings.ToUpper(code), Name: name, Enabled: enabled}
+	result := db.Create(country)
+	return country, result.Error
+}
+
+// UpdateTrendingCountry updates an existing trending country
+func UpdateTrendingCountry(id uint, code, name string, enabled bool) (*models.TrendingCountry, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var country models.TrendingCountry
+	if err := db.First(&country, id).Error; err != nil {
+		return nil, err
+	}
+	country.Code = strings.ToUpper(code)
+	country.Name = name
+	country.Enabled = enabled
+	result := db.Save(&country)
+	return &country, result.Error
+}
+
+// DeleteTrendingCountry deletes a trending country
+func DeleteTrendingCountry(id uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Unscoped().Delete(&models.TrendingCountry{}, id).Error
+}
+
+// BackfillEventSports repairs events whose sport column holds the country
+// code of the trending list they were scraped from. The sport is taken from
+// another event of the same tournament; events with no such sibling are
+// cleared so the next scrape that carries a sport fills them in.
+func BackfillEventSports() error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	codes := httpcli.GET_COUNTRIES()
+	var stored []string
+	if err := db.Model(&models.TrendingCountry{}).Unscoped().Pluck("code", &stored).Error; err != nil {
+		return err
+	}
+	codes = append(codes, stored...)
+
+	if err := db.Exec(`UPDATE events e
+		JOIN (SELECT league_id, MAX(sport) AS sport FROM events WHERE sport <> '' AND sport NOT IN ? GROUP BY league_id) s ON s.league_id = e.league_id
+		SET e.sport = s.sport
+		WHERE e.sport IN ?`, codes, codes).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.SofaScoreEvent{}).Unscoped().Where("sport IN ?", codes).UpdateColumn("sport", "").Error
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func CreateDeviceCommand(command *models.DeviceCommand) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	command.Status = models.DeviceCommandPending
+	return db.Omit("User").Create(command).Error
+}
+
+// GetDeviceCommands returns a page of the commands of a device, newest first
+func GetDeviceCommands(deviceID, page, limit uint) ([]models.DeviceCommand, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+	query := db.Model(&models.DeviceCommand{}).Where("device_id = ?", deviceID)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var commands []models.DeviceCommand
+	offset := (page - 1) * limit
+	result := query.Session(&gorm.Session{}).Preload("User").Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&commands)
+	return commands, total, result.Error
+}
+
+func GetDeviceCommand(deviceID, id uint) (*models.DeviceCommand, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var command models.DeviceCommand
+	if err := db.Preload("User").Where("device_id = ?", deviceID).First(&command, id).Error; err != nil {
+		return nil, err
+	}
+	return &command, nil
+}
+
+// CancelDeviceCommand withdraws a command the device has not received yet
+func CancelDeviceCommand(deviceID, id uint) (*models.DeviceCommand, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	result := db.Model(&models.DeviceCommand{}).
+		Where("id = ? AND device_id = ? AND status = ?", id, deviceID, models.DeviceCommandPending).
+		Updates(map[string]any{"status": models.DeviceCommandCancelled, "completed_at": time.Now().Unix()})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, models.ErrDeviceCommandClosed
+	}
+	return GetDeviceCommand(deviceID, id)
+}
+
+// ClaimDeviceCommands marks the unexpired commands of a device that are
+// pending, or were delivered but not acknowledged within
+// DeviceCommandAckTimeout, as delivered and returns them oldest first
+func ClaimDeviceCommands(deviceID uint, limit int) ([]models.DeviceCommand, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	now := time.Now().Unix()
+	unacknowledged := now - int64(models.DeviceCommandAckTimeout/time.Second)
+	var commands []models.DeviceCommand
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("device_id = ? AND expires_at > ? AND (status = ? OR (status = ? AND delivered_at <= ?))",
+			deviceID, now, models.DeviceCommandPending, models.DeviceCommandDelivered, unacknowledged).
+		Order("id").
+		Limit(limit).
+		Find(&commands).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(commands) == 0 {
+		tx.Rollback()
+		return commands, nil
+	}
+
+	ids := make([]uint, len(commands))
+	for i := range commands {
+		ids[i] = commands[i].ID
+		commands[i].Status = models.DeviceCommandDelivered
+		commands[i].DeliveredAt = now
+	}
+	if err := tx.Model(&models.DeviceCommand{}).Where("id IN ?", ids).
+		Updates(map[string]any{"status": models.DeviceCommandDelivered, "delivered_at": now}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return commands, tx.Commit().Error
+}
+
+// CompleteDeviceCommand stores the outcome reported by the device for one
+// of its open commands
+func CompleteDeviceCommand(deviceID, id uint, success bool, output string) (*models.DeviceCommand, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	status := models.DeviceCommandSucceeded
+	if !success {
+		status = models.DeviceCommandFailed
+	}
+	result := db.Model(&models.DeviceCommand{}).
+		Where("id = ? AND device_id = ? AND status IN ?", id, deviceID, []string{models.DeviceCommandPending, models.DeviceCommandDelivered}).
+		Updates(map[string]any{"status": status, "result": output, "completed_at": time.Now().Unix()})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, models.ErrDeviceCommandClosed
+	}
+	return GetDeviceCommand(deviceID, id)
+}
+
+// ExpireDeviceCommands closes the open commands whose expiry has passed and
+// returns them
+func ExpireDeviceCommands(now time.Time) ([]models.DeviceCommand, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	open := []string{models.DeviceCommandPending, models.DeviceCommandDelivered}
+
+	var commands []models.DeviceCommand
+	if err := db.Where("status IN ? AND expires_at <= ?", open, now.Unix()).Find(&commands).Error; err != nil {
+		return nil, err
+	}
+	if len(commands) == 0 {
+		return commands, nil
+	}
+
+	ids := make([]uint, len(commands))
+	for i := range commands {
+		ids[i] = commands[i].ID
+	}
+	// Commands acknowledged in the meantime keep their status.
+	if err := db.Model(&models.DeviceCommand{}).
+		Where("id IN ? AND status IN ?", ids, open).
+		Updates(map[string]any{"status": models.DeviceCommandExpired, "completed_at": now.Unix()}).Error; err != nil {
+		return nil, err
+	}
+
+	var expired []models.DeviceCommand
+	err = db.Where("id IN ? AND status = ?", ids, models.DeviceCommandExpired).Find(&expired).Error
+	return expired, err
+}
@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const deviceGroupsCacheTTL = 30 * time.Second
+
+// deviceGroups caches the groups by priority for GetDeviceGroupIDs, which
+// runs on every feed, sync and config request. Group changes reset it; the
+// TTL covers changes made by other instances.
+var deviceGroups = &deviceGroupCache{}
+
+type deviceGroupCache struct {
+	mu        sync.Mutex
+	groups    []models.DeviceGroup
+	expiresAt time.Time
+}
+
+func (c *deviceGroupCache) get(db *gorm.DB) ([]models.DeviceGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.groups != nil && time.Now().Before(c.expiresAt) {
+		return c.groups, nil
+	}
+	groups := make([]models.DeviceGroup, 0)
+	if err := db.Order("priority DESC, id").Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	c.groups = groups
+	c.expiresAt = time.Now().Add(deviceGroupsCacheTTL)
+	return groups, nil
+}
+
+func (c *deviceGroupCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.groups = nil
+}
+
+// deviceGroupRuleCondition returns the SQL condition selecting the devices
+// matched by the rules of group, mirroring DeviceGroup.Matches
+func deviceGroupRuleCondition(group models.DeviceGroup) (string, []any) {
+	var parts []string
+	var args []any
+	if group.RulePackage != "" {
+		parts = append(parts, "package_name = ?")
+		args = append(args, group.RulePackage)
+	}
+	if group.RuleVersion != "" {
+		// ValidRuleVersion keeps LIKE wildcards out of the rule.
+		parts = append(parts, "(version = ? OR version LIKE ?)")
+		args = append(args, group.RuleVersion, group.RuleVersion+".%")
+	}
+	if group.RulePlatform != "" {
+		parts = append(parts, "LOWER(platform) = ?")
+		args = append(args, strings.ToLower(group.RulePlatform))
+	}
+	if group.RuleManagerID != nil {
+		parts = append(parts, "user_id = ?")
+		args = append(args, *group.RuleManagerID)
+	}
+	return strings.Join(parts, " AND "), args
+}
+
+// deviceGroupDevices selects the manual members of group along with the
+// devices its rules match
+func deviceGroupDevices(db *gorm.DB, group models.DeviceGroup) *gorm.DB {
+	members := db.Model(&models.DeviceGroupMember{}).Select("device_id").Where("device_group_id = ?", group.ID)
+	condition := db.Where("id IN (?)", members)
+	if group.HasRules() {
+		rule, args := deviceGroupRuleCondition(group)
+		condition = condition.Or(rule, args...)
+	}
+	return db.Model(&models.Device{}).Where(condition)
+}
+
+// GetDeviceGroups returns every group with the number of devices in each
+func GetDeviceGroups() ([]models.DeviceGroup, map[uint]int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	var groups []models.DeviceGroup
+	if err := db.Order("priority DESC, name").Find(&groups).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var rows []struct {
+		DeviceGroupID uint
+		Total         int64
+	}
+	if err := db.Model(&models.DeviceGroupMember{}).
+		Select("device_group_id, COUNT(*) AS total").
+		Group("device_group_id").
+		Scan(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DeviceGroupID] = row.Total
+	}
+
+	// Rule matches overlap with manual members, so those groups are counted
+	// on their own.
+	for _, group := range groups {
+		if !group.HasRules() {
+			continue
+		}
+		var total int64
+		if err := deviceGroupDevices(db, group).Count(&total).Error; err != nil {
+			return nil, nil, err
+		}
+		counts[group.ID] = total
+	}
+	return groups, counts, nil
+}
+
+func GetDeviceGroup(id uint) (*models.DeviceGroup, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var group models.DeviceGroup
+	if err := db.First(&group, id).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func CreateDeviceGroup(group *models.DeviceGroup) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	defer deviceGroups.reset()
+	return db.Create(group).Error
+}
+
+func UpdateDeviceGroup(id uint, values models.DeviceGroup) (*models.DeviceGroup, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var group models.DeviceGroup
+	if err := db.First(&group, id).Error; err != nil {
+		return nil, err
+	}
+	group.Name = values.Name
+	group.Description = values.Description
+	group.Priority = values.Priority
+	group.RulePackage = values.RulePackage
+	group.RuleVersion = values.RuleVersion
+	group.RulePlatform = values.RulePlatform
+	group.RuleManagerID = values.RuleManagerID
+	defer deviceGroups.reset()
+	if err := db.Save(&group).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// DeleteDeviceGroup removes a group with its memberships, its tournaments
+// and the config values scoped to it
+func DeleteDeviceGroup(id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	defer deviceGroups.reset()
+
+	tx := db.Begin()
+	if err := tx.Unscoped().Where("device_group_id = ?", id).Delete(&models.DeviceGroupMember{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Unscoped().Where("device_group_id = ?", id).Delete(&models.DeviceGroupTournament{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Unscoped().Where("scope = ? AND scope_id = ?", models.ConfigScopeGroup, strconv.FormatUint(uint64(id), 10)).Delete(&models.ConfigValue{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	result := tx.Unscoped().Delete(&models.DeviceGroup{}, id)
+	if result.Error != nil {
+		tx.Rollback()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, tx.Commit().Error
+}
+
+// GetDeviceGroupMembers returns the devices of a group, restricted to those
+// of managerID when set
+func GetDeviceGroupMembers(group models.DeviceGroup, managerID *uint) ([]models.Device, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	query := deviceGroupDevices(db, group)
+	if managerID != nil {
+		query = query.Where("user_id = ?", *managerID)
+	}
+	var devices []models.Device
+	result := query.Preload("Manager").Order("name").Find(&devices)
+	return devices, result.Error
+}
+
+func AddDeviceToGroup(groupID, deviceID uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	member := models.DeviceGroupMember{DeviceGroupID: groupID, DeviceID: deviceID}
+	return db.Where(member).FirstOrCreate(&member).Error
+}
+
+func RemoveDeviceFromGroup(groupID, deviceID uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Unscoped().Where("device_group_id = ? AND device_id = ?", groupID, deviceID).Delete(&models.DeviceGroupMember{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// AddDevicesToGroup adds devices to a group, skipping those already in it,
+// and returns how many were added
+func AddDevicesToGroup(groupID uint, deviceIDs []uint) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	if len(deviceIDs) == 0 {
+		return 0, nil
+	}
+	members := make([]models.DeviceGroupMember, len(deviceIDs))
+	for i, deviceID := range deviceIDs {
+		members[i] = models.DeviceGroupMember{DeviceGroupID: groupID, DeviceID: deviceID}
+	}
+	result := db.Clauses(clause.Insert{Modifier: "IGNORE"}).Omit("DeviceGroup", "Device").Create(&members)
+	return result.RowsAffected, result.Error
+}
+
+// RemoveDevicesFromGroup removes devices from a group and returns how many
+// were members
+func RemoveDevicesFromGroup(groupID uint, deviceIDs []uint) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	if len(deviceIDs) == 0 {
+		return 0, nil
+	}
+	result := db.Unscoped().Where("device_group_id = ? AND device_id IN ?", groupID, deviceIDs).Delete(&models.DeviceGroupMember{})
+	return result.RowsAffected, result.Error
+}
+
+// GetDeviceGroupIDs returns the groups of a device, manual or by rule, from
+// the highest to the lowest priority. Rules are matched against the cached
+// groups, so only the manual memberships are read per call
+func GetDeviceGroupIDs(device models.Device) ([]uint, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var memberOf []uint
+	if err := db.Model(&models.DeviceGroupMember{}).Where("device_id = ?", device.ID).Pluck("device_group_id", &memberOf).Error; err != nil {
+		return nil, err
+	}
+	members := make(map[uint]struct{}, len(memberOf))
+	for _, id := range memberOf {
+		members[id] = struct{}{}
+	}
+
+	groups, err := deviceGroups.get(db)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(groups))
+	for _, group := range groups {
+		if _, ok := members[group.ID]; ok || group.Matches(device) {
+			ids = append(ids, group.ID)
+		}
+	}
+	return ids, nil
+}
+
+func GetDeviceGroupTournaments(groupID uint) ([]models.DeviceGroupTournament, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	tournaments := make([]models.DeviceGroupTournament, 0)
+	result := db.Preload("Tournament").Where("device_group_id = ?", groupID).Find(&tournaments)
+	return tournaments, result.Error
+}
+
+// GetGroupTournamentLimit returns the plan with the lowest tournament limit
+// among the subscriptions of the group devices and the default plan, or nil
+// when none of them limits tournaments
+func GetGroupTournamentLimit(group models.DeviceGroup) (*models.Plan, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var plans []models.Plan
+	if err := db.Where("max_tournaments > 0 AND id IN (?)", db.Model(&models.DeviceSubscription{}).
+		Select("plan_id").
+		Where("device_id IN (?)", deviceGroupDevices(db, group).Select("id"))).
+		Order("max_tournaments ASC").Limit(1).Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	var strictest *models.Plan
+	if len(plans) > 0 {
+		strictest = &plans[0]
+	}
+	defaultPlan, err := GetDefaultPlan()
+	if err != nil {
+		return nil, err
+	}
+	if defaultPlan != nil && defaultPlan.MaxTournaments > 0 && (strictest == nil || defaultPlan.MaxTournaments < strictest.MaxTournaments) {
+		strictest = defaultPlan
+	}
+	return strictest, nil
+}
+
+// SetDeviceGroupTournaments replaces the tournament set of a group
+func SetDeviceGroupTournaments(groupID uint, tournamentIDs []uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	tx := db.Begin()
+	if err := tx.Where("device_group_id = ?", groupID).Unscoped().Delete(&models.DeviceGroupTournament{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, tournamentID := range tournamentIDs {
+		if err := tx.Create(&models.DeviceGroupTournament{DeviceGroupID: groupID, TournamentID: tournamentID}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
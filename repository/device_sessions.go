@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecordHeartbeat extends the open session of the device or starts a new
+// one when it has been silent for models.DeviceOfflineAfter. The boolean
+// reports whether a session was started
+func RecordHeartbeat(device *models.Device, heartbeat models.DeviceHeartbeat) (*models.DeviceSession, bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, false, tx.Error
+	}
+
+	now := time.Now().Unix()
+	cutoff := time.Now().Add(-models.DeviceOfflineAfter).Unix()
+
+	var open []models.DeviceSession
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("device_id = ? AND ended_at = 0", device.ID).
+		Order("id DESC").
+		Limit(1).
+		Find(&open).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	var session models.DeviceSession
+	started := len(open) == 0 || open[0].LastHeartbeatAt < cutoff
+	if !started {
+		session = open[0]
+	} else if len(open) > 0 {
+		// The sweeper has not closed it yet; end it at its last heartbeat.
+		if err := tx.Model(&open[0]).Update("ended_at", open[0].LastHeartbeatAt).Error; err != nil {
+			tx.Rollback()
+			return nil, false, err
+		}
+	}
+
+	if started {
+		session = models.DeviceSession{DeviceID: device.ID, StartedAt: now}
+	}
+	session.LastHeartbeatAt = now
+	if heartbeat.Version != "" {
+		session.Version = heartbeat.Version
+	}
+	if heartbeat.PackageName != "" {
+		session.PackageName = heartbeat.PackageName
+	}
+	if heartbeat.NetworkType != "" {
+		session.NetworkType = heartbeat.NetworkType
+	}
+	if heartbeat.Content != "" {
+		session.Content = heartbeat.Content
+	}
+	if err := tx.Save(&session).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	values := map[string]any{"last_seen": now}
+	if heartbeat.Version != "" {
+		values["version"] = heartbeat.Version
+	}
+	if heartbeat.PackageName != "" {
+		values["package_name"] = heartbeat.PackageName
+	}
+	if err := tx.Model(&models.Device{}).Where("id = ?", device.ID).Updates(values).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+	// Playbacks of apps without playback heartbeats live as long as the
+	// device does.
+	if err := tx.Model(&models.PlaybackLog{}).
+		Where("device_id = ? AND ended_at = 0 AND NOT tracked", device.ID).
+		UpdateColumn("last_heartbeat_at", gorm.Expr("GREATEST(last_heartbeat_at, ?)", now)).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, false, err
+	}
+
+	device.LastSeen = now
+	return &session, started, nil
+}
+
+// CloseStaleDeviceSessions ends the open sessions whose last heartbeat is
+// older than cutoff and returns them with their device
+func CloseStaleDeviceSessions(cutoff int64) ([]models.DeviceSession, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var sessions []models.DeviceSession
+	if err := db.Where("ended_at = 0 AND last_heartbeat_at < ?", cutoff).Preload("Device").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+
+	// A heartbeat may arrive in the meantime, so each session is only
+	// closed if it is still stale.
+	closed := sessions[:0]
+	for _, session := range sessions {
+		result := db.Model(&models.DeviceSession{}).
+			Where("id = ? AND ended_at = 0 AND last_heartbeat_at < ?", session.ID, cutoff).
+			Update("ended_at", gorm.Expr("last_heartbeat_at"))
+		if result.Error != nil {
+			return closed, result.Error
+		}
+		if result.RowsAffected > 0 {
+			session.EndedAt = session.LastHeartbeatAt
+			closed = append(closed, session)
+		}
+	}
+	return closed, nil
+}
+
+// GetOnlineDevices pages through the open sessions of the devices paired to
+// managerID (all devices when nil), most recent heartbeat first
+func GetOnlineDevices(managerID *uint, page, limit uint) ([]models.DeviceSession, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+	query := db.Model(&models.DeviceSession{}).
+		Where("ended_at = 0 AND last_heartbeat_at >= ?", time.Now().Add(-models.DeviceOfflineAfter).Unix())
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", db.Model(&models.Device{}).Select("id").Where("user_id = ?", *managerID))
+	}
+
+	var sessions []models.DeviceSession
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * limit
+	result := query.Session(&gorm.Session{}).
+		Preload("Device").
+		Order("last_heartbeat_at DESC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&sessions)
+	return sessions, total, result.Error
+}
+
+// GetDeviceUptime returns the sessions of a device overlapping [from, to)
+// and the time it was online in that window
+func GetDeviceUptime(deviceID uint, from, to int64) (*models.DeviceUptime, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var sessions []models.DeviceSession
+	if err := db.Where("device_id = ? AND started_at < ? AND (ended_at = 0 OR ended_at > ?)", deviceID, to, from).
+		Order("started_at").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	uptime := &models.DeviceUptime{DeviceID: deviceID, From: from, To: to, Sessions: sessions}
+	for i := range sessions {
+		uptime.Seconds += sessions[i].Span(from, to)
+	}
+	return uptime, nil
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+// GetDeviceTeams retrieves the favorite teams of a device
+func GetDeviceTeams(deviceID uint) ([]models.DeviceTeam, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var deviceTeams []models.DeviceTeam
+	result := db.Where("device_id = ?", deviceID).Preload("Team").Find(&deviceTeams)
+	return deviceTeams, result.Error
+}
+
+// GetAllDeviceTeams retrieves the device-team associations of the devices
+// paired to managerID, or all of them when managerID is nil
+func GetAllDeviceTeams(managerID *uint) ([]models.DeviceTeam, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	query := db.Preload("Device").Preload("Team")
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", managedDeviceIDs(db, *managerID))
+	}
+	var deviceTeams []models.DeviceTeam
+	result := query.Find(&deviceTeams)
+	return deviceTeams, result.Error
+}
+
+// GetDeviceTeamIDs returns the SofaScore IDs of the favorite teams of a device
+func GetDeviceTeamIDs(deviceID uint) ([]int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var teamIDs []int64
+	result := db.Model(&models.DeviceTeam{}).Where("device_id = ?", deviceID).Order("team_id ASC").Pluck("team_id", &teamIDs)
+	return teamIDs, result.Error
+}
+
+// AddTeamToDevice marks a team as favorite for a device, returning the existing association if any
+func AddTeamToDevice(deviceID uint, teamID int64) (*models.DeviceTeam, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	deviceTeam := &models.DeviceTeam{DeviceID: deviceID, TeamID: teamID}
+	if err := db.Where(deviceTeam).FirstOrCreate(deviceTeam).Error; err != nil {
+		return nil, err
+	}
+	result := db.Preload("Team").First(deviceTeam, deviceTeam.ID)
+	return deviceTeam, result.Error
+}
+
+// RemoveTeamFromDevice removes a device-team association
+func RemoveTeamFromDevice(deviceID uint, teamID int64) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Where("device_id = ? AND team_id = ?", deviceID, teamID).Unscoped().Delete(&models.DeviceTeam{}).Error
+}
+
+// SetDeviceTeams sets the favorite teams for a device (replaces all existing)
+func SetDeviceTeams(deviceID uint, teamIDs []int64) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	tx := db.Begin()
+
+	if err := tx.Where("device_id = ?", deviceID).Unscoped().Delete(&models.DeviceTeam{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, teamID := range teamIDs {
+		deviceTeam := &models.DeviceTeam{DeviceID: deviceID, TeamID: teamID}
+		if err := tx.Where(deviceTeam).FirstOrCreate(deviceTeam).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// TeamExists reports whether a team with the given SofaScore ID is known
+func TeamExists(teamID int64) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	var count int64
+	result := db.Model(&models.Team{}).Where("team_id = ?", teamID).Count(&count)
+	return count > 0, result.Error
+}
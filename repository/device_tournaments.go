@@ -16,14 +16,19 @@ func GetDeviceTournaments(deviceID uint) ([]models.DeviceTournament, error) {
 	return deviceTournaments, result.Error
 }
 
-// GetAllDeviceTournaments retrieves all device-tournament associations
-func GetAllDeviceTournaments() ([]models.DeviceTournament, error) {
+// GetAllDeviceTournaments retrieves the device-tournament associations of the
+// devices paired to managerID, or all of them when managerID is nil
+func GetAllDeviceTournaments(managerID *uint) ([]models.DeviceTournament, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, err
 	}
+	query := db.Preload("Device").Preload("Tournament")
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", managedDeviceIDs(db, *managerID))
+	}
 	var deviceTournaments []models.DeviceTournament
-	result := db.Preload("Device").Preload("Tournament").Find(&deviceTournaments)
+	result := query.Find(&deviceTournaments)
 	return deviceTournaments, result.Error
 }
 
@@ -4,59 +4,144 @@ import (
 	"time"
 
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/deviceauth"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
 )
 
-func RegisterDevice(userID *uint, token, platform, name, version string) (*models.Device, error) {
+func RegisterDevice(userID *uint, token, platform, name, version, country, packageName, locale, fingerprint string) (*models.Device, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, err
 	}
 	device := &models.Device{
-		UserID:   userID,
-		Token:    token,
-		Platform: platform,
-		Name:     name,
-		Version:  version,
-		LastSeen: time.Now().Unix(),
+		UserID:      userID,
+		Token:       token,
+		Platform:    platform,
+		Name:        name,
+		Version:     version,
+		Country:     country,
+		PackageName: packageName,
+		Locale:      locale,
+		Fingerprint: fingerprint,
+		LastSeen:    time.Now().Unix(),
 	}
-	result := db.Where(models.Device{Token: token}).Assign(models.Device{UserID: userID, Platform: platform, Name: name, LastSeen: device.LastSeen, Version: version}).FirstOrCreate(device)
-	return device, result.Error
+	assign := models.Device{UserID: userID, Platform: platform, Name: name, LastSeen: device.LastSeen, Version: version, Country: country, PackageName: packageName, Locale: locale}
+	// An app that does not report a fingerprint keeps the stored one.
+	if fingerprint != "" {
+		assign.Fingerprint = fingerprint
+	}
+	result := db.Where(models.Device{Token: token}).Assign(assign).FirstOrCreate(device)
+	if result.Error != nil {
+		return device, result.Error
+	}
+	// Registering again hands over a fresh push token.
+	if device.PushDisabledAt != 0 {
+		if err := db.Model(device).UpdateColumn("push_disabled_at", 0).Error; err != nil {
+			return device, err
+		}
+	}
+	return device, nil
 }
 
-func UpdateDeviceLastSeen(token string) error {
+// FingerprintBlocked reports whether a blocked device has reported the
+// hardware fingerprint
+func FingerprintBlocked(fingerprint string) (bool, error) {
+	if fingerprint == "" {
+		return false, nil
+	}
 	db, err := database.GetDB()
 	if err != nil {
-		return err
+		return false, err
 	}
-	return db.Model(&models.Device{}).Where("token = ?", token).Update("last_seen", time.Now().Unix()).Error
+	var count int64
+	err = db.Model(&models.Device{}).Where("fingerprint = ? AND blocked_at <> 0", fingerprint).Count(&count).Error
+	return count > 0, err
 }
 
-func GetDevices(page, limit uint) ([]models.Device, int64, error) {
+// managedDeviceIDs is a subquery of the IDs of the devices paired to
+// managerID, for scoping per-device tables
+func managedDeviceIDs(db *gorm.DB, managerID uint) *gorm.DB {
+	return db.Model(&models.Device{}).Select("id").Where("user_id = ?", managerID)
+}
+
+// GetDevices pages through the devices paired to managerID, or through all
+// devices when managerID is nil
+func GetDevices(managerID *uint, page, limit uint) ([]models.Device, int64, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, 0, err
 	}
+	query := db.Model(&models.Device{})
+	if managerID != nil {
+		query = query.Where("user_id = ?", *managerID)
+	}
 	var devices []models.Device
 	var total int64
-	if err := db.Model(&models.Device{}).Count(&total).Error; err != nil {
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	offset := (page - 1) * limit
-	result := db.Offset(int(offset)).Limit(int(limit)).Preload("Manager").Find(&devices)
+	result := query.Session(&gorm.Session{}).Offset(int(offset)).Limit(int(limit)).Preload("Manager").Find(&devices)
 	return devices, total, result.Error
 }
 
-func GetAllDevices() ([]models.Device, error) {
+func GetAllDevices(managerID *uint) ([]models.Device, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, err
 	}
+	query := db.Preload("Manager")
+	if managerID != nil {
+		query = query.Where("user_id = ?", *managerID)
+	}
 	var devices []models.Device
-	result := db.Preload("Manager").Find(&devices)
+	result := query.Find(&devices)
 	return devices, result.Error
 }
 
+func GetDeviceByID(id uint) (*models.Device, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var device models.Device
+	if err := db.First(&device, id).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// GetPairedDeviceIDs returns the IDs of the devices paired to managerID
+func GetPairedDeviceIDs(managerID uint) ([]uint, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint
+	result := managedDeviceIDs(db, managerID).Pluck("id", &ids)
+	return ids, result.Error
+}
+
+// GetManagedDeviceIDs returns which of ids are existing devices, restricted
+// to those of managerID when set
+func GetManagedDeviceIDs(managerID *uint, ids []uint) ([]uint, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	found := make([]uint, 0, len(ids))
+	if len(ids) == 0 {
+		return found, nil
+	}
+	query := db.Model(&models.Device{}).Where("id IN ?", ids)
+	if managerID != nil {
+		query = query.Where("user_id = ?", *managerID)
+	}
+	result := query.Pluck("id", &found)
+	return found, result.Error
+}
+
 func UpdateDevice(token, platform, name string) (*models.Device, error) {
 	db, err := database.GetDB()
 	if err != nil {
@@ -76,3 +161,180 @@ func UpdateDevice(token, platform, name string) (*models.Device, error) {
 
 	return &device, nil
 }
+
+func GetDeviceByToken(token string) (*models.Device, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var device models.Device
+	if err := db.Where("token = ?", token).First(&device).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// IssueDeviceSecret stores a new signing secret for the device and returns it
+func IssueDeviceSecret(id uint) (string, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return "", err
+	}
+	secret, err := deviceauth.GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+	result := db.Model(&models.Device{}).Where("id = ?", id).Updates(map[string]any{
+		"secret":                     secret,
+		"previous_secret":            "",
+		"previous_secret_expires_at": 0,
+	})
+	return secret, result.Error
+}
+
+// RotateDeviceSecret replaces the device secret, keeping the current one
+// valid for grace
+func RotateDeviceSecret(device *models.Device, grace time.Duration) (string, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return "", err
+	}
+	secret, err := deviceauth.GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+	result := db.Model(&models.Device{}).Where("id = ?", device.ID).Updates(map[string]any{
+		"secret":                     secret,
+		"previous_secret":            device.Secret,
+		"previous_secret_expires_at": time.Now().Add(grace).Unix(),
+	})
+	return secret, result.Error
+}
+
+// RevokeDevice rejects every further app request of the device
+func RevokeDevice(id, actorID uint) (*models.Device, error) {
+	return changeDeviceAccess(id, actorID, models.DeviceAuditRevoked, "", map[string]any{
+		"revoked_at": time.Now().Unix(),
+	})
+}
+
+// ReinstateDevice lifts a revocation and discards the old secrets, so the
+// device has to be paired again with an enrollment code to obtain a new one
+func ReinstateDevice(id, actorID uint) (*models.Device, error) {
+	return changeDeviceAccess(id, actorID, models.DeviceAuditReinstated, "", map[string]any{
+		"revoked_at":                 0,
+		"pairing_required":           true,
+		"secret":                     "",
+		"previous_secret":            "",
+		"previous_secret_expires_at": 0,
+	})
+}
+
+// BlockDevice disables the device until it is unblocked. Unlike a
+// revocation its credentials stay valid
+func BlockDevice(id, actorID uint, reason string) (*models.Device, error) {
+	return changeDeviceAccess(id, actorID, models.DeviceAuditBlocked, reason, map[string]any{
+		"blocked_at":   time.Now().Unix(),
+		"block_reason": reason,
+	})
+}
+
+func UnblockDevice(id, actorID uint, reason string) (*models.Device, error) {
+	return changeDeviceAccess(id, actorID, models.DeviceAuditUnblocked, reason, map[string]any{
+		"blocked_at":   0,
+		"block_reason": "",
+	})
+}
+
+// changeDeviceAccess applies values to a device and records action in its
+// audit log in the same transaction
+func changeDeviceAccess(id, actorID uint, action, reason string, values map[string]any) (*models.Device, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	var device models.Device
+	if err := tx.First(&device, id).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Model(&device).Updates(values).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Create(&models.DeviceAuditLog{DeviceID: id, UserID: &actorID, Action: action, Reason: reason}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	if err := db.Preload("Manager").First(&device, id).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// GetDeviceAuditLogs returns the audit trail of a device, newest first
+func GetDeviceAuditLogs(deviceID uint) ([]models.DeviceAuditLog, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var logs []models.DeviceAuditLog
+	result := db.Where("device_id = ?", deviceID).Preload("User").Order("id DESC").Find(&logs)
+	return logs, result.Error
+}
+
+// GetDuplicateDevices returns the fingerprints reported by more than one
+// device, restricted to the devices paired to managerID when not nil
+func GetDuplicateDevices(managerID *uint) ([]models.DuplicateDevices, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	scope := func() *gorm.DB {
+		query := db.Model(&models.Device{}).Where("fingerprint <> ''")
+		if managerID != nil {
+			query = query.Where("user_id = ?", *managerID)
+		}
+		return query
+	}
+
+	var fingerprints []string
+	if err := scope().
+		Group("fingerprint").
+		Having("COUNT(*) > 1").
+		Order("fingerprint").
+		Pluck("fingerprint", &fingerprints).Error; err != nil {
+		return nil, err
+	}
+	if len(fingerprints) == 0 {
+		return nil, nil
+	}
+
+	var devices []models.Device
+	if err := scope().Where("fingerprint IN ?", fingerprints).Preload("Manager").Order("last_seen DESC").Find(&devices).Error; err != nil {
+		return nil, err
+	}
+
+	groups := make([]models.DuplicateDevices, len(fingerprints))
+	index := make(map[string]int, len(fingerprints))
+	for i, fingerprint := range fingerprints {
+		groups[i].Fingerprint = fingerprint
+		index[fingerprint] = i
+	}
+	for _, device := range devices {
+		i := index[device.Fingerprint]
+		groups[i].Devices = append(groups[i].Devices, device)
+	}
+	return groups, nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateEnrollmentCode generates a single-use code that pairs a device with
+// userID until ttl elapses
+func CreateEnrollmentCode(userID uint, ttl time.Duration) (*models.EnrollmentCode, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Collisions are only possible with live codes, so retry a few times
+	// before giving up.
+	var lastErr error
+	for range 5 {
+		code, err := randomEnrollmentCode()
+		if err != nil {
+			return nil, err
+		}
+		enrollment := &models.EnrollmentCode{
+			UserID:    userID,
+			Code:      code,
+			ExpiresAt: time.Now().Add(ttl).Unix(),
+		}
+		if lastErr = db.Create(enrollment).Error; lastErr == nil {
+			return enrollment, nil
+		}
+	}
+	return nil, lastErr
+}
+
+// GetEnrollmentCodes returns the codes of userID generated within the last
+// day, newest first
+func GetEnrollmentCodes(userID uint) ([]models.EnrollmentCode, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var codes []models.EnrollmentCode
+	result := db.Where("user_id = ? AND created_at >= ?", userID, time.Now().Add(-models.EnrollmentCodeMaxTTL)).
+		Preload("Device").
+		Order("id DESC").
+		Find(&codes)
+	return codes, result.Error
+}
+
+// DeleteEnrollmentCode removes a code of userID and reports whether it
+// existed
+func DeleteEnrollmentCode(userID, id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Unscoped().Where("id = ? AND user_id = ?", id, userID).Delete(&models.EnrollmentCode{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RedeemEnrollmentCode pairs device with the manager that generated code.
+// A device already paired with another manager is only moved by the code of
+// an admin.
+func RedeemEnrollmentCode(code string, device *models.Device) (*models.EnrollmentCode, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	now := time.Now()
+	var enrollment models.EnrollmentCode
+	if err := tx.Where("code = ?", code).First(&enrollment).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrEnrollmentCodeInvalid
+		}
+		return nil, err
+	}
+
+	// Guard against a concurrent redemption of the same code.
+	claimed := tx.Model(&models.EnrollmentCode{}).
+		Where("id = ? AND redeemed_at = 0 AND expires_at > ?", enrollment.ID, now.Unix()).
+		Updates(map[string]any{"redeemed_at": now.Unix(), "device_id": device.ID})
+	if claimed.Error != nil {
+		tx.Rollback()
+		return nil, claimed.Error
+	}
+	if claimed.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, models.ErrEnrollmentCodeInvalid
+	}
+
+	// The device row is locked so its current owner cannot change while the
+	// pairing is decided.
+	var current models.Device
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, device.ID).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// The manager row is locked so concurrent enrollments cannot overshoot
+	// its quota.
+	if current.UserID == nil || *current.UserID != enrollment.UserID {
+		var manager models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&manager, enrollment.UserID).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		// Only an admin's code may move a device away from another manager.
+		if current.UserID != nil && !manager.IsAdmin() {
+			tx.Rollback()
+			return nil, models.ErrDevicePairedElsewhere
+		}
+		if manager.DeviceQuota > 0 {
+			var paired int64
+			if err := tx.Model(&models.Device{}).Where("user_id = ?", manager.ID).Count(&paired).Error; err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if paired >= int64(manager.DeviceQuota) {
+				tx.Rollback()
+				return nil, models.ErrDeviceQuotaExceeded
+			}
+		}
+	}
+
+	// Redeeming a code also completes the pairing a reinstated device needs.
+	if err := tx.Model(&models.Device{}).Where("id = ?", device.ID).Updates(map[string]any{
+		"user_id":          enrollment.UserID,
+		"pairing_required": false,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Create(&models.DeviceAuditLog{DeviceID: device.ID, UserID: &enrollment.UserID, Action: models.DeviceAuditEnrolled, Reason: "enrollment code " + enrollment.Code}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	enrollment.RedeemedAt = now.Unix()
+	enrollment.DeviceID = &device.ID
+	device.UserID = &enrollment.UserID
+	device.PairingRequired = false
+	return &enrollment, nil
+}
+
+func randomEnrollmentCode() (string, error) {
+	alphabet := big.NewInt(int64(len(models.EnrollmentCodeAlphabet)))
+	code := make([]byte, models.EnrollmentCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabet)
+		if err != nil {
+			return "", err
+		}
+		code[i] = models.EnrollmentCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+)
+
+const (
+	eventDetailLastResults = 5
+	eventDetailHeadToHead  = 10
+)
+
+// GetEventDetail retrieves an event by its SofaScore ID with the latest
+// results of both teams and their head-to-head history
+func GetEventDetail(sofaScoreEventID int64) (*models.EventDetail, error) {
+	event, err := GetEventWithTeams(sofaScoreEventID)
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &models.EventDetail{Event: *event}
+	finished := func() *gorm.DB {
+		return db.Preload("HomeTeamModel").Preload("AwayTeamModel").Preload("League").
+			Where("status_type = ? AND id <> ? AND start_timestamp < ?", "finished", event.ID, event.StartTimestamp).
+			Order("start_timestamp DESC")
+	}
+
+	if err := finished().Where("home_team_id = ? OR away_team_id = ?", event.HomeTeamId, event.HomeTeamId).
+		Limit(eventDetailLastResults).Find(&detail.HomeLast).Error; err != nil {
+		return nil, err
+	}
+	if err := finished().Where("home_team_id = ? OR away_team_id = ?", event.AwayTeamId, event.AwayTeamId).
+		Limit(eventDetailLastResults).Find(&detail.AwayLast).Error; err != nil {
+		return nil, err
+	}
+	if err := finished().Where("(home_team_id = ? AND away_team_id = ?) OR (home_team_id = ? AND away_team_id = ?)",
+		event.HomeTeamId, event.AwayTeamId, event.AwayTeamId, event.HomeTeamId).
+		Limit(eventDetailHeadToHead).Find(&detail.HeadToHead).Error; err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
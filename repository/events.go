@@ -1,12 +1,15 @@
 package repository
 
 import (
+	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/imageproxy"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -14,12 +17,33 @@ import (
 
 var downloadSem = make(chan struct{}, 10)
 
-func SaveSofaScoreEvent(Events []*models.APIEvent, sport string) {
+// eventUpsertColumns are the columns a scrape refreshes on an existing event.
+// sport is added only when the scrape knows it, so trending lists that carry
+// no sport never blank it.
+var eventUpsertColumns = []string{"deleted_at", "home_score", "away_score", "start_timestamp", "current_period_start_timestamp", "status_code", "status_type", "status_description", "round", "round_name", "season", "venue", "venue_city", "referee", "scraped_at", "updated_at"}
+
+// SaveSofaScoreEvent upserts the scraped events with their teams and
+// tournaments. The sport reported by each event payload takes precedence;
+// sport is only used as a fallback when the payload does not carry one.
+// Events that are new or whose score or status changed are published on
+// broker.TopicEventChanged; events that only gained details such as the
+// venue are saved silently and the rest only get their scraped_at refreshed.
+// Webhook deliveries for match transitions are queued with the event.
+// An error is returned when any event could not be saved.
+func SaveSofaScoreEvent(Events []*models.APIEvent, sport string) error {
 	db, err := database.GetDB()
 	if err != nil {
-		return
+		return err
 	}
 
+	existing := loadExistingEvents(db, Events)
+	unchanged := make([]uint, 0, len(Events))
+	var translations []models.Translation
+	var failed int
+	var saveErr error
+	var webhooks []models.WebhookSubscription
+	webhooksLoaded := false
+
 	now := time.Now().Unix()
 	for _, event := range Events {
 		model := event.ToSofaScoreEvent()
@@ -39,13 +63,241 @@ func SaveSofaScoreEvent(Events []*models.APIEvent, sport string) {
 		tournament := models.Tournament{Slug: event.Tournament.UniqueTournament.Slug + "-" + strings.ToLower(event.Tournament.UniqueTournament.Category.Slug), Name: event.Tournament.UniqueTournament.Name, Region: event.Tournament.UniqueTournament.Category.Name, Model: gorm.Model{ID: uint(event.Tournament.UniqueTournament.ID)}}
 		db.FirstOrCreate(&tournament, models.Tournament{Slug: event.Tournament.UniqueTournament.Slug + "-" + strings.ToLower(event.Tournament.UniqueTournament.Category.Slug)})
 
+		translations = append(translations, event.Translations()...)
+
 		model.ScrapedAt = now
-		model.Sport = sport
-		db.Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "sofa_score_event_id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"home_score", "away_score", "current_period_start_timestamp", "scraped_at"}),
-		}).Create(&model)
+		if model.Sport == "" {
+			model.Sport = sport
+		}
+		// Cancelled events are soft deleted so syncing clients get a
+		// tombstone; any other status brings a deleted event back.
+		if model.StatusType == models.StatusCanceled {
+			model.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		}
+
+		previous, found := existing[model.SofaScoreEventId]
+		deletedChanged := found && previous.DeletedAt.Valid != model.DeletedAt.Valid
+		liveChange := !found || previous.ScoreOrStatusChanged(&model)
+		if !liveChange && !deletedChanged && !previous.DetailsChanged(&model) {
+			unchanged = append(unchanged, previous.ID)
+			continue
+		}
+		if found {
+			keepEventDetails(&model, previous)
+		}
+
+		columns := eventUpsertColumns
+		if model.Sport != "" {
+			columns = append([]string{"sport"}, columns...)
+		}
+
+		transitions := models.EventChange{Event: model, Previous: previous}.Transitions()
+		if len(transitions) > 0 && !webhooksLoaded {
+			if err := db.Where("active = ?", true).Find(&webhooks).Error; err != nil {
+				log.Printf("repository: failed to load webhook subscriptions: %v", err)
+				failed, saveErr = failed+1, err
+				continue
+			}
+			webhooksLoaded = true
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "sofa_score_event_id"}},
+				DoUpdates: clause.AssignmentColumns(columns),
+			}).Create(&model).Error; err != nil {
+				return err
+			}
+			if len(transitions) == 0 {
+				return nil
+			}
+			return enqueueMatchWebhooks(tx, webhooks, transitions, model)
+		}); err != nil {
+			log.Printf("repository: failed to save event %d: %v", model.SofaScoreEventId, err)
+			failed, saveErr = failed+1, err
+			continue
+		}
+		if !liveChange {
+			continue
+		}
+
+		change := models.EventChange{Event: model, Previous: previous}
+		if found {
+			change.Event.ID = previous.ID
+			change.Event.CreatedAt = previous.CreatedAt
+		}
+		broker.Publish(broker.TopicEventChanged, change)
 	}
+
+	if len(unchanged) > 0 {
+		db.Model(&models.SofaScoreEvent{}).Unscoped().Where("id IN ?", unchanged).UpdateColumn("scraped_at", now)
+	}
+	saveScrapedTranslations(db, translations)
+	if saveErr != nil {
+		return fmt.Errorf("failed to save %d of %d events: %w", failed, len(Events), saveErr)
+	}
+	return nil
+}
+
+// DeleteMissingEvents soft deletes the stored events of sport that SofaScore
+// no longer lists. Only events starting between the first and last event of
+// the scraped day are considered, so day boundaries that differ from the
+// upstream ones never remove anything. Syncing clients receive tombstones for
+// the deleted events.
+func DeleteMissingEvents(sport string, events []*models.APIEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, 0, len(events))
+	first, last := events[0].StartTimestamp, events[0].StartTimestamp
+	for _, event := range events {
+		ids = append(ids, event.ID)
+		first = min(first, event.StartTimestamp)
+		last = max(last, event.StartTimestamp)
+	}
+
+	result := db.Where("sport = ? AND start_timestamp BETWEEN ? AND ? AND sofa_score_event_id NOT IN ?", sport, first, last, ids).
+		Delete(&models.SofaScoreEvent{})
+	if result.RowsAffected > 0 {
+		log.Printf("repository: deleted %d %s events no longer listed upstream", result.RowsAffected, sport)
+	}
+	return result.Error
+}
+
+// keepEventDetails fills the details missing from a scraped event with the
+// stored ones, since list payloads do not always include them.
+func keepEventDetails(model, previous *models.SofaScoreEvent) {
+	if model.Sport == "" {
+		model.Sport = previous.Sport
+	}
+	if model.Round == 0 {
+		model.Round = previous.Round
+	}
+	if model.RoundName == "" {
+		model.RoundName = previous.RoundName
+	}
+	if model.Season == "" {
+		model.Season = previous.Season
+	}
+	if model.Venue == "" {
+		model.Venue = previous.Venue
+	}
+	if model.VenueCity == "" {
+		model.VenueCity = previous.VenueCity
+	}
+	if model.Referee == "" {
+		model.Referee = previous.Referee
+	}
+}
+
+// loadExistingEvents returns the stored version of the given events keyed by
+// SofaScore event ID, including soft deleted ones.
+func loadExistingEvents(db *gorm.DB, events []*models.APIEvent) map[int64]*models.SofaScoreEvent {
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	existing := make(map[int64]*models.SofaScoreEvent, len(ids))
+	if len(ids) == 0 {
+		return existing
+	}
+
+	var stored []models.SofaScoreEvent
+	if err := db.Unscoped().Where("sofa_score_event_id IN ?", ids).Find(&stored).Error; err != nil {
+		log.Printf("repository: failed to load stored events: %v", err)
+		return existing
+	}
+	for i := range stored {
+		existing[stored[i].SofaScoreEventId] = &stored[i]
+	}
+	return existing
+}
+
+// GetDeviceTournamentIDs returns the tournaments a device follows: its own
+// assignments, else the set of its highest-priority group that has one, else
+// the global configuration. The set is cut to the MaxTournaments of the
+// device plan, keeping the earliest entries.
+func GetDeviceTournamentIDs(devId uint) ([]uint, error) {
+	tournamentIDs, err := resolveDeviceTournamentIDs(devId)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := GetDevicePlan(devId)
+	if err != nil {
+		return nil, err
+	}
+	if plan != nil && plan.MaxTournaments > 0 && len(tournamentIDs) > plan.MaxTournaments {
+		tournamentIDs = tournamentIDs[:plan.MaxTournaments]
+	}
+	return tournamentIDs, nil
+}
+
+func resolveDeviceTournamentIDs(devId uint) ([]uint, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var selfEvents []models.DeviceTournament
+	if err := db.Order("id ASC").Find(&selfEvents, "device_id = ?", devId).Error; err != nil {
+		return nil, err
+	}
+	if len(selfEvents) > 0 {
+		tournamentIDs := make([]uint, len(selfEvents))
+		for i, dt := range selfEvents {
+			tournamentIDs[i] = dt.TournamentID
+		}
+		return tournamentIDs, nil
+	}
+
+	tournamentIDs, err := groupTournamentIDs(db, devId)
+	if err != nil || len(tournamentIDs) > 0 {
+		return tournamentIDs, err
+	}
+
+	var globalConfig []models.GlobalTournamentConfig
+	if err := db.Order("id ASC").Find(&globalConfig).Error; err != nil {
+		return nil, err
+	}
+	tournamentIDs = make([]uint, len(globalConfig))
+	for i, gc := range globalConfig {
+		tournamentIDs[i] = gc.TournamentID
+	}
+	return tournamentIDs, nil
+}
+
+// groupTournamentIDs returns the tournament set of the highest-priority
+// group of the device that has one
+func groupTournamentIDs(db *gorm.DB, devId uint) ([]uint, error) {
+	var device models.Device
+	if err := db.Limit(1).Find(&device, devId).Error; err != nil || device.ID == 0 {
+		return nil, err
+	}
+	groupIDs, err := GetDeviceGroupIDs(device)
+	if err != nil || len(groupIDs) == 0 {
+		return nil, err
+	}
+
+	var groupTournaments []models.DeviceGroupTournament
+	if err := db.Where("device_group_id IN ?", groupIDs).Order("id ASC").Find(&groupTournaments).Error; err != nil {
+		return nil, err
+	}
+	byGroup := make(map[uint][]uint, len(groupIDs))
+	for _, gt := range groupTournaments {
+		byGroup[gt.DeviceGroupID] = append(byGroup[gt.DeviceGroupID], gt.TournamentID)
+	}
+	for _, groupID := range groupIDs {
+		if tournamentIDs := byGroup[groupID]; len(tournamentIDs) > 0 {
+			return tournamentIDs, nil
+		}
+	}
+	return nil, nil
 }
 
 func isProxiedLogoURL(url string) bool {
@@ -76,79 +328,27 @@ func downloadAndUpdateTeamLogo(db *gorm.DB, teamID int64, sourceURL string) {
 	}
 }
 
-func GetCurrentAndUpcomingEvents(devId uint, limit int) ([]models.SofaScoreEvent, error) {
+// DeleteEvent soft deletes an event so syncing clients receive a tombstone
+func DeleteEvent(id uint) error {
 	db, err := database.GetDB()
 	if err != nil {
-		return nil, err
-	}
-
-	if limit <= 0 || limit > 6 {
-		limit = 6
-	}
-
-	now := time.Now().Add(-(time.Minute * 5)).Unix()
-	var events []models.SofaScoreEvent
-	var selfEvents []models.DeviceTournament
-
-	var tournamentIDs []uint
-	db.Find(&selfEvents, "device_id = ?", devId)
-	if len(selfEvents) > 0 {
-		tournamentIDs = make([]uint, len(selfEvents))
-		for i, dt := range selfEvents {
-			tournamentIDs[i] = dt.TournamentID
-		}
-	} else {
-		var globalConfig []models.GlobalTournamentConfig
-		db.Find(&globalConfig)
-		tournamentIDs = make([]uint, len(globalConfig))
-		for i, gc := range globalConfig {
-			tournamentIDs[i] = gc.TournamentID
-		}
-	}
-
-	db.Where("current_period_start_timestamp >= ? AND league_id IN ?", now, tournamentIDs).
-		Order("current_period_start_timestamp DESC").
-		Limit(limit).
-		Preload("HomeTeamModel").
-		Preload("AwayTeamModel").
-		Preload("League").
-		Find(&events)
-
-	if len(events) < limit {
-		remaining := limit - len(events)
-		var upcomingEvents []models.SofaScoreEvent
-		existingIDs := make([]uint, len(events))
-		for i, e := range events {
-			existingIDs[i] = e.ID
-		}
-
-		now = time.Now().Add((time.Minute * 5)).Unix()
-		query := db.Where("start_timestamp > ? AND league_id IN ?", now, tournamentIDs).Order("start_timestamp ASC")
-		if len(existingIDs) > 0 {
-			query = query.Where("id NOT IN ?", existingIDs)
-		}
-
-		query.Limit(remaining).
-			Preload("HomeTeamModel").
-			Preload("AwayTeamModel").
-			Preload("League").
-			Find(&upcomingEvents)
-
-		events = append(events, upcomingEvents...)
+		return err
 	}
-
-	return events, nil
+	return db.Delete(&models.SofaScoreEvent{}, id).Error
 }
 
+// GenerateDailyEventStats aggregates the playbacks that ended yesterday into
+// per-content stats. Playback timestamps are Unix seconds, as are the stored
+// totals
 func GenerateDailyEventStats() error {
 	db, err := database.GetDB()
 	if err != nil {
 		return err
 	}
 
-	now := time.Now()
-	begin := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local).Add(-time.Hour * 24)
-	end := begin.AddDate(0, 0, 1).Add(-time.Second)
+	loc := timezone.Reference()
+	yesterday := timezone.StartOfDay(time.Now(), loc).AddDate(0, 0, -1)
+	begin, end := timezone.DayBounds(yesterday, loc)
 
 	var stats []struct {
 		Content    string
@@ -166,9 +366,9 @@ func GenerateDailyEventStats() error {
 	}()
 
 	if err := ctx.Model(&models.PlaybackLog{}).
-		Select("content, COUNT(id) as total_views, COALESCE(SUM(CAST(ended_at AS SIGNED) - CAST(started_at AS SIGNED)) DIV 1000, 0) as time_played").
+		Select("content, COUNT(id) as total_views, COALESCE(SUM(CAST(ended_at AS SIGNED) - CAST(started_at AS SIGNED)), 0) as time_played").
 		Group("content").
-		Where("ended_at <= ? AND ended_at >= ? AND ended_at > 0", end.UnixMilli(), begin.UnixMilli()).
+		Where("ended_at < ? AND ended_at >= ? AND ended_at > 0", end.Unix(), begin.Unix()).
 		Find(&stats).Error; err != nil {
 		ctx.Rollback()
 		return err
@@ -195,7 +395,7 @@ func GenerateDailyEventStats() error {
 		return err
 	}
 
-	if err := ctx.Unscoped().Delete(&models.PlaybackLog{}, "ended_at <= ? AND ended_at >= ? AND ended_at > 0", end.UnixMilli(), begin.UnixMilli()).Error; err != nil {
+	if err := ctx.Unscoped().Delete(&models.PlaybackLog{}, "ended_at < ? AND ended_at >= ? AND ended_at > 0", end.Unix(), begin.Unix()).Error; err != nil {
 		ctx.Rollback()
 		return err
 	}
@@ -209,9 +409,9 @@ func GenerateMonthlyEventStats() error {
 		return err
 	}
 
-	now := time.Now()
-	begin := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
-	end := begin.AddDate(0, 1, 0).Add(-time.Second)
+	loc := timezone.Reference()
+	thisMonth, _ := timezone.MonthBounds(time.Now(), loc)
+	begin, end := timezone.MonthBounds(thisMonth.AddDate(0, -1, 0), loc)
 	var stats []struct {
 		ContentHash string
 		TotalViews  int
@@ -230,7 +430,7 @@ func GenerateMonthlyEventStats() error {
 	if err := ctx.Model(&models.ContentStat{}).
 		Select("content_hash, SUM(views) as total_views, SUM(seconds) as time_played").
 		Group("content_hash").
-		Where("created_at >= ? AND created_at <= ? AND period_type = ?", begin, end, models.PeriodTypeDay).
+		Where("period_start >= ? AND period_start < ? AND period_type = ?", begin, end, models.PeriodTypeDay).
 		Find(&stats).Error; err != nil {
 		ctx.Rollback()
 		return err
@@ -257,7 +457,7 @@ func GenerateMonthlyEventStats() error {
 		return err
 	}
 
-	if err := ctx.Unscoped().Delete(&models.ContentStat{}, "created_at >= ? AND created_at <= ? AND period_type = ?", begin, end, models.PeriodTypeDay).Error; err != nil {
+	if err := ctx.Unscoped().Delete(&models.ContentStat{}, "period_start >= ? AND period_start < ? AND period_type = ?", begin, end, models.PeriodTypeDay).Error; err != nil {
 		ctx.Rollback()
 		return err
 	}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+const feedCacheTTL = 5 * time.Second
+
+// eventFeeds caches GetEventFeed results. Entries are shared between callers
+// and must not be modified.
+var eventFeeds = &feedCache{entries: make(map[string]feedCacheEntry)}
+
+type feedCacheEntry struct {
+	feed      *models.EventFeed
+	expiresAt time.Time
+}
+
+type feedCache struct {
+	mu      sync.Mutex
+	entries map[string]feedCacheEntry
+}
+
+func (c *feedCache) get(key string) (*models.EventFeed, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.feed, true
+}
+
+func (c *feedCache) set(key string, feed *models.EventFeed) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = feedCacheEntry{feed: feed, expiresAt: now.Add(feedCacheTTL)}
+}
+
+// feedCacheKey identifies a feed page regardless of the order of the
+// tournament and favorite team sets.
+func feedCacheKey(tournamentIDs []uint, teamIDs []int64, settings models.FeedSettings, live bool, cursor models.FeedCursor, limit int) string {
+	ids := slices.Clone(tournamentIDs)
+	slices.Sort(ids)
+	teams := slices.Clone(teamIDs)
+	slices.Sort(teams)
+	return fmt.Sprintf("%v|%v|%d|%d|%d|%s|%s|%t|%s|%d", ids, teams,
+		settings.MaxItems, settings.LookAheadHours, settings.RecentHours, settings.Ordering, settings.Sports, live, cursor, limit)
+}
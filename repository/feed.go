@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetFeedSettings returns the feed settings of a device, falling back to the
+// settings of its package and then to the defaults
+func GetFeedSettings(device models.Device) (models.FeedSettings, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return models.FeedSettings{}, err
+	}
+
+	var settings []models.FeedSettings
+	query := db.Where("device_id = ?", device.ID)
+	if device.PackageName != "" {
+		query = query.Or("device_id IS NULL AND package_name = ?", device.PackageName)
+	}
+	if err := query.Order("device_id DESC").Limit(1).Find(&settings).Error; err != nil {
+		return models.FeedSettings{}, err
+	}
+	if len(settings) == 0 {
+		return models.DefaultFeedSettings(), nil
+	}
+	return settings[0], nil
+}
+
+// GetAllFeedSettings retrieves every device and package feed configuration,
+// or only the device configurations of the devices paired to managerID
+func GetAllFeedSettings(managerID *uint) ([]models.FeedSettings, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	query := db.Preload("Device")
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", managedDeviceIDs(db, *managerID))
+	}
+	var settings []models.FeedSettings
+	result := query.Order("package_name ASC, device_id ASC").Find(&settings)
+	return settings, result.Error
+}
+
+// SaveFeedSettings creates or updates the feed settings of a device, or of a
+// package when deviceID is nil
+func SaveFeedSettings(deviceID *uint, packageName string, values models.FeedSettings) (*models.FeedSettings, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var settings models.FeedSettings
+	query := db.Where("device_id IS NULL AND package_name = ?", packageName)
+	if deviceID != nil {
+		query = db.Where("device_id = ?", *deviceID)
+	}
+	if err := query.Limit(1).Find(&settings).Error; err != nil {
+		return nil, err
+	}
+
+	settings.DeviceID = deviceID
+	settings.PackageName = packageName
+	settings.MaxItems = values.MaxItems
+	settings.LookAheadHours = values.LookAheadHours
+	settings.RecentHours = values.RecentHours
+	settings.Ordering = values.Ordering
+	settings.Sports = values.Sports
+	result := db.Omit("Device").Save(&settings)
+	return &settings, result.Error
+}
+
+// GetFeedSettingsByID retrieves a feed configuration
+func GetFeedSettingsByID(id uint) (*models.FeedSettings, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var settings models.FeedSettings
+	result := db.First(&settings, id)
+	return &settings, result.Error
+}
+
+// DeleteFeedSettings deletes a feed configuration
+func DeleteFeedSettings(id uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Unscoped().Delete(&models.FeedSettings{}, id).Error
+}
+
+// GetEventFeed returns a page of the current-events feed of a device: the
+// events of its tournaments and favorite teams, restricted to the configured
+// sports. Favorite-team events are ranked first. Pages are shared for
+// feedCacheTTL between devices with the same tournaments, favorite teams and
+// settings. Without live the in-progress events are left out, for plans
+// that do not include live updates.
+func GetEventFeed(devId uint, settings models.FeedSettings, live bool, cursor models.FeedCursor, limit int) (*models.EventFeed, error) {
+	tournamentIDs, err := GetDeviceTournamentIDs(devId)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs, err := GetDeviceTeamIDs(devId)
+	if err != nil {
+		return nil, err
+	}
+
+	key := feedCacheKey(tournamentIDs, teamIDs, settings, live, cursor, limit)
+	if feed, ok := eventFeeds.get(key); ok {
+		return feed, nil
+	}
+
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sports := settings.SportList()
+	scope := func() *gorm.DB {
+		q := db.Preload("HomeTeamModel").Preload("AwayTeamModel").Preload("League")
+		if len(teamIDs) > 0 {
+			q = q.Where("league_id IN ? OR home_team_id IN ? OR away_team_id IN ?", tournamentIDs, teamIDs, teamIDs)
+		} else {
+			q = q.Where("league_id IN ?", tournamentIDs)
+		}
+		if len(sports) > 0 {
+			q = q.Where("sport IN ?", sports)
+		}
+		return q
+	}
+	upcoming := func(q *gorm.DB) *gorm.DB {
+		now := time.Now()
+		return q.Where("status_type = ? AND start_timestamp >= ? AND start_timestamp <= ?",
+			"notstarted", now.Unix(), now.Add(time.Duration(settings.LookAheadHours)*time.Hour).Unix()).
+			Order("start_timestamp ASC, id ASC")
+	}
+	// favoritesFirst orders favorite-team events first and then by order. Both
+	// go in one expression because gorm drops an expression order followed
+	// by a column order.
+	favoritesFirst := func(q *gorm.DB, order string) *gorm.DB {
+		if len(teamIDs) == 0 {
+			return q.Order(order)
+		}
+		return q.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "(home_team_id IN ? OR away_team_id IN ?) DESC, " + order,
+			Vars: []any{teamIDs, teamIDs},
+		}})
+	}
+
+	feed := &models.EventFeed{}
+	query := upcoming(scope())
+	if !cursor.IsZero() {
+		query = query.Where("start_timestamp > ? OR (start_timestamp = ? AND id > ?)", cursor.StartTimestamp, cursor.StartTimestamp, cursor.ID)
+	}
+	if err := query.Limit(limit + 1).Find(&feed.Upcoming).Error; err != nil {
+		return nil, err
+	}
+	hasMore := len(feed.Upcoming) > limit
+	if hasMore {
+		feed.Upcoming = feed.Upcoming[:limit]
+	}
+
+	if !cursor.IsZero() {
+		feed.Data = feed.Upcoming
+		if hasMore {
+			last := feed.Upcoming[limit-1]
+			feed.NextCursor = models.FeedCursor{StartTimestamp: last.StartTimestamp, ID: last.ID}
+		}
+		eventFeeds.set(key, feed)
+		return feed, nil
+	}
+
+	if live {
+		if err := favoritesFirst(scope().Where("status_type = ?", "inprogress"), "current_period_start_timestamp DESC").
+			Limit(settings.MaxItems).
+			Find(&feed.Live).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.RecentHours > 0 {
+		since := time.Now().Add(-time.Duration(settings.RecentHours) * time.Hour).Unix()
+		if err := favoritesFirst(scope().Where("status_type = ? AND start_timestamp >= ?", "finished", since), "start_timestamp DESC").
+			Limit(settings.MaxItems).
+			Find(&feed.Recent).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// Favorite-team events are always part of the combined list, even when
+	// they are too far ahead to be on the first upcoming page.
+	var favorites []models.SofaScoreEvent
+	if len(teamIDs) > 0 {
+		if err := upcoming(scope().Where("home_team_id IN ? OR away_team_id IN ?", teamIDs, teamIDs)).
+			Limit(limit).
+			Find(&favorites).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	feed.Data = combineFeed(settings.Ordering, teamIDs, limit, feed.Live, favorites, feed.Upcoming)
+	feed.NextCursor = firstPageCursor(feed.Upcoming, feed.Data, hasMore)
+	eventFeeds.set(key, feed)
+	return feed, nil
+}
+
+// firstPageCursor points after the longest run of upcoming events that made
+// it into data, so the upcoming events that live and favorite events pushed
+// past the limit are served on the next page.
+func firstPageCursor(upcoming, data []models.SofaScoreEvent, hasMore bool) models.FeedCursor {
+	included := make(map[uint]bool, len(data))
+	for _, e := range data {
+		included[e.ID] = true
+	}
+	served := 0
+	for served < len(upcoming) && included[upcoming[served].ID] {
+		served++
+	}
+
+	switch {
+	case served == len(upcoming) && !hasMore:
+		return models.FeedCursor{}
+	case served == 0:
+		// Just before the first upcoming event.
+		first := upcoming[0]
+		return models.FeedCursor{StartTimestamp: first.StartTimestamp, ID: first.ID - 1}
+	default:
+		last := upcoming[served-1]
+		return models.FeedCursor{StartTimestamp: last.StartTimestamp, ID: last.ID}
+	}
+}
+
+// combineFeed merges the given lists without duplicates, favorite-team events
+// first, ordered by start time when ordering is chronological and keeping
+// the list order otherwise.
+func combineFeed(ordering string, teamIDs []int64, limit int, lists ...[]models.SofaScoreEvent) []models.SofaScoreEvent {
+	seen := make(map[uint]bool)
+	var combined []models.SofaScoreEvent
+	for _, list := range lists {
+		for _, e := range list {
+			if !seen[e.ID] {
+				seen[e.ID] = true
+				combined = append(combined, e)
+			}
+		}
+	}
+
+	isFavorite := func(e models.SofaScoreEvent) bool {
+		return slices.Contains(teamIDs, e.HomeTeamId) || slices.Contains(teamIDs, e.AwayTeamId)
+	}
+	slices.SortStableFunc(combined, func(a, b models.SofaScoreEvent) int {
+		if fa, fb := isFavorite(a), isFavorite(b); fa != fb {
+			if fa {
+				return -1
+			}
+			return 1
+		}
+		if ordering == models.FeedOrderChronological {
+			return cmp.Compare(a.StartTimestamp, b.StartTimestamp)
+		}
+		return 0
+	})
+
+	if len(combined) > limit {
+		combined = combined[:limit]
+	}
+	return combined
+}
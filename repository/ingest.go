@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/ingest"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+)
+
+// ingestWaitTimeout bounds how long a request waits for room in a full
+// buffer before it is turned away.
+const ingestWaitTimeout = 5 * time.Second
+
+// deviceSeen records that a device was active at At.
+type deviceSeen struct {
+	DeviceID uint  `json:"device_id"`
+	At       int64 `json:"at"`
+}
+
+// playbackBeat records a heartbeat of a running playback.
+type playbackBeat struct {
+	PlaybackID uint  `json:"playback_id"`
+	At         int64 `json:"at"`
+}
+
+var (
+	playbackStarts *ingest.Buffer[*models.PlaybackLog]
+	playbackBeats  *ingest.Buffer[playbackBeat]
+	devicesSeen    *ingest.Buffer[deviceSeen]
+)
+
+func ingestOptions(name string) ingest.Options {
+	opts := ingest.Options{Name: name, Size: 500, Interval: 250 * time.Millisecond, Capacity: 10000}
+	if v, err := strconv.Atoi(os.Getenv("INGEST_BATCH_SIZE")); err == nil && v > 0 {
+		opts.Size = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("INGEST_FLUSH_EVERY")); err == nil && v > 0 {
+		opts.Interval = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("INGEST_QUEUE_SIZE")); err == nil && v > 0 {
+		opts.Capacity = v
+	}
+	dir := os.Getenv("INGEST_SPOOL_DIR")
+	if dir == "" {
+		dir = "./ingest_spool"
+	}
+	opts.SpoolPath = filepath.Join(dir, name+".jsonl")
+	return opts
+}
+
+// StartIngest starts the buffers batching playback and last-seen writes,
+// writing first what a previous run spooled
+func StartIngest() {
+	playbackStarts = ingest.New(ingestOptions("playback-starts"), writePlaybackStarts)
+	playbackBeats = ingest.New(ingestOptions("playback-heartbeats"), writePlaybackBeats)
+	devicesSeen = ingest.New(ingestOptions("devices-seen"), writeDevicesSeen)
+	playbackStarts.Start()
+	playbackBeats.Start()
+	devicesSeen.Start()
+}
+
+// StopIngest writes the pending items of every buffer, spooling what is
+// left when ctx is done
+func StopIngest(ctx context.Context) error {
+	return errors.Join(
+		playbackStarts.Close(ctx),
+		playbackBeats.Close(ctx),
+		devicesSeen.Close(ctx),
+	)
+}
+
+// StartPlayback opens a playback for the device and waits until its batch
+// is written, returning it with its ID
+func StartPlayback(deviceID uint, content string, startedAt int64, tracked bool) (*models.PlaybackLog, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ingestWaitTimeout)
+	defer cancel()
+	playback := &models.PlaybackLog{DeviceID: deviceID, Content: content, Tracked: tracked, StartedAt: startedAt}
+	if err := playbackStarts.AddWait(ctx, playback); err != nil {
+		return nil, err
+	}
+	return playback, nil
+}
+
+// QueueDeviceSeen records the activity of a device. It refreshes last_seen
+// and keeps its open session and untracked playbacks alive, but only
+// heartbeats start sessions
+func QueueDeviceSeen(deviceID uint, at int64) error {
+	return devicesSeen.Add(deviceSeen{DeviceID: deviceID, At: at})
+}
+
+// caseByID builds "CASE column WHEN ? THEN ? ... END" mapping each ID to its
+// value, along with the IDs in ascending order
+func caseByID(column string, values map[uint]int64) (string, []any, []uint) {
+	ids := make([]uint, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var expr strings.Builder
+	args := make([]any, 0, 2*len(ids))
+	expr.WriteString("CASE " + column)
+	for _, id := range ids {
+		expr.WriteString(" WHEN ? THEN ?")
+		args = append(args, id, values[id])
+	}
+	expr.WriteString(" END")
+	return expr.String(), args, ids
+}
+
+// writePlaybackStarts inserts a batch of playbacks in one statement. The
+// playbacks each device left open are ended first: at the new start when
+// they were still alive, at their last heartbeat when they had gone silent
+// for models.PlaybackTimeout, or models.DeviceOfflineAfter when untracked.
+// Playbacks that started after the new one are left open, so a start
+// replayed from the spool does not end the current playback. Later starts
+// of a device in the same batch end its earlier ones.
+func writePlaybackStarts(ctx context.Context, playbacks []*models.PlaybackLog) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	sorted := slices.Clone(playbacks)
+	slices.SortStableFunc(sorted, func(a, b *models.PlaybackLog) int {
+		if a.DeviceID != b.DeviceID {
+			return cmp.Compare(a.DeviceID, b.DeviceID)
+		}
+		return cmp.Compare(a.StartedAt, b.StartedAt)
+	})
+	firstStart := make(map[uint]int64)
+	for i, playback := range sorted {
+		// Spooled playbacks may carry the ID of a rolled back insert.
+		playback.ID = 0
+		// The start is the only sign of life until the first heartbeat, also
+		// for starts written long after they were queued.
+		playback.LastHeartbeatAt = playback.StartedAt
+		if i+1 < len(sorted) && sorted[i+1].DeviceID == playback.DeviceID {
+			playback.EndedAt = max(sorted[i+1].StartedAt, playback.StartedAt)
+		}
+		if _, ok := firstStart[playback.DeviceID]; !ok {
+			firstStart[playback.DeviceID] = playback.StartedAt
+		}
+	}
+
+	silent := int64(models.PlaybackTimeout / time.Second)
+	offline := int64(models.DeviceOfflineAfter / time.Second)
+	ids := make([]uint, 0, len(firstStart))
+	for id := range firstStart {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	var expr strings.Builder
+	args := make([]any, 0, 5*len(ids))
+	expr.WriteString("CASE device_id")
+	for _, id := range ids {
+		expr.WriteString(" WHEN ? THEN IF(started_at > ?, 0, IF(GREATEST(last_heartbeat_at, started_at) < IF(tracked, ?, ?), GREATEST(last_heartbeat_at, started_at), ?))")
+		args = append(args, id, firstStart[id], firstStart[id]-silent, firstStart[id]-offline, firstStart[id])
+	}
+	expr.WriteString(" END")
+
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.PlaybackLog{}).
+			Where("ended_at = 0 AND device_id IN ?", ids).
+			UpdateColumn("ended_at", gorm.Expr(expr.String(), args...)).Error; err != nil {
+			return err
+		}
+		return tx.Create(&sorted).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, playback := range playbacks {
+		broker.Publish(broker.TopicPlaybackStarted, playback)
+	}
+	return nil
+}
+
+// writePlaybackBeats moves the last heartbeat of the batch's running
+// playbacks forward in one statement
+func writePlaybackBeats(ctx context.Context, beats []playbackBeat) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	latest := make(map[uint]int64, len(beats))
+	for _, beat := range beats {
+		latest[beat.PlaybackID] = max(latest[beat.PlaybackID], beat.At)
+	}
+	expr, args, ids := caseByID("id", latest)
+	return db.WithContext(ctx).Model(&models.PlaybackLog{}).
+		Where("ended_at = 0 AND id IN ?", ids).
+		UpdateColumn("last_heartbeat_at", gorm.Expr("GREATEST(last_heartbeat_at, "+expr+")", args...)).Error
+}
+
+// writeDevicesSeen moves last_seen of the batch's devices forward, and the
+// last heartbeat of their sessions that are still alive and of their open
+// untracked playbacks, in one statement per table
+func writeDevicesSeen(ctx context.Context, seen []deviceSeen) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	latest := make(map[uint]int64, len(seen))
+	for _, s := range seen {
+		latest[s.DeviceID] = max(latest[s.DeviceID], s.At)
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expr, args, ids := caseByID("id", latest)
+		if err := tx.Model(&models.Device{}).
+			Where("id IN ?", ids).
+			UpdateColumn("last_seen", gorm.Expr("GREATEST(last_seen, "+expr+")", args...)).Error; err != nil {
+			return err
+		}
+
+		cutoff := time.Now().Add(-models.DeviceOfflineAfter).Unix()
+		expr, args, ids = caseByID("device_id", latest)
+		if err := tx.Model(&models.DeviceSession{}).
+			Where("ended_at = 0 AND last_heartbeat_at >= ? AND device_id IN ?", cutoff, ids).
+			UpdateColumn("last_heartbeat_at", gorm.Expr("GREATEST(last_heartbeat_at, "+expr+")", args...)).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.PlaybackLog{}).
+			Where("ended_at = 0 AND NOT tracked AND device_id IN ?", ids).
+			UpdateColumn("last_heartbeat_at", gorm.Expr("GREATEST(last_heartbeat_at, "+expr+")", args...)).Error
+	})
+}
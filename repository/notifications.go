@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm/clause"
+)
+
+// defaultNotificationTemplates are seeded the first time the service starts
+var defaultNotificationTemplates = []models.NotificationTemplate{
+	{Kind: models.NotificationKickoffReminder, Title: "{home} vs {away}", Body: "Kicks off in {minutes} minutes ({tournament})"},
+	{Kind: models.NotificationKickoff, Title: "{home} vs {away}", Body: "The match has started ({tournament})"},
+	{Kind: models.NotificationGoal, Title: "Goal! {home} {home_score} - {away_score} {away}", Body: "{tournament}"},
+	{Kind: models.NotificationFinalScore, Title: "Full time: {home} {home_score} - {away_score} {away}", Body: "{tournament}"},
+}
+
+// SeedNotificationTemplates creates the notification templates that do not exist yet
+func SeedNotificationTemplates() error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	for _, template := range defaultNotificationTemplates {
+		if err := db.Where("kind = ?", template.Kind).FirstOrCreate(&template).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetNotificationTemplates retrieves every notification template
+func GetNotificationTemplates() ([]models.NotificationTemplate, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var templates []models.NotificationTemplate
+	result := db.Order("id ASC").Find(&templates)
+	return templates, result.Error
+}
+
+// GetNotificationTemplate retrieves the template of a notification kind
+func GetNotificationTemplate(kind string) (*models.NotificationTemplate, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var template models.NotificationTemplate
+	result := db.Where("kind = ?", kind).First(&template)
+	return &template, result.Error
+}
+
+// UpdateNotificationTemplate updates the title and body of a notification kind
+func UpdateNotificationTemplate(kind, title, body string) (*models.NotificationTemplate, error) {
+	template, err := GetNotificationTemplate(kind)
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	template.Title = title
+	template.Body = body
+	return template, db.Save(template).Error
+}
+
+// GetNotificationSubscriptions retrieves the subscriptions of a device
+func GetNotificationSubscriptions(deviceID uint) ([]models.NotificationSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []models.NotificationSubscription
+	result := db.Where("device_id = ?", deviceID).Order("id ASC").Find(&subscriptions)
+	return subscriptions, result.Error
+}
+
+// CreateNotificationSubscription subscribes a device to a team or event, returning the existing subscription if any
+func CreateNotificationSubscription(deviceID uint, targetType string, targetID int64) (*models.NotificationSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	subscription := models.NotificationSubscription{DeviceID: deviceID, TargetType: targetType, TargetID: targetID}
+	result := db.Where(&subscription).FirstOrCreate(&subscription)
+	return &subscription, result.Error
+}
+
+// DeleteNotificationSubscription removes a device subscription
+func DeleteNotificationSubscription(deviceID uint, targetType string, targetID int64) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Unscoped().
+		Where("device_id = ? AND target_type = ? AND target_id = ?", deviceID, targetType, targetID).
+		Delete(&models.NotificationSubscription{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetNotificationRecipients retrieves the devices subscribed to an event or to either of its teams,
+// skipping devices whose push token was rejected
+func GetNotificationRecipients(event *models.SofaScoreEvent) ([]models.Device, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	teamIDs := []int64{event.HomeTeamId, event.AwayTeamId}
+	var devices []models.Device
+	result := db.Where("push_disabled_at = 0 AND id IN (?)", db.Model(&models.NotificationSubscription{}).
+		Select("device_id").
+		Where("(target_type = ? AND target_id IN ?) OR (target_type = ? AND target_id = ?)",
+			models.NotificationTargetTeam, teamIDs,
+			models.NotificationTargetEvent, event.SofaScoreEventId)).
+		Find(&devices)
+	return devices, result.Error
+}
+
+// GetEventWithTeams retrieves an event by its SofaScore ID with its teams and league
+func GetEventWithTeams(sofaScoreEventID int64) (*models.SofaScoreEvent, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var event models.SofaScoreEvent
+	result := db.Preload("HomeTeamModel").Preload("AwayTeamModel").Preload("League").
+		Where("sofa_score_event_id = ?", sofaScoreEventID).First(&event)
+	return &event, result.Error
+}
+
+// GetEventsStartingBetween retrieves the not started events whose start timestamp is in [from, to)
+func GetEventsStartingBetween(from, to int64) ([]models.SofaScoreEvent, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var events []models.SofaScoreEvent
+	result := db.Preload("HomeTeamModel").Preload("AwayTeamModel").Preload("League").
+		Where("start_timestamp >= ? AND start_timestamp < ? AND status_type = ?", from, to, "notstarted").
+		Find(&events)
+	return events, result.Error
+}
+
+// ClaimNotification stores a notification log entry and reports whether it
+// is new. An existing entry with the same DedupKey means the notification
+// was already sent.
+func ClaimNotification(entry *models.NotificationLog) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Omit("Device").Create(entry)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DisableDevicePush stops notifications to a device whose push token the
+// provider no longer accepts
+func DisableDevicePush(deviceID uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Model(&models.Device{}).Where("id = ?", deviceID).UpdateColumn("push_disabled_at", time.Now().Unix()).Error
+}
+
+// SaveNotificationLog updates a notification log entry
+func SaveNotificationLog(entry *models.NotificationLog) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Omit("Device").Save(entry).Error
+}
+
+// GetNotificationLogs retrieves the notification log of the devices paired
+// to managerID, or of all devices when managerID is nil, with pagination
+func GetNotificationLogs(managerID *uint, deviceID uint, status string, page, limit int) ([]models.NotificationLog, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := db.Model(&models.NotificationLog{})
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", managedDeviceIDs(db, *managerID))
+	}
+	if deviceID != 0 {
+		query = query.Where("device_id = ?", deviceID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []models.NotificationLog
+	result := query.Preload("Device").Order("id DESC").Offset((page - 1) * limit).Limit(limit).Find(&entries)
+	return entries, total, result.Error
+}
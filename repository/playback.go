@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
 )
 
 type EventStats struct {
@@ -10,28 +13,80 @@ type EventStats struct {
 	ViewCount        int64
 }
 
-func LogPlayback(deviceID uint, content string, startedAt int64) (*models.PlaybackLog, error) {
+// HeartbeatPlayback keeps a running playback of the device open. The new
+// heartbeat is queued and written with the next batch
+func HeartbeatPlayback(deviceID, id uint) (*models.PlaybackLog, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var playback models.PlaybackLog
+	if err := db.Where("device_id = ?", deviceID).First(&playback, id).Error; err != nil {
+		return nil, err
+	}
+	if !playback.Open() {
+		return nil, models.ErrPlaybackClosed
+	}
+
+	now := time.Now().Unix()
+	if err := playbackBeats.Add(playbackBeat{PlaybackID: id, At: now}); err != nil {
+		return nil, err
+	}
+	playback.LastHeartbeatAt = now
+	return &playback, nil
+}
+
+// StopPlayback ends a running playback of the device at endedAt, kept
+// between its start and now
+func StopPlayback(deviceID, id uint, endedAt int64) (*models.PlaybackLog, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, err
 	}
-	var lastLog models.PlaybackLog
-	db.Where("device_id=?", deviceID).Order("started_at DESC").First(&lastLog)
-	if lastLog.ID != 0 {
-		db.Model(&lastLog).Where("id = ?", lastLog.ID).Update("ended_at", startedAt)
+	var playback models.PlaybackLog
+	if err := db.Where("device_id = ?", deviceID).First(&playback, id).Error; err != nil {
+		return nil, err
 	}
 
-	log := &models.PlaybackLog{DeviceID: deviceID, Content: content, StartedAt: startedAt}
-	result := db.Create(log)
-	return log, result.Error
+	endedAt = min(max(endedAt, playback.StartedAt), time.Now().Unix())
+	result := db.Model(&models.PlaybackLog{}).
+		Where("id = ? AND ended_at = 0", id).
+		Update("ended_at", endedAt)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, models.ErrPlaybackClosed
+	}
+	playback.EndedAt = endedAt
+	return &playback, nil
 }
 
-func UpdatePlaybackEnd(id uint, endedAt int64) error {
+// CloseStalePlaybacks ends at their last heartbeat the tracked playbacks
+// without a heartbeat since cutoff and the untracked ones whose device has
+// not been seen since offlineCutoff, and returns how many were closed
+func CloseStalePlaybacks(cutoff, offlineCutoff int64) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	result := db.Model(&models.PlaybackLog{}).
+		Where("ended_at = 0 AND ((tracked AND last_heartbeat_at < ?) OR (NOT tracked AND last_heartbeat_at < ?))", cutoff, offlineCutoff).
+		Update("ended_at", gorm.Expr("GREATEST(last_heartbeat_at, started_at)"))
+	return result.RowsAffected, result.Error
+}
+
+// BackfillPlaybackHeartbeats gives the playbacks stored before heartbeats
+// were recorded their start as last heartbeat, so the sweeper does not end
+// them at the epoch
+func BackfillPlaybackHeartbeats() error {
 	db, err := database.GetDB()
 	if err != nil {
 		return err
 	}
-	return db.Model(&models.PlaybackLog{}).Where("id = ?", id).Update("ended_at", endedAt).Error
+	return db.Model(&models.PlaybackLog{}).
+		Where("last_heartbeat_at = 0").
+		UpdateColumn("last_heartbeat_at", gorm.Expr("started_at")).Error
 }
 
 func GetTopEvents(limit int) ([]EventStats, error) {
@@ -49,7 +104,9 @@ func GetTopEvents(limit int) ([]EventStats, error) {
 	return stats, result.Error
 }
 
-func GetList(page, limit int) ([]*models.PlaybackLog, error) {
+// GetList pages through the playbacks of the devices paired to managerID, or
+// of all devices when managerID is nil
+func GetList(managerID *uint, page, limit int) ([]*models.PlaybackLog, error) {
 	db, err := database.GetDB()
 	if err != nil {
 		return nil, err
@@ -57,17 +114,25 @@ func GetList(page, limit int) ([]*models.PlaybackLog, error) {
 
 	offset := (page - 1) * limit
 	var stats []*models.PlaybackLog
-	result := db.Offset(offset).Limit(limit).Order("created_at DESC").Find(&stats)
+	result := scopePlaybacks(db, managerID).Offset(offset).Limit(limit).Order("created_at DESC").Find(&stats)
 	return stats, result.Error
 }
 
-func TotalCount() int64 {
+// TotalCount counts the playbacks GetList pages through
+func TotalCount(managerID *uint) int64 {
 	db, err := database.GetDB()
 	if err != nil {
 		return 0
 	}
 
 	var count int64
-	_ = db.Model(&models.PlaybackLog{}).Count(&count)
+	_ = scopePlaybacks(db, managerID).Model(&models.PlaybackLog{}).Count(&count)
 	return count
 }
+
+func scopePlaybacks(db *gorm.DB, managerID *uint) *gorm.DB {
+	if managerID == nil {
+		return db
+	}
+	return db.Where("device_id IN (?)", managedDeviceIDs(db, *managerID))
+}
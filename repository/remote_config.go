@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm/clause"
+)
+
+func GetConfigKeys() ([]models.ConfigKey, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var keys []models.ConfigKey
+	result := db.Order("`key`").Find(&keys)
+	return keys, result.Error
+}
+
+func GetConfigKey(id uint) (*models.ConfigKey, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var key models.ConfigKey
+	if err := db.First(&key, id).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func GetConfigKeyByName(name string) (*models.ConfigKey, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var key models.ConfigKey
+	if err := db.Where("`key` = ?", name).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func CreateConfigKey(key *models.ConfigKey) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Create(key).Error
+}
+
+// UpdateConfigKey changes the type, default and description of a key. The
+// key name is immutable because apps look values up by it
+func UpdateConfigKey(id uint, values models.ConfigKey) (*models.ConfigKey, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var key models.ConfigKey
+	if err := db.First(&key, id).Error; err != nil {
+		return nil, err
+	}
+	key.Type = values.Type
+	key.DefaultValue = values.DefaultValue
+	key.Description = values.Description
+	if err := db.Save(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// DeleteConfigKey removes a key with all of its values
+func DeleteConfigKey(id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+
+	tx := db.Begin()
+	if err := tx.Unscoped().Where("config_key_id = ?", id).Delete(&models.ConfigValue{}).Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	result := tx.Unscoped().Delete(&models.ConfigKey{}, id)
+	if result.Error != nil {
+		tx.Rollback()
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, tx.Commit().Error
+}
+
+// GetConfigValues returns the overrides, optionally filtered by key and
+// scope
+func GetConfigValues(keyID uint, scope string) ([]models.ConfigValue, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	query := db.Preload("ConfigKey")
+	if keyID != 0 {
+		query = query.Where("config_key_id = ?", keyID)
+	}
+	if scope != "" {
+		query = query.Where("scope = ?", scope)
+	}
+	var values []models.ConfigValue
+	result := query.Order("config_key_id, scope, scope_id").Find(&values)
+	return values, result.Error
+}
+
+func GetConfigValue(id uint) (*models.ConfigValue, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var value models.ConfigValue
+	if err := db.Preload("ConfigKey").First(&value, id).Error; err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// SetConfigValue creates or replaces the override of a key for a scope
+func SetConfigValue(key *models.ConfigKey, scope, scopeID, value string) (*models.ConfigValue, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	configValue := &models.ConfigValue{ConfigKeyID: key.ID, Scope: scope, ScopeID: scopeID, Value: value}
+	result := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "config_key_id"}, {Name: "scope"}, {Name: "scope_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at", "deleted_at"}),
+	}).Create(configValue)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := db.Where("config_key_id = ? AND scope = ? AND scope_id = ?", key.ID, scope, scopeID).First(configValue).Error; err != nil {
+		return nil, err
+	}
+	configValue.ConfigKey = key
+	return configValue, nil
+}
+
+func DeleteConfigValue(id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Unscoped().Delete(&models.ConfigValue{}, id)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetDeviceConfig resolves the remote configuration of a device
+func GetDeviceConfig(device models.Device) (*models.ConfigBundle, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []models.ConfigKey
+	if err := db.Find(&keys).Error; err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := GetDeviceGroupIDs(device)
+	if err != nil {
+		return nil, err
+	}
+	groupScopeIDs := make([]string, len(groupIDs))
+	for i, id := range groupIDs {
+		groupScopeIDs[i] = strconv.FormatUint(uint64(id), 10)
+	}
+
+	query := db.Where("scope = ?", models.ConfigScopeGlobal).
+		Or("scope = ? AND scope_id = ?", models.ConfigScopeDevice, strconv.FormatUint(uint64(device.ID), 10))
+	if device.PackageName != "" {
+		query = query.Or("scope = ? AND scope_id = ?", models.ConfigScopePackage, device.PackageName)
+	}
+	if len(groupScopeIDs) > 0 {
+		query = query.Or("scope = ? AND scope_id IN ?", models.ConfigScopeGroup, groupScopeIDs)
+	}
+	var values []models.ConfigValue
+	if err := query.Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	bundle := models.ResolveConfig(keys, values, device, groupIDs)
+	return &bundle, nil
+}
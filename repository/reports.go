@@ -5,13 +5,13 @@ import (
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 )
 
-func SaveCrashReport(report models.CrashReport) error {
+func SaveCrashReport(report *models.CrashReport) error {
 	db, err := database.GetDB()
 	if err != nil {
 		return err
 	}
 
-	if err := db.Create(&report).Error; err != nil {
+	if err := db.Create(report).Error; err != nil {
 		return err
 	}
 
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"os"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+)
+
+func GetPlans() ([]models.Plan, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var plans []models.Plan
+	result := db.Order("name").Find(&plans)
+	return plans, result.Error
+}
+
+func GetPlan(id uint) (*models.Plan, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var plan models.Plan
+	if err := db.First(&plan, id).Error; err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func CreatePlan(plan *models.Plan) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Create(plan).Error
+}
+
+// UpdatePlan overwrites the features of a plan. Existing subscriptions pick
+// them up immediately; their expiry is not recalculated
+func UpdatePlan(id uint, values models.Plan) (*models.Plan, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var plan models.Plan
+	if err := db.First(&plan, id).Error; err != nil {
+		return nil, err
+	}
+	plan.Name = values.Name
+	plan.DurationDays = values.DurationDays
+	plan.MaxTournaments = values.MaxTournaments
+	plan.LiveUpdates = values.LiveUpdates
+	if err := db.Save(&plan).Error; err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// PlanInUse reports whether any device subscription references the plan
+func PlanInUse(id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	var count int64
+	err = db.Model(&models.DeviceSubscription{}).Where("plan_id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
+func DeletePlan(id uint) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	result := db.Unscoped().Delete(&models.Plan{}, id)
+	return result.RowsAffected > 0, result.Error
+}
+
+// GetDeviceSubscription returns the subscription of a device with its plan,
+// or nil when the device never had one
+func GetDeviceSubscription(deviceID uint) (*models.DeviceSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []models.DeviceSubscription
+	if err := db.Where("device_id = ?", deviceID).Preload("Plan").Limit(1).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+	return &subscriptions[0], nil
+}
+
+// GetDefaultPlan returns the plan named by DEFAULT_PLAN, which applies to
+// devices without a subscription, or nil when none is configured
+func GetDefaultPlan() (*models.Plan, error) {
+	name := os.Getenv("DEFAULT_PLAN")
+	if name == "" {
+		return nil, nil
+	}
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var plans []models.Plan
+	if err := db.Where("name = ?", name).Limit(1).Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	if len(plans) == 0 {
+		return nil, nil
+	}
+	return &plans[0], nil
+}
+
+// GetDevicePlan returns the plan that limits a device: the plan of its
+// subscription, else the default plan. Nil means no limits
+func GetDevicePlan(deviceID uint) (*models.Plan, error) {
+	subscription, err := GetDeviceSubscription(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription != nil {
+		return subscription.Plan, nil
+	}
+	return GetDefaultPlan()
+}
+
+// GetDeviceSubscriptions pages through the subscriptions of the devices
+// paired to managerID (all devices when nil). With expiringWithin > 0 only
+// active subscriptions ending within that period are returned, soonest
+// first
+func GetDeviceSubscriptions(managerID *uint, expiringWithin time.Duration, page, limit uint) ([]models.DeviceSubscription, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+	query := db.Model(&models.DeviceSubscription{})
+	if managerID != nil {
+		query = query.Where("device_id IN (?)", db.Model(&models.Device{}).Select("id").Where("user_id = ?", *managerID))
+	}
+	order := "id DESC"
+	if expiringWithin > 0 {
+		now := time.Now()
+		query = query.Where("expires_at > ? AND expires_at <= ?", now.Unix(), now.Add(expiringWithin).Unix())
+		order = "expires_at"
+	}
+
+	var subscriptions []models.DeviceSubscription
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * limit
+	result := query.Session(&gorm.Session{}).
+		Preload("Device").
+		Preload("Plan").
+		Order(order).
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Find(&subscriptions)
+	return subscriptions, total, result.Error
+}
+
+// RenewDeviceSubscription puts the device on plan for another plan period.
+// The period starts when the current one ends, or now if it already ended
+func RenewDeviceSubscription(deviceID uint, plan *models.Plan) (*models.DeviceSubscription, error) {
+	return saveDeviceSubscription(deviceID, plan.ID, time.Duration(plan.DurationDays)*24*time.Hour)
+}
+
+// ExtendDeviceSubscription adds days to a subscription without changing its
+// plan
+func ExtendDeviceSubscription(subscription *models.DeviceSubscription, days int) (*models.DeviceSubscription, error) {
+	return saveDeviceSubscription(subscription.DeviceID, subscription.PlanID, time.Duration(days)*24*time.Hour)
+}
+
+func saveDeviceSubscription(deviceID, planID uint, period time.Duration) (*models.DeviceSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	subscription := models.DeviceSubscription{DeviceID: deviceID}
+	if err := db.Where("device_id = ?", deviceID).Limit(1).Find(&subscription).Error; err != nil {
+		return nil, err
+	}
+	if !subscription.Active(now) {
+		subscription.StartsAt = now.Unix()
+		subscription.ExpiresAt = now.Unix()
+	}
+	subscription.PlanID = planID
+	subscription.ExpiresAt = time.Unix(subscription.ExpiresAt, 0).Add(period).Unix()
+	subscription.ExpiryFlaggedAt = 0
+	subscription.Plan = nil
+	if err := db.Save(&subscription).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Preload("Device").Preload("Plan").First(&subscription, subscription.ID).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// FlagExpiringSubscriptions marks the active subscriptions ending within
+// lead that were not flagged yet and returns them
+func FlagExpiringSubscriptions(lead time.Duration) ([]models.DeviceSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var subscriptions []models.DeviceSubscription
+	if err := db.Where("expiry_flagged_at = 0 AND expires_at > ? AND expires_at <= ?", now.Unix(), now.Add(lead).Unix()).
+		Preload("Device").
+		Preload("Plan").
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uint, len(subscriptions))
+	for i := range subscriptions {
+		ids[i] = subscriptions[i].ID
+		subscriptions[i].ExpiryFlaggedAt = now.Unix()
+	}
+	if err := db.Model(&models.DeviceSubscription{}).Where("id IN ?", ids).Update("expiry_flagged_at", now.Unix()).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// CountDeviceTournaments returns how many tournaments are assigned to a
+// device
+func CountDeviceTournaments(deviceID uint) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = db.Model(&models.DeviceTournament{}).Where("device_id = ?", deviceID).Count(&count).Error
+	return count, err
+}
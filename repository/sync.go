@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+)
+
+const (
+	syncWindowPast  = 24 * time.Hour
+	syncWindowAhead = 8 * 24 * time.Hour
+)
+
+// GetSyncChanges returns the events of the device tournaments starting within
+// the sync window that changed after cursor, at most limit at a time, along
+// with the teams and tournaments the client needs to render them and the
+// events deleted since the cursor. When the device tournaments changed since
+// the cursor every event of the window is sent again, since the client never
+// received the older events of the tournaments that were added.
+func GetSyncChanges(devId uint, cursor models.SyncCursor, limit int) (*models.SyncChanges, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tournamentIDs, err := GetDeviceTournamentIDs(devId)
+	if err != nil {
+		return nil, err
+	}
+	setKey := models.TournamentSetKey(tournamentIDs)
+	changes := &models.SyncChanges{TournamentIDs: tournamentIDs, Cursor: cursor}
+	changes.Cursor.Tournaments = setKey
+	if len(tournamentIDs) == 0 {
+		return changes, nil
+	}
+
+	// deletedSince stays at the client cursor so tombstones are not lost when
+	// the events restart from the beginning.
+	deletedSince := cursor.Time()
+	if cursor.Tournaments != setKey {
+		cursor = models.SyncCursor{}
+	}
+
+	now := time.Now()
+	since := cursor.Time()
+	window := db.Model(&models.SofaScoreEvent{}).
+		Where("league_id IN ? AND start_timestamp >= ? AND start_timestamp < ?", tournamentIDs, now.Add(-syncWindowPast).Unix(), now.Add(syncWindowAhead).Unix())
+
+	var events []models.SofaScoreEvent
+	if err := window.Session(&gorm.Session{}).
+		Where("updated_at > ? OR (updated_at = ? AND id > ?)", since, since, cursor.ID).
+		Order("updated_at ASC, id ASC").
+		Limit(limit + 1).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	if len(events) > limit {
+		events = events[:limit]
+		changes.HasMore = true
+	}
+	changes.Events = events
+
+	teamIDs := make([]int64, 0, len(events)*2)
+	leagueIDs := make([]uint, 0, len(events))
+	for _, e := range events {
+		teamIDs = append(teamIDs, e.HomeTeamId, e.AwayTeamId)
+		leagueIDs = append(leagueIDs, e.LeagueId)
+	}
+
+	teamQuery := db.Where("team_id IN (?) OR team_id IN (?)",
+		window.Session(&gorm.Session{}).Select("home_team_id"),
+		window.Session(&gorm.Session{}).Select("away_team_id"))
+	if !cursor.IsZero() {
+		teamQuery = teamQuery.Where("updated_at > ?", since)
+	}
+	if len(teamIDs) > 0 {
+		teamQuery = db.Where(teamQuery).Or("team_id IN ?", teamIDs)
+	}
+	if err := teamQuery.Find(&changes.Teams).Error; err != nil {
+		return nil, err
+	}
+
+	tournamentQuery := db.Where("id IN ? AND updated_at > ?", tournamentIDs, since)
+	if len(leagueIDs) > 0 {
+		tournamentQuery = tournamentQuery.Or("id IN ?", leagueIDs)
+	}
+	if err := tournamentQuery.Find(&changes.Tournaments).Error; err != nil {
+		return nil, err
+	}
+
+	var deleted []models.SofaScoreEvent
+	if deletedSince.UnixMilli() > 0 {
+		if err := db.Unscoped().Select("sofa_score_event_id", "deleted_at").
+			Where("league_id IN ? AND deleted_at > ?", tournamentIDs, deletedSince).
+			Find(&deleted).Error; err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range deleted {
+		changes.DeletedEventIDs = append(changes.DeletedEventIDs, e.SofaScoreEventId)
+	}
+
+	if changes.HasMore {
+		last := events[len(events)-1]
+		changes.Cursor = models.SyncCursor{UpdatedAt: last.UpdatedAt.UnixMilli(), ID: last.ID, Tournaments: setKey}
+		return changes, nil
+	}
+	for _, e := range events {
+		if millis := e.UpdatedAt.UnixMilli(); millis > changes.Cursor.UpdatedAt || (millis == changes.Cursor.UpdatedAt && e.ID > changes.Cursor.ID) {
+			changes.Cursor = models.SyncCursor{UpdatedAt: millis, ID: e.ID, Tournaments: setKey}
+		}
+	}
+	for _, t := range changes.Teams {
+		changes.Cursor.Advance(t.UpdatedAt)
+	}
+	for _, t := range changes.Tournaments {
+		changes.Cursor.Advance(t.UpdatedAt)
+	}
+	for _, e := range deleted {
+		changes.Cursor.Advance(e.DeletedAt.Time)
+	}
+	return changes, nil
+}
@@ -65,3 +65,17 @@ func DeleteTournament(id uint) error {
 	}
 	return db.Delete(&models.Tournament{}, id).Error
 }
+
+// CountTournaments returns how many of ids are existing tournaments
+func CountTournaments(ids []uint) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	var count int64
+	result := db.Model(&models.Tournament{}).Where("id IN ?", ids).Count(&count)
+	return count, result.Error
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"log"
+	"strconv"
+	"sync"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/locale"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultStatusTranslations are the SofaScore status descriptions, keyed by
+// status code, for the markets the app is distributed in and for English,
+// which is the fallback of every other locale
+var defaultStatusTranslations = map[string]map[int]string{
+	"en": {0: "Not started", 6: "1st half", 7: "2nd half", 31: "Halftime", 41: "1st extra time", 42: "2nd extra time", 50: "Penalties", 60: "Postponed", 70: "Canceled", 100: "Ended", 110: "AET", 120: "AP"},
+	"es": {0: "No iniciado", 6: "1er tiempo", 7: "2do tiempo", 31: "Descanso", 41: "1er tiempo extra", 42: "2do tiempo extra", 50: "Penales", 60: "Aplazado", 70: "Cancelado", 100: "Finalizado", 110: "Tras prórroga", 120: "Tras penales"},
+	"pt": {0: "Não iniciado", 6: "1º tempo", 7: "2º tempo", 31: "Intervalo", 41: "1º tempo extra", 42: "2º tempo extra", 50: "Pênaltis", 60: "Adiado", 70: "Cancelado", 100: "Encerrado", 110: "Após prorrogação", 120: "Após pênaltis"},
+}
+
+// savedTranslations remembers the scraped translations already stored so
+// every scrape does not rewrite them
+var savedTranslations sync.Map
+
+// SeedTranslations stores the default status translations that do not exist yet
+func SeedTranslations() error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	var translations []models.Translation
+	for loc, statuses := range defaultStatusTranslations {
+		for code, name := range statuses {
+			translations = append(translations, models.Translation{
+				EntityType: models.TranslationStatus,
+				EntityKey:  strconv.Itoa(code),
+				Locale:     loc,
+				Name:       name,
+				Source:     models.TranslationSourceDefault,
+			})
+		}
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&translations).Error
+}
+
+// saveScrapedTranslations stores the names scraped from SofaScore, keeping
+// the translations edited by admins
+func saveScrapedTranslations(db *gorm.DB, scraped []models.Translation) {
+	translations := make([]models.Translation, 0, len(scraped))
+	for _, t := range scraped {
+		t.Locale = locale.Normalize(t.Locale)
+		if t.Locale == "" {
+			continue
+		}
+		key := t.EntityType + ":" + t.EntityKey + ":" + t.Locale
+		if name, ok := savedTranslations.Load(key); ok && name == t.Name {
+			continue
+		}
+		translations = append(translations, t)
+	}
+	if len(translations) == 0 {
+		return
+	}
+
+	if err := db.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"name":       gorm.Expr("IF(source = ?, name, VALUES(name))", models.TranslationSourceAdmin),
+			"source":     gorm.Expr("IF(source = ?, source, VALUES(source))", models.TranslationSourceAdmin),
+			"updated_at": gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).CreateInBatches(&translations, 200).Error; err != nil {
+		log.Printf("repository: failed to save translations: %v", err)
+		return
+	}
+	for _, t := range translations {
+		savedTranslations.Store(t.EntityType+":"+t.EntityKey+":"+t.Locale, t.Name)
+	}
+}
+
+// GetTranslations retrieves translations with optional filters and pagination
+func GetTranslations(entityType, entityKey, loc string, page, limit int) ([]models.Translation, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := db.Model(&models.Translation{})
+	if entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+	if entityKey != "" {
+		query = query.Where("entity_key = ?", entityKey)
+	}
+	if loc != "" {
+		query = query.Where("locale = ?", loc)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var translations []models.Translation
+	result := query.Order("entity_type ASC, entity_key ASC, locale ASC").Offset((page - 1) * limit).Limit(limit).Find(&translations)
+	return translations, total, result.Error
+}
+
+// SaveAdminTranslation creates or overrides a translation
+func SaveAdminTranslation(entityType, entityKey, loc, name string) (*models.Translation, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	translation := models.Translation{EntityType: entityType, EntityKey: entityKey, Locale: loc}
+	if err := db.Where(&translation).Limit(1).Find(&translation).Error; err != nil {
+		return nil, err
+	}
+	translation.Name = name
+	translation.Source = models.TranslationSourceAdmin
+	result := db.Save(&translation)
+	savedTranslations.Delete(entityType + ":" + entityKey + ":" + loc)
+	return &translation, result.Error
+}
+
+// DeleteTranslation removes a translation so the scraped or stored name is used again
+func DeleteTranslation(id uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	var translation models.Translation
+	if err := db.First(&translation, id).Error; err != nil {
+		return err
+	}
+	savedTranslations.Delete(translation.EntityType + ":" + translation.EntityKey + ":" + translation.Locale)
+	return db.Unscoped().Delete(&translation).Error
+}
+
+// LookupTranslations returns the names of the given teams, tournaments and
+// statuses in the first of locales that has a translation for each of them
+func LookupTranslations(locales []string, teamIDs []int64, tournamentIDs []uint, statusCodes []int) (models.TranslationSet, error) {
+	set := models.TranslationSet{}
+	if len(locales) == 0 {
+		return set, nil
+	}
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := db.Where("1 = 0")
+	if len(teamIDs) > 0 {
+		keys := make([]string, 0, len(teamIDs))
+		for _, id := range teamIDs {
+			keys = append(keys, strconv.FormatInt(id, 10))
+		}
+		conditions = conditions.Or("entity_type = ? AND entity_key IN ?", models.TranslationTeam, keys)
+	}
+	if len(tournamentIDs) > 0 {
+		keys := make([]string, 0, len(tournamentIDs))
+		for _, id := range tournamentIDs {
+			keys = append(keys, strconv.FormatUint(uint64(id), 10))
+		}
+		conditions = conditions.Or("entity_type = ? AND entity_key IN ?", models.TranslationTournament, keys)
+	}
+	if len(statusCodes) > 0 {
+		keys := make([]string, 0, len(statusCodes))
+		for _, code := range statusCodes {
+			keys = append(keys, strconv.Itoa(code))
+		}
+		conditions = conditions.Or("entity_type = ? AND entity_key IN ?", models.TranslationStatus, keys)
+	}
+
+	var translations []models.Translation
+	if err := db.Where("locale IN ?", locales).Where(conditions).Find(&translations).Error; err != nil {
+		return nil, err
+	}
+
+	rank := make(map[string]int, len(locales))
+	for i, loc := range locales {
+		rank[loc] = i
+	}
+	best := make(map[string]int)
+	for _, t := range translations {
+		key := t.EntityType + ":" + t.EntityKey
+		if r, ok := best[key]; !ok || rank[t.Locale] < r {
+			best[key] = rank[t.Locale]
+			set[key] = t.Name
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+// SaveTrendingEvents persists the events of a country's trending list and
+// replaces the previous ranking for that country.
+func SaveTrendingEvents(countryCode string, events []*models.APIEvent) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	if err := SaveSofaScoreEvent(events, ""); err != nil {
+		return err
+	}
+
+	countryCode = strings.ToUpper(countryCode)
+	fetchedAt := time.Now().Unix()
+	tx := db.Begin()
+	if err := tx.Where("country_code = ?", countryCode).Unscoped().Delete(&models.EventTrending{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for i, event := range events {
+		trending := &models.EventTrending{
+			CountryCode:      countryCode,
+			SofaScoreEventId: event.ID,
+			Rank:             i + 1,
+			FetchedAt:        fetchedAt,
+		}
+		if err := tx.Create(trending).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// GetTrendingEvents returns the trending events of a country ordered by rank.
+func GetTrendingEvents(countryCode string, limit int) ([]models.EventTrending, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var trending []models.EventTrending
+	result := db.Where("country_code = ?", strings.ToUpper(countryCode)).
+		Order("`rank` ASC").
+		Limit(limit).
+		Preload("Event.HomeTeamModel").
+		Preload("Event.AwayTeamModel").
+		Preload("Event.League").
+		Find(&trending)
+	return trending, result.Error
+}
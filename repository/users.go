@@ -17,11 +17,42 @@ func CreateUser(email, password string) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	user := &models.User{Email: email, Password: string(hash)}
+	user := &models.User{Email: email, Password: string(hash), Role: models.UserRoleManager}
+
+	// The first account of an installation administers every device.
+	var total int64
+	if err := db.Model(&models.User{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		user.Role = models.UserRoleAdmin
+	}
+
 	result := db.Create(user)
 	return user, result.Error
 }
 
+// EnsureAdminUser promotes the oldest user when no admin exists, so
+// installations that predate roles keep seeing all of their devices
+func EnsureAdminUser() error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	var admins int64
+	if err := db.Model(&models.User{}).Where("role = ?", models.UserRoleAdmin).Count(&admins).Error; err != nil {
+		return err
+	}
+	if admins > 0 {
+		return nil
+	}
+	var user models.User
+	if err := db.Order("id").Limit(1).Find(&user).Error; err != nil || user.ID == 0 {
+		return err
+	}
+	return db.Model(&user).Update("role", models.UserRoleAdmin).Error
+}
+
 func GetUserByEmail(email string) (*models.User, error) {
 	db, err := database.GetDB()
 	if err != nil {
@@ -99,3 +130,60 @@ func RevokeAllRefreshTokens(userID uint) error {
 func CheckPassword(user *models.User, password string) bool {
 	return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) == nil
 }
+
+// GetUsers returns every user with the number of devices paired to each
+func GetUsers() ([]models.User, map[uint]int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	var users []models.User
+	if err := db.Order("id").Find(&users).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var rows []struct {
+		UserID uint
+		Total  int64
+	}
+	if err := db.Model(&models.Device{}).
+		Select("user_id, COUNT(*) AS total").
+		Where("user_id IS NOT NULL").
+		Group("user_id").
+		Scan(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Total
+	}
+	return users, counts, nil
+}
+
+// SetUserDeviceQuota limits the devices that can be paired to a user; zero
+// removes the limit
+func SetUserDeviceQuota(id uint, quota int) (*models.User, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var user models.User
+	if err := db.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	user.DeviceQuota = quota
+	if err := db.Model(&user).Update("device_quota", quota).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func CountUserDevices(id uint) (int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = db.Model(&models.Device{}).Where("user_id = ?", id).Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/database"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/webhook"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"gorm.io/gorm"
+)
+
+const (
+	webhookMaxAttempts = 8
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = 6 * time.Hour
+	// webhookClaimLease keeps a claimed delivery away from other senders
+	// while it is being sent.
+	webhookClaimLease = time.Minute
+)
+
+// GetWebhookSubscriptions retrieves every webhook subscription
+func GetWebhookSubscriptions() ([]models.WebhookSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []models.WebhookSubscription
+	result := db.Order("id ASC").Find(&subscriptions)
+	return subscriptions, result.Error
+}
+
+// GetWebhookSubscriptionByID retrieves a webhook subscription by ID
+func GetWebhookSubscriptionByID(id uint) (*models.WebhookSubscription, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var subscription models.WebhookSubscription
+	result := db.First(&subscription, id)
+	return &subscription, result.Error
+}
+
+// SaveWebhookSubscription creates or updates a webhook subscription
+func SaveWebhookSubscription(subscription *models.WebhookSubscription) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	return db.Save(subscription).Error
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription and its pending deliveries
+func DeleteWebhookSubscription(id uint) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+	if err := db.Where("subscription_id = ? AND status = ?", id, models.WebhookStatusPending).Delete(&models.WebhookDelivery{}).Error; err != nil {
+		return err
+	}
+	return db.Delete(&models.WebhookSubscription{}, id).Error
+}
+
+// enqueueMatchWebhooks queues the webhook deliveries for the match
+// transitions of event. It runs in the transaction that saves the event so a
+// persisted change never misses its deliveries.
+func enqueueMatchWebhooks(tx *gorm.DB, subscriptions []models.WebhookSubscription, transitions []string, event models.SofaScoreEvent) error {
+	for _, transition := range transitions {
+		if err := queueWebhookEvent(tx, subscriptions, models.WebhookEventTypes[transition], event.LeagueId, matchData(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func queueWebhookEvent(tx *gorm.DB, subscriptions []models.WebhookSubscription, eventType string, tournamentID uint, data any) error {
+	now := time.Now()
+	var payload []byte
+	var err error
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(eventType, tournamentID) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(webhook.Envelope{Type: eventType, CreatedAt: now.Unix(), Data: data})
+			if err != nil {
+				return err
+			}
+		}
+		delivery := &models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+			Status:         models.WebhookStatusPending,
+			NextAttemptAt:  now.Unix(),
+		}
+		if err := tx.Create(delivery).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchData(e models.SofaScoreEvent) webhook.MatchData {
+	return webhook.MatchData{
+		EventID:           e.SofaScoreEventId,
+		Slug:              e.Slug,
+		Sport:             e.Sport,
+		TournamentID:      e.LeagueId,
+		HomeTeamID:        e.HomeTeamId,
+		AwayTeamID:        e.AwayTeamId,
+		HomeScore:         e.HomeScore,
+		AwayScore:         e.AwayScore,
+		StatusType:        e.StatusType,
+		StatusDescription: e.StatusDescription,
+		StartTimestamp:    e.StartTimestamp,
+	}
+}
+
+// CreateTestWebhookDelivery queues a test notification for a subscription
+// regardless of the event types it listens to. The delivery is created
+// already claimed so the caller can send it without racing the worker.
+func CreateTestWebhookDelivery(subscription *models.WebhookSubscription) (*models.WebhookDelivery, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(webhook.Envelope{
+		Type:      models.WebhookEventTest,
+		CreatedAt: now.Unix(),
+		Data:      map[string]string{"message": "test notification"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &models.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventTest,
+		Payload:        string(payload),
+		Status:         models.WebhookStatusPending,
+		NextAttemptAt:  now.Add(webhookClaimLease).Unix(),
+		Subscription:   subscription,
+	}
+	result := db.Omit("Subscription").Create(delivery)
+	return delivery, result.Error
+}
+
+// GetDueWebhookDeliveries returns pending deliveries whose next attempt is due
+func GetDueWebhookDeliveries(limit int) ([]models.WebhookDelivery, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	var deliveries []models.WebhookDelivery
+	result := db.Where("status = ? AND next_attempt_at <= ?", models.WebhookStatusPending, time.Now().Unix()).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Preload("Subscription").
+		Find(&deliveries)
+	return deliveries, result.Error
+}
+
+// ClaimWebhookDelivery reserves a due delivery for one sender by pushing its
+// next attempt past webhookClaimLease. It reports false when another sender
+// claimed or finished it first.
+func ClaimWebhookDelivery(delivery *models.WebhookDelivery) (bool, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return false, err
+	}
+	leaseUntil := time.Now().Add(webhookClaimLease).Unix()
+	result := db.Model(&models.WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at = ?", delivery.ID, models.WebhookStatusPending, delivery.NextAttemptAt).
+		UpdateColumn("next_attempt_at", leaseUntil)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	delivery.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+// DeliverWebhook performs one attempt of a delivery, records it and
+// schedules the next retry or dead-letters the delivery when it failed too
+// many times. The delivery must have its Subscription loaded and be claimed.
+func DeliverWebhook(delivery *models.WebhookDelivery) error {
+	db, err := database.GetDB()
+	if err != nil {
+		return err
+	}
+
+	if delivery.Subscription == nil || delivery.Subscription.ID == 0 {
+		delivery.Status = models.WebhookStatusDead
+		delivery.LastError = "subscription no longer exists"
+		return db.Omit("Subscription").Save(delivery).Error
+	}
+
+	result := webhook.Send(delivery.Subscription.URL, delivery.Subscription.Secret, delivery.EventType, delivery.ID, []byte(delivery.Payload))
+	delivery.Attempts++
+	delivery.ResponseStatus = result.StatusCode
+
+	attempt := &models.WebhookAttempt{
+		DeliveryID:     delivery.ID,
+		Attempt:        delivery.Attempts,
+		ResponseStatus: result.StatusCode,
+		DurationMs:     result.Duration.Milliseconds(),
+	}
+
+	now := time.Now()
+	if result.OK() {
+		delivery.Status = models.WebhookStatusDelivered
+		delivery.DeliveredAt = now.Unix()
+		delivery.LastError = ""
+	} else {
+		attempt.Error = result.Err.Error()
+		delivery.LastError = attempt.Error
+		if delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = models.WebhookStatusDead
+		} else {
+			delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts)).Unix()
+		}
+	}
+
+	if err := db.Create(attempt).Error; err != nil {
+		return err
+	}
+	return db.Omit("Subscription").Save(delivery).Error
+}
+
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff << (attempts - 1)
+	if backoff <= 0 || backoff > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+	return backoff
+}
+
+// GetWebhookDeliveries lists the deliveries of a subscription, optionally
+// filtered by status, newest first.
+func GetWebhookDeliveries(subscriptionID uint, status string, page, limit int) ([]models.WebhookDelivery, int64, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := db.Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var deliveries []models.WebhookDelivery
+	result := query.Order("id DESC").Offset((page - 1) * limit).Limit(limit).Find(&deliveries)
+	return deliveries, total, result.Error
+}
+
+// RequeueWebhookDelivery moves a dead-lettered delivery back to the queue.
+// It returns models.ErrWebhookDeliveryNotDead for any other status.
+func RequeueWebhookDelivery(id uint) (*models.WebhookDelivery, error) {
+	db, err := database.GetDB()
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery models.WebhookDelivery
+	if err := db.First(&delivery, id).Error; err != nil {
+		return nil, err
+	}
+	if delivery.Status != models.WebhookStatusDead {
+		return nil, models.ErrWebhookDeliveryNotDead
+	}
+
+	now := time.Now().Unix()
+	result := db.Model(&models.WebhookDelivery{}).
+		Where("id = ? AND status = ?", id, models.WebhookStatusDead).
+		Updates(map[string]any{"status": models.WebhookStatusPending, "attempts": 0, "next_attempt_at": now})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, models.ErrWebhookDeliveryNotDead
+	}
+	delivery.Status = models.WebhookStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	return &delivery, nil
+}
@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+// startDeviceCommands expires every minute the device commands that were
+// neither delivered nor acknowledged in time.
+func startDeviceCommands() {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			now := <-ticker.C
+			commands, err := repository.ExpireDeviceCommands(now)
+			if err != nil {
+				log.Printf("scheduler: failed to expire device commands: %v", err)
+			}
+			for _, command := range commands {
+				broker.Publish(broker.TopicDeviceCommand, command)
+			}
+		}
+	}()
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+// startDeviceStatus closes the sessions of devices that stopped sending
+// heartbeats for models.DeviceOfflineAfter and announces them offline.
+func startDeviceStatus() {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			<-ticker.C
+			cutoff := time.Now().Add(-models.DeviceOfflineAfter).Unix()
+			sessions, err := repository.CloseStaleDeviceSessions(cutoff)
+			if err != nil {
+				log.Printf("scheduler: failed to close stale device sessions: %v", err)
+			}
+			for _, session := range sessions {
+				change := models.DeviceStatusChange{DeviceID: session.DeviceID, LastSeen: session.LastHeartbeatAt}
+				if session.Device != nil {
+					change.Name = session.Device.Name
+				}
+				broker.Publish(broker.TopicDeviceStatus, change)
+			}
+		}
+	}()
+}
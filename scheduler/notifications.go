@@ -0,0 +1,200 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/push"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+const (
+	pushSendTimeout    = 15 * time.Second
+	pushQueueSize      = 1024
+	defaultPushWorkers = 4
+)
+
+// notificationJob is a notification of kind about an event waiting for a
+// push worker. Jobs from match changes carry only the event ID and load the
+// event on the worker.
+type notificationJob struct {
+	eventID int64
+	event   *models.SofaScoreEvent
+	kind    string
+	extra   map[string]string
+}
+
+// notificationKinds maps match transitions to the notification they send.
+var notificationKinds = map[string]string{
+	models.MatchStarted: models.NotificationKickoff,
+	models.MatchGoal:    models.NotificationGoal,
+	models.MatchEnded:   models.NotificationFinalScore,
+}
+
+func startNotifications() {
+	provider := push.FromEnv()
+	log.Printf("scheduler: push notifications use the %s provider", provider.Name())
+
+	jobs := make(chan notificationJob, pushQueueSize)
+	for i := 0; i < envInt("PUSH_WORKERS", defaultPushWorkers); i++ {
+		go sendNotifications(provider, jobs)
+	}
+
+	changes, _ := broker.Subscribe(256, broker.TopicEventChanged)
+	go dispatchNotifications(changes, jobs)
+	go remindKickoffs(jobs, envDuration("PUSH_REMINDER_LEAD", 15*time.Minute))
+}
+
+// dispatchNotifications queues kickoff, goal and final score notifications
+// for the push workers. It never waits on the database or the provider, so
+// the broker subscription keeps up with bursts of match changes.
+func dispatchNotifications(changes <-chan broker.Message, jobs chan<- notificationJob) {
+	for msg := range changes {
+		change, ok := msg.Payload.(models.EventChange)
+		if !ok {
+			continue
+		}
+		for _, transition := range change.Transitions() {
+			job := notificationJob{eventID: change.Event.SofaScoreEventId, kind: notificationKinds[transition]}
+			select {
+			case jobs <- job:
+			default:
+				log.Printf("scheduler: push queue is full, dropping %s notification for event %d", job.kind, job.eventID)
+			}
+		}
+	}
+}
+
+// sendNotifications runs queued notification jobs until jobs is closed.
+func sendNotifications(provider push.Provider, jobs <-chan notificationJob) {
+	for job := range jobs {
+		event := job.event
+		if event == nil {
+			var err error
+			event, err = repository.GetEventWithTeams(job.eventID)
+			if err != nil {
+				log.Printf("scheduler: failed to load event %d for notifications: %v", job.eventID, err)
+				continue
+			}
+		}
+		notifyEvent(provider, event, job.kind, job.extra)
+	}
+}
+
+// remindKickoffs queues a reminder for subscribed devices once an event is
+// due to start within lead.
+func remindKickoffs(jobs chan<- notificationJob, lead time.Duration) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		now := time.Now()
+		events, err := repository.GetEventsStartingBetween(now.Unix(), now.Add(lead).Unix())
+		if err != nil {
+			log.Printf("scheduler: failed to load upcoming events for reminders: %v", err)
+			continue
+		}
+		for i := range events {
+			minutes := (events[i].StartTimestamp - now.Unix() + 59) / 60
+			jobs <- notificationJob{
+				eventID: events[i].SofaScoreEventId,
+				event:   &events[i],
+				kind:    models.NotificationKickoffReminder,
+				extra:   map[string]string{"minutes": strconv.FormatInt(minutes, 10)},
+			}
+		}
+	}
+}
+
+// notifyEvent sends a notification of kind about event to every subscribed
+// device that has not received it yet.
+func notifyEvent(provider push.Provider, event *models.SofaScoreEvent, kind string, extra map[string]string) {
+	devices, err := repository.GetNotificationRecipients(event)
+	if err != nil {
+		log.Printf("scheduler: failed to load notification recipients for event %d: %v", event.SofaScoreEventId, err)
+		return
+	}
+	if len(devices) == 0 {
+		return
+	}
+
+	template, err := repository.GetNotificationTemplate(kind)
+	if err != nil {
+		log.Printf("scheduler: missing %s notification template: %v", kind, err)
+		return
+	}
+	title, body := template.Render(notificationValues(event, extra))
+
+	for _, device := range devices {
+		entry := &models.NotificationLog{
+			DeviceID:         device.ID,
+			SofaScoreEventId: event.SofaScoreEventId,
+			Kind:             kind,
+			DedupKey:         models.NotificationDedupKey(device.ID, event, kind),
+			Title:            title,
+			Body:             body,
+			Provider:         provider.Name(),
+			Status:           models.NotificationStatusPending,
+		}
+		claimed, err := repository.ClaimNotification(entry)
+		if err != nil {
+			log.Printf("scheduler: failed to record %s notification for device %d: %v", kind, device.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), pushSendTimeout)
+		err = provider.Send(ctx, push.Message{
+			Token: device.Token,
+			Title: title,
+			Body:  body,
+			Data: map[string]string{
+				"kind":     kind,
+				"event_id": strconv.FormatInt(event.SofaScoreEventId, 10),
+			},
+		})
+		cancel()
+
+		entry.Status = models.NotificationStatusSent
+		if err != nil {
+			entry.Status = models.NotificationStatusFailed
+			entry.Error = err.Error()
+		}
+		if errors.Is(err, push.ErrInvalidToken) {
+			log.Printf("scheduler: push token of device %d is no longer registered, disabling its notifications", device.ID)
+			if err := repository.DisableDevicePush(device.ID); err != nil {
+				log.Printf("scheduler: failed to disable notifications of device %d: %v", device.ID, err)
+			}
+		}
+		if err := repository.SaveNotificationLog(entry); err != nil {
+			log.Printf("scheduler: failed to update notification %d: %v", entry.ID, err)
+		}
+	}
+}
+
+func notificationValues(event *models.SofaScoreEvent, extra map[string]string) map[string]string {
+	values := map[string]string{
+		"home_score": strconv.Itoa(event.HomeScore),
+		"away_score": strconv.Itoa(event.AwayScore),
+	}
+	if event.HomeTeamModel != nil {
+		values["home"] = event.HomeTeamModel.Name
+	}
+	if event.AwayTeamModel != nil {
+		values["away"] = event.AwayTeamModel.Name
+	}
+	if event.League != nil {
+		values["tournament"] = event.League.Name
+	}
+	for key, value := range extra {
+		values[key] = value
+	}
+	return values
+}
@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+// startPlaybackSweeper closes every minute the playbacks that stopped
+// sending heartbeats for models.PlaybackTimeout, and the untracked ones of
+// devices offline for models.DeviceOfflineAfter, so the daily stats count
+// them.
+func startPlaybackSweeper() {
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			now := <-ticker.C
+			cutoff := now.Add(-models.PlaybackTimeout).Unix()
+			offlineCutoff := now.Add(-models.DeviceOfflineAfter).Unix()
+			if _, err := repository.CloseStalePlaybacks(cutoff, offlineCutoff); err != nil {
+				log.Printf("scheduler: failed to close stale playbacks: %v", err)
+			}
+		}
+	}()
+}
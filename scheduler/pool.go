@@ -0,0 +1,133 @@
+package scheduler
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+)
+
+const (
+	defaultScrapeConcurrency     = 4
+	defaultScrapeRequestInterval = 250 * time.Millisecond
+)
+
+// scrapeTask is a unit of scraping work identified by a key; two tasks with
+// the same key are considered the same upstream request.
+type scrapeTask struct {
+	key string
+	run func()
+}
+
+// scrapePool runs scrape tasks on a bounded number of workers. Task starts
+// are spaced by a shared throttle so the upstream rate limit is respected no
+// matter how many workers are configured, and a task whose key is already
+// queued or running is dropped instead of being fetched twice.
+type scrapePool struct {
+	tasks    chan pooledTask
+	throttle *time.Ticker
+
+	mu       sync.Mutex
+	inFlight map[string]struct{}
+}
+
+type pooledTask struct {
+	scrapeTask
+	wg *sync.WaitGroup
+}
+
+var (
+	poolOnce sync.Once
+	pool     *scrapePool
+)
+
+// getScrapePool returns the shared pool, starting its workers on first use.
+func getScrapePool() *scrapePool {
+	poolOnce.Do(func() {
+		concurrency := envInt("SCRAPE_CONCURRENCY", defaultScrapeConcurrency)
+		interval := envDuration("SCRAPE_REQUEST_INTERVAL", defaultScrapeRequestInterval)
+		pool = newScrapePool(concurrency, interval)
+		log.Printf("scheduler: scrape pool started with %d workers, one request every %s", concurrency, interval)
+	})
+	return pool
+}
+
+func newScrapePool(workers int, interval time.Duration) *scrapePool {
+	p := &scrapePool{
+		tasks:    make(chan pooledTask),
+		throttle: time.NewTicker(interval),
+		inFlight: make(map[string]struct{}),
+	}
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *scrapePool) work() {
+	for task := range p.tasks {
+		<-p.throttle.C
+		task.run()
+		p.release(task.key)
+		task.wg.Done()
+	}
+}
+
+func (p *scrapePool) acquire(key string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, busy := p.inFlight[key]; busy {
+		return false
+	}
+	p.inFlight[key] = struct{}{}
+	return true
+}
+
+func (p *scrapePool) release(key string) {
+	p.mu.Lock()
+	delete(p.inFlight, key)
+	p.mu.Unlock()
+}
+
+// runCycle executes tasks on the pool, waits for all of them to finish and
+// logs how long the cycle took.
+func (p *scrapePool) runCycle(name string, tasks []scrapeTask) {
+	begin := time.Now()
+	var wg sync.WaitGroup
+	skipped := 0
+	for _, task := range tasks {
+		if !p.acquire(task.key) {
+			skipped++
+			continue
+		}
+		wg.Add(1)
+		p.tasks <- pooledTask{scrapeTask: task, wg: &wg}
+	}
+	wg.Wait()
+
+	log.Printf("scheduler: cycle %s finished %d tasks (%d already in flight) in %s", name, len(tasks)-skipped, skipped, time.Since(begin).Round(time.Millisecond))
+	reportJob(models.JobRun{Name: "scrape-" + name, StartedAt: begin, Duration: time.Since(begin), Tasks: len(tasks) - skipped, Skipped: skipped})
+}
+
+// reportJob publishes the outcome of a background job for live consumers.
+func reportJob(run models.JobRun) {
+	broker.Publish(broker.TopicJobFinished, run)
+}
+
+func envInt(key string, defaultValue int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
@@ -3,4 +3,10 @@ package scheduler
 func Begin() {
 	startScrape()
 	startStats()
+	startDeviceStatus()
+	startDeviceCommands()
+	startPlaybackSweeper()
+	startWebhooks()
+	startNotifications()
+	startSubscriptions()
 }
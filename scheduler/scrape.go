@@ -3,52 +3,113 @@ package scheduler
 import (
 	"encoding/json"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/jeriveromartinez/sofascore-scrapper/libs/httpcli"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
 	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 )
 
 func scrape(sport string, date time.Time) {
-	body := httpcli.LoadDataBySport(sport, date)
+	body, changed, commit := httpcli.LoadDataBySport(sport, date)
+	if body != nil && !changed {
+		log.Printf("scheduler: %s on %s unchanged, skipping persistence", sport, timezone.FormatDay(date, timezone.Reference()))
+		return
+	}
 	var list models.EventsListResponse
 	if err := json.Unmarshal(body, &list); err != nil {
-		log.Printf("scheduler: error parsing JSON for %s on %s: %v", sport, date.Format("2006-01-02"), err)
+		log.Printf("scheduler: error parsing JSON for %s on %s: %v", sport, timezone.FormatDay(date, timezone.Reference()), err)
+		return
+	}
+	if err := repository.SaveSofaScoreEvent(list.Events, sport); err != nil {
+		log.Printf("scheduler: error saving events for %s on %s: %v", sport, timezone.FormatDay(date, timezone.Reference()), err)
+		return
+	}
+	if err := repository.DeleteMissingEvents(sport, list.Events); err != nil {
+		log.Printf("scheduler: error deleting unlisted events for %s on %s: %v", sport, timezone.FormatDay(date, timezone.Reference()), err)
 		return
 	}
-	repository.SaveSofaScoreEvent(list.Events, sport)
-	log.Printf("scheduler: scraped %d events for %s on %s", len(list.Events), sport, date.Format("2006-01-02"))
+	commit()
+	log.Printf("scheduler: scraped %d events for %s on %s", len(list.Events), sport, timezone.FormatDay(date, timezone.Reference()))
 }
 
 func scrapeCountry(countryCode string) {
-	body := httpcli.LoadDataByTrendingCountry(countryCode)
+	body, changed, commit := httpcli.LoadDataByTrendingCountry(countryCode)
+	if body != nil && !changed {
+		log.Printf("scheduler: trending events for country %s unchanged, skipping persistence", countryCode)
+		return
+	}
 	var list models.EventsListResponse
 	if err := json.Unmarshal(body, &list); err != nil {
 		log.Printf("scheduler: error parsing JSON for country %s: %v", countryCode, err)
 		return
 	}
-	repository.SaveSofaScoreEvent(list.Events, countryCode)
+	if err := repository.SaveTrendingEvents(countryCode, list.Events); err != nil {
+		log.Printf("scheduler: error saving trending events for country %s: %v", countryCode, err)
+		return
+	}
+	commit()
 	log.Printf("scheduler: scraped %d events for country %s", len(list.Events), countryCode)
 }
 
+// enabledSports returns the sports enabled in the catalog, falling back to the
+// built-in list when the catalog cannot be read.
+func enabledSports() []string {
+	sports, err := repository.GetEnabledSportSlugs()
+	if err != nil {
+		log.Printf("scheduler: could not load enabled sports, using defaults: %v", err)
+		return httpcli.GET_SPORTS()
+	}
+	return sports
+}
+
+// enabledCountries returns the trending countries enabled in the catalog,
+// falling back to the built-in list when the catalog cannot be read.
+func enabledCountries() []string {
+	countries, err := repository.GetEnabledCountryCodes()
+	if err != nil {
+		log.Printf("scheduler: could not load enabled countries, using defaults: %v", err)
+		return httpcli.GET_COUNTRIES()
+	}
+	return countries
+}
+
+func sportTask(sport string, date time.Time) scrapeTask {
+	return scrapeTask{
+		key: "sport:" + sport + ":" + timezone.FormatDay(date, timezone.Reference()),
+		run: func() { scrape(sport, date) },
+	}
+}
+
+func countryTask(countryCode string) scrapeTask {
+	return scrapeTask{
+		key: "country:" + countryCode,
+		run: func() { scrapeCountry(countryCode) },
+	}
+}
+
 func scrapeToday(date time.Time) {
-	for _, sport := range httpcli.GET_SPORTS() {
-		scrape(sport, date)
+	var tasks []scrapeTask
+	for _, sport := range enabledSports() {
+		tasks = append(tasks, sportTask(sport, date))
 	}
-	for _, country := range httpcli.GET_COUNTRIES() {
-		scrapeCountry(country)
+	for _, country := range enabledCountries() {
+		tasks = append(tasks, countryTask(country))
 	}
+	getScrapePool().runCycle("today", tasks)
 }
 
 func scrapeNext7Days() {
-	now := time.Now()
-	for _, sport := range httpcli.GET_SPORTS() {
+	today := timezone.StartOfDay(time.Now(), timezone.Reference())
+	var tasks []scrapeTask
+	for _, sport := range enabledSports() {
 		for i := 1; i <= 7; i++ {
-			scrape(sport, now.Add(time.Duration(i)*24*time.Hour))
+			tasks = append(tasks, sportTask(sport, today.AddDate(0, 0, i)))
 		}
 	}
-
+	getScrapePool().runCycle("next-7-days", tasks)
 }
 
 func startScrape() {
@@ -61,7 +122,9 @@ func startScrape() {
 		defer ticker.Stop()
 		for {
 			<-ticker.C
-			scrape(httpcli.FOOTBALL, time.Now())
+			if slices.Contains(enabledSports(), httpcli.FOOTBALL) {
+				getScrapePool().runCycle("live-football", []scrapeTask{sportTask(httpcli.FOOTBALL, time.Now())})
+			}
 		}
 	}()
 
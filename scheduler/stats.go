@@ -2,27 +2,36 @@ package scheduler
 
 import (
 	"log"
+	"time"
 
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
 	"github.com/jeriveromartinez/sofascore-scrapper/repository"
 	"github.com/robfig/cron/v3"
 )
 
 func startStats() {
-	c := cron.New()
+	c := cron.New(cron.WithLocation(timezone.Reference()))
 
 	_, err := c.AddFunc("1 0 * * *", func() {
-		if err := repository.GenerateDailyEventStats(); err != nil {
+		begin := time.Now()
+		err := repository.GenerateDailyEventStats()
+		if err != nil {
 			log.Printf("failed to generate daily event stats: %v", err)
 		}
+		reportJob(models.JobRun{Name: "daily-event-stats", StartedAt: begin, Duration: time.Since(begin), Tasks: 1, Err: err})
 	})
 	if err != nil {
 		log.Printf("failed to schedule daily stats cron job: %v", err)
 	}
 
 	_, err = c.AddFunc("10 0 1 * *", func() {
-		if err := repository.GenerateMonthlyEventStats(); err != nil {
+		begin := time.Now()
+		err := repository.GenerateMonthlyEventStats()
+		if err != nil {
 			log.Printf("failed to generate monthly event stats: %v", err)
 		}
+		reportJob(models.JobRun{Name: "monthly-event-stats", StartedAt: begin, Duration: time.Since(begin), Tasks: 1, Err: err})
 	})
 	if err != nil {
 		log.Printf("failed to schedule monthly stats cron job: %v", err)
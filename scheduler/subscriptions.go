@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/broker"
+	"github.com/jeriveromartinez/sofascore-scrapper/libs/timezone"
+	"github.com/jeriveromartinez/sofascore-scrapper/models"
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+	"github.com/robfig/cron/v3"
+)
+
+// startSubscriptions flags once a day the subscriptions that expire within
+// SUBSCRIPTION_NOTICE days and announces them to the dashboard.
+func startSubscriptions() {
+	lead := time.Duration(envInt("SUBSCRIPTION_NOTICE", 7)) * 24 * time.Hour
+	c := cron.New(cron.WithLocation(timezone.Reference()))
+
+	_, err := c.AddFunc("20 0 * * *", func() {
+		begin := time.Now()
+		subscriptions, err := repository.FlagExpiringSubscriptions(lead)
+		if err != nil {
+			log.Printf("failed to flag expiring subscriptions: %v", err)
+		}
+		for _, subscription := range subscriptions {
+			broker.Publish(broker.TopicSubscriptionExpiring, subscription)
+		}
+		reportJob(models.JobRun{Name: "expiring-subscriptions", StartedAt: begin, Duration: time.Since(begin), Tasks: len(subscriptions), Err: err})
+	})
+	if err != nil {
+		log.Printf("failed to schedule expiring subscriptions cron job: %v", err)
+	}
+
+	c.Start()
+}
@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"log"
+	"time"
+
+	"github.com/jeriveromartinez/sofascore-scrapper/repository"
+)
+
+const (
+	webhookPollInterval = 5 * time.Second
+	webhookBatchSize    = 50
+)
+
+// startWebhooks runs the delivery worker. Deliveries are queued by the
+// repository in the same transaction that saves the event change.
+func startWebhooks() {
+	go deliverWebhooks()
+}
+
+// deliverWebhooks periodically sends the deliveries that are due.
+func deliverWebhooks() {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		deliveries, err := repository.GetDueWebhookDeliveries(webhookBatchSize)
+		if err != nil {
+			log.Printf("scheduler: failed to load due webhook deliveries: %v", err)
+			continue
+		}
+		for i := range deliveries {
+			claimed, err := repository.ClaimWebhookDelivery(&deliveries[i])
+			if err != nil {
+				log.Printf("scheduler: failed to claim webhook delivery %d: %v", deliveries[i].ID, err)
+				continue
+			}
+			if !claimed {
+				continue
+			}
+			if err := repository.DeliverWebhook(&deliveries[i]); err != nil {
+				log.Printf("scheduler: failed to record webhook delivery %d: %v", deliveries[i].ID, err)
+			}
+		}
+	}
+}
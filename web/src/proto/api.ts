@@ -31,6 +31,24 @@ export interface AuthResponse {
   email: string;
   token: string;
   refreshToken: string;
+  role: string;
+}
+
+export interface User {
+  id: number;
+  createdAt: string;
+  email: string;
+  role: string;
+  deviceQuota: number;
+  deviceCount: number;
+}
+
+export interface UserList {
+  data: User[];
+}
+
+export interface UserQuotaRequest {
+  deviceQuota: number;
 }
 
 export interface DeviceRegisterRequest {
@@ -38,6 +56,12 @@ export interface DeviceRegisterRequest {
   platform: string;
   name: string;
   version: string;
+  country: string;
+  packageName: string;
+  locale: string;
+  fingerprint: string;
+  /** Required when a reinstated device registers again. */
+  enrollmentCode: string;
 }
 
 export interface Device {
@@ -50,6 +74,16 @@ export interface Device {
   lastSeen: number;
   version: string;
   iptvUrl: string;
+  country: string;
+  packageName: string;
+  locale: string;
+  secret: string;
+  revokedAt: number;
+  managerId: number;
+  managerEmail: string;
+  fingerprint: string;
+  blockedAt: number;
+  blockReason: string;
 }
 
 export interface DeviceList {
@@ -64,6 +98,284 @@ export interface DeviceUrl {
   url: string;
 }
 
+export interface HeartbeatRequest {
+  version: string;
+  packageName: string;
+  networkType: string;
+  content: string;
+}
+
+export interface HeartbeatResponse {
+  sessionId: number;
+  serverTime: number;
+  intervalSeconds: number;
+}
+
+export interface DeviceSession {
+  id: number;
+  deviceId: number;
+  deviceName: string;
+  startedAt: number;
+  lastHeartbeatAt: number;
+  endedAt: number;
+  version: string;
+  packageName: string;
+  networkType: string;
+  content: string;
+}
+
+export interface DeviceSessionList {
+  data: DeviceSession[];
+  page: number;
+  limit: number;
+  total: number;
+  totalPages: number;
+}
+
+export interface DeviceUptime {
+  deviceId: number;
+  from: number;
+  to: number;
+  uptimeSeconds: number;
+  uptimeRatio: number;
+  sessions: DeviceSession[];
+}
+
+export interface BlockDeviceRequest {
+  reason: string;
+}
+
+export interface DeviceAuditEntry {
+  id: number;
+  createdAt: string;
+  deviceId: number;
+  userId: number;
+  userEmail: string;
+  action: string;
+  reason: string;
+}
+
+export interface DeviceAuditList {
+  data: DeviceAuditEntry[];
+}
+
+export interface DuplicateDeviceGroup {
+  fingerprint: string;
+  devices: Device[];
+}
+
+export interface DuplicateDeviceGroupList {
+  data: DuplicateDeviceGroup[];
+}
+
+export interface EnrollmentCodeRequest {
+  ttlMinutes: number;
+}
+
+export interface EnrollmentCode {
+  id: number;
+  createdAt: string;
+  code: string;
+  expiresAt: number;
+  redeemedAt: number;
+  deviceId: number;
+  deviceName: string;
+  qrPayload: string;
+}
+
+export interface EnrollmentCodeList {
+  data: EnrollmentCode[];
+}
+
+export interface EnrollDeviceRequest {
+  code: string;
+}
+
+export interface DeviceCommandRequest {
+  type: string;
+  payload: string;
+  ttlMinutes: number;
+}
+
+export interface DeviceCommand {
+  id: number;
+  createdAt: string;
+  deviceId: number;
+  type: string;
+  payload: string;
+  status: string;
+  expiresAt: number;
+  deliveredAt: number;
+  completedAt: number;
+  result: string;
+  createdBy: string;
+}
+
+export interface DeviceCommandList {
+  data: DeviceCommand[];
+  page: number;
+  limit: number;
+  total: number;
+  totalPages: number;
+}
+
+export interface DeviceCommandAck {
+  success: boolean;
+  result: string;
+}
+
+export interface PlanRequest {
+  name: string;
+  durationDays: number;
+  maxTournaments: number;
+  liveUpdates: boolean;
+}
+
+export interface Plan {
+  id: number;
+  createdAt: string;
+  name: string;
+  durationDays: number;
+  maxTournaments: number;
+  liveUpdates: boolean;
+}
+
+export interface PlanList {
+  data: Plan[];
+}
+
+export interface SubscriptionRequest {
+  planId: number;
+  days: number;
+}
+
+export interface DeviceSubscription {
+  id: number;
+  deviceId: number;
+  deviceName: string;
+  plan: Plan | undefined;
+  startsAt: number;
+  expiresAt: number;
+  active: boolean;
+  expiryFlaggedAt: number;
+}
+
+export interface DeviceSubscriptionList {
+  data: DeviceSubscription[];
+  page: number;
+  limit: number;
+  total: number;
+  totalPages: number;
+}
+
+/**
+ * SubscriptionError is returned with 402 Payment Required when the device
+ * subscription does not grant access to the requested resource.
+ */
+export interface SubscriptionError {
+  code: string;
+  message: string;
+  expiresAt: number;
+}
+
+export interface DeviceGroupRequest {
+  name: string;
+  description: string;
+  priority: number;
+  rulePackage: string;
+  ruleVersion: string;
+  rulePlatform: string;
+  ruleManagerId: number;
+}
+
+export interface DeviceGroup {
+  id: number;
+  createdAt: string;
+  name: string;
+  description: string;
+  priority: number;
+  deviceCount: number;
+  rulePackage: string;
+  ruleVersion: string;
+  rulePlatform: string;
+  ruleManagerId: number;
+}
+
+export interface DeviceGroupList {
+  data: DeviceGroup[];
+}
+
+export interface DeviceGroupMembersRequest {
+  deviceIds: number[];
+}
+
+export interface DeviceGroupMembersResult {
+  affected: number;
+  rejectedDeviceIds: number[];
+}
+
+export interface DeviceGroupTournamentList {
+  deviceGroupId: number;
+  tournaments: Tournament[];
+}
+
+export interface ConfigKeyRequest {
+  key: string;
+  type: string;
+  defaultValue: string;
+  description: string;
+}
+
+export interface ConfigKey {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  key: string;
+  type: string;
+  defaultValue: string;
+  description: string;
+}
+
+export interface ConfigKeyList {
+  data: ConfigKey[];
+}
+
+export interface ConfigValueRequest {
+  key: string;
+  scope: string;
+  scopeId: string;
+  value: string;
+}
+
+export interface ConfigValue {
+  id: number;
+  updatedAt: string;
+  key: string;
+  scope: string;
+  scopeId: string;
+  value: string;
+}
+
+export interface ConfigValueList {
+  data: ConfigValue[];
+}
+
+export interface ConfigEntry {
+  key: string;
+  type: string;
+  value: string;
+  source: string;
+}
+
+/**
+ * ConfigBundle is the effective configuration of a device. version changes
+ * whenever any value does.
+ */
+export interface ConfigBundle {
+  version: string;
+  entries: ConfigEntry[];
+}
+
 export interface TournamentRequest {
   name: string;
   slug: string;
@@ -105,6 +417,29 @@ export interface DeviceTournamentList {
   deviceTournaments: DeviceTournament[];
 }
 
+export interface AssignTeamRequest {
+  deviceId: number;
+  teamId: number;
+}
+
+export interface SetTeamIdsRequest {
+  teamIds: number[];
+}
+
+export interface DeviceTeam {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  deviceId: number;
+  teamId: number;
+  device: Device | undefined;
+  team: Team | undefined;
+}
+
+export interface DeviceTeamList {
+  deviceTeams: DeviceTeam[];
+}
+
 export interface GlobalTournamentConfig {
   id: number;
   createdAt: string;
@@ -117,6 +452,44 @@ export interface GlobalTournamentConfigList {
   configs: GlobalTournamentConfig[];
 }
 
+export interface SportRequest {
+  slug: string;
+  name: string;
+  enabled: boolean;
+}
+
+export interface Sport {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  slug: string;
+  name: string;
+  enabled: boolean;
+}
+
+export interface SportList {
+  sports: Sport[];
+}
+
+export interface TrendingCountryRequest {
+  code: string;
+  name: string;
+  enabled: boolean;
+}
+
+export interface TrendingCountry {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  code: string;
+  name: string;
+  enabled: boolean;
+}
+
+export interface TrendingCountryList {
+  countries: TrendingCountry[];
+}
+
 export interface Team {
   id: number;
   teamId: number;
@@ -145,6 +518,10 @@ export interface SofaScoreEvent {
   teamHome: Team | undefined;
   teamAway: Team | undefined;
   league: Tournament | undefined;
+  statusCode: number;
+  statusType: string;
+  statusDescription: string;
+  leagueId: number;
 }
 
 export interface EventsList {
@@ -153,6 +530,103 @@ export interface EventsList {
   limit: number;
   total: number;
   totalPages: number;
+  /**
+   * Sections of the current-events feed. live and recent are only sent on
+   * the first page; upcoming continues with next_cursor.
+   */
+  live: SofaScoreEvent[];
+  upcoming: SofaScoreEvent[];
+  recent: SofaScoreEvent[];
+  nextCursor: string;
+}
+
+export interface EventDelta {
+  sofaScoreEventId: number;
+  leagueId: number;
+  homeScore: number;
+  awayScore: number;
+  statusCode: number;
+  statusType: string;
+  statusDescription: string;
+  startTimestamp: number;
+  currentPeriodStartTimestamp: number;
+  updatedAt: string;
+  isNew: boolean;
+}
+
+export interface TrendingEventsList {
+  countryCode: string;
+  fetchedAt: number;
+  data: SofaScoreEvent[];
+}
+
+export interface DeviceStatusChange {
+  deviceId: number;
+  name: string;
+  online: boolean;
+  lastSeen: number;
+}
+
+export interface CrashReportSummary {
+  id: number;
+  createdAt: string;
+  fatal: boolean;
+  error: string;
+  appName: string;
+  appVersion: string;
+  platform: string;
+}
+
+export interface JobRun {
+  name: string;
+  startedAt: string;
+  durationMs: number;
+  tasks: number;
+  skipped: number;
+  error: string;
+}
+
+export interface WebhookRequest {
+  url: string;
+  secret: string;
+  eventTypes: string[];
+  tournamentIds: number[];
+  active: boolean;
+}
+
+export interface Webhook {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  url: string;
+  eventTypes: string[];
+  tournamentIds: number[];
+  active: boolean;
+  /** Only populated when the secret is generated by the server. */
+  secret: string;
+}
+
+export interface WebhookList {
+  webhooks: Webhook[];
+}
+
+export interface WebhookDelivery {
+  id: number;
+  createdAt: string;
+  subscriptionId: number;
+  eventType: string;
+  status: string;
+  attempts: number;
+  nextAttemptAt: number;
+  responseStatus: number;
+  lastError: string;
+  deliveredAt: number;
+  payload: string;
+}
+
+export interface WebhookDeliveryList {
+  deliveries: WebhookDelivery[];
+  total: number;
 }
 
 export interface LogPlaybackRequest {
@@ -175,6 +649,11 @@ export interface PlaybackLog {
   endedAt: number;
 }
 
+export interface PlaybackSession {
+  playback: PlaybackLog | undefined;
+  heartbeatIntervalSeconds: number;
+}
+
 export interface PlaybackLogList {
   list: PlaybackLog[];
   total: number;
@@ -243,29 +722,172 @@ export interface ApkVersion {
   url: string;
 }
 
-function createBaseErrorResponse(): ErrorResponse {
-  return { error: "" };
+export interface NotificationSubscriptionRequest {
+  targetType: string;
+  targetId: number;
 }
 
-export const ErrorResponse: MessageFns<ErrorResponse> = {
-  encode(message: ErrorResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.error !== "") {
-      writer.uint32(10).string(message.error);
-    }
-    return writer;
-  },
+export interface NotificationSubscription {
+  id: number;
+  createdAt: string;
+  targetType: string;
+  targetId: number;
+}
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ErrorResponse {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseErrorResponse();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
-            break;
-          }
+export interface NotificationSubscriptionList {
+  subscriptions: NotificationSubscription[];
+}
+
+export interface NotificationTemplateRequest {
+  title: string;
+  body: string;
+}
+
+export interface NotificationTemplate {
+  kind: string;
+  title: string;
+  body: string;
+  updatedAt: string;
+}
+
+export interface NotificationTemplateList {
+  templates: NotificationTemplate[];
+}
+
+export interface NotificationLogEntry {
+  id: number;
+  createdAt: string;
+  deviceId: number;
+  deviceName: string;
+  sofaScoreEventId: number;
+  kind: string;
+  title: string;
+  body: string;
+  provider: string;
+  status: string;
+  error: string;
+}
+
+export interface NotificationLogList {
+  entries: NotificationLogEntry[];
+  total: number;
+}
+
+export interface SyncResponse {
+  /** Opaque cursor to send on the next sync. */
+  cursor: string;
+  /** More changes are pending; sync again right away with the new cursor. */
+  hasMore: boolean;
+  events: SofaScoreEvent[];
+  teams: Team[];
+  tournaments: Tournament[];
+  deletedEventIds: number[];
+  /**
+   * Tournaments the device currently follows; cached events of any other
+   * tournament can be dropped.
+   */
+  tournamentIds: number[];
+}
+
+export interface FeedSettingsRequest {
+  maxItems: number;
+  lookAheadHours: number;
+  recentHours: number;
+  ordering: string;
+  sports: string[];
+}
+
+export interface FeedSettings {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  deviceId: number;
+  packageName: string;
+  maxItems: number;
+  lookAheadHours: number;
+  recentHours: number;
+  ordering: string;
+  sports: string[];
+  device: Device | undefined;
+}
+
+export interface FeedSettingsList {
+  settings: FeedSettings[];
+}
+
+export interface EventDetail {
+  /** The event with both teams and its tournament. */
+  event: SofaScoreEvent | undefined;
+  round: number;
+  roundName: string;
+  season: string;
+  venue: string;
+  venueCity: string;
+  referee: string;
+  /**
+   * Latest finished events of each team, most recent first, and their
+   * results from that team's point of view, e.g. "WWDLW".
+   */
+  homeLastEvents: SofaScoreEvent[];
+  homeForm: string;
+  awayLastEvents: SofaScoreEvent[];
+  awayForm: string;
+  /**
+   * Previous meetings between both teams, most recent first. Wins are
+   * counted from the point of view of this event's teams.
+   */
+  headToHead: SofaScoreEvent[];
+  homeWins: number;
+  awayWins: number;
+  draws: number;
+}
+
+export interface TranslationRequest {
+  entityType: string;
+  entityKey: string;
+  locale: string;
+  name: string;
+}
+
+export interface Translation {
+  id: number;
+  createdAt: string;
+  updatedAt: string;
+  entityType: string;
+  entityKey: string;
+  locale: string;
+  name: string;
+  source: string;
+}
+
+export interface TranslationList {
+  translations: Translation[];
+  total: number;
+}
+
+function createBaseErrorResponse(): ErrorResponse {
+  return { error: "" };
+}
+
+export const ErrorResponse: MessageFns<ErrorResponse> = {
+  encode(message: ErrorResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.error !== "") {
+      writer.uint32(10).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ErrorResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseErrorResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
 
           message.error = reader.string();
           continue;
@@ -494,7 +1116,7 @@ export const AuthRequest: MessageFns<AuthRequest> = {
 };
 
 function createBaseAuthResponse(): AuthResponse {
-  return { id: 0, email: "", token: "", refreshToken: "" };
+  return { id: 0, email: "", token: "", refreshToken: "", role: "" };
 }
 
 export const AuthResponse: MessageFns<AuthResponse> = {
@@ -511,6 +1133,9 @@ export const AuthResponse: MessageFns<AuthResponse> = {
     if (message.refreshToken !== "") {
       writer.uint32(34).string(message.refreshToken);
     }
+    if (message.role !== "") {
+      writer.uint32(42).string(message.role);
+    }
     return writer;
   },
 
@@ -553,6 +1178,14 @@ export const AuthResponse: MessageFns<AuthResponse> = {
           message.refreshToken = reader.string();
           continue;
         }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -572,6 +1205,7 @@ export const AuthResponse: MessageFns<AuthResponse> = {
         : isSet(object.refresh_token)
         ? globalThis.String(object.refresh_token)
         : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
     };
   },
 
@@ -589,6 +1223,9 @@ export const AuthResponse: MessageFns<AuthResponse> = {
     if (message.refreshToken !== "") {
       obj.refreshToken = message.refreshToken;
     }
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
     return obj;
   },
 
@@ -601,44 +1238,51 @@ export const AuthResponse: MessageFns<AuthResponse> = {
     message.email = object.email ?? "";
     message.token = object.token ?? "";
     message.refreshToken = object.refreshToken ?? "";
+    message.role = object.role ?? "";
     return message;
   },
 };
 
-function createBaseDeviceRegisterRequest(): DeviceRegisterRequest {
-  return { token: "", platform: "", name: "", version: "" };
+function createBaseUser(): User {
+  return { id: 0, createdAt: "", email: "", role: "", deviceQuota: 0, deviceCount: 0 };
 }
 
-export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
-  encode(message: DeviceRegisterRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.token !== "") {
-      writer.uint32(10).string(message.token);
+export const User: MessageFns<User> = {
+  encode(message: User, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
     }
-    if (message.platform !== "") {
-      writer.uint32(18).string(message.platform);
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
     }
-    if (message.name !== "") {
-      writer.uint32(26).string(message.name);
+    if (message.email !== "") {
+      writer.uint32(26).string(message.email);
     }
-    if (message.version !== "") {
-      writer.uint32(34).string(message.version);
+    if (message.role !== "") {
+      writer.uint32(34).string(message.role);
+    }
+    if (message.deviceQuota !== 0) {
+      writer.uint32(40).int32(message.deviceQuota);
+    }
+    if (message.deviceCount !== 0) {
+      writer.uint32(48).int64(message.deviceCount);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DeviceRegisterRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): User {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeviceRegisterRequest();
+    const message = createBaseUser();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.token = reader.string();
+          message.id = reader.uint32();
           continue;
         }
         case 2: {
@@ -646,7 +1290,7 @@ export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
             break;
           }
 
-          message.platform = reader.string();
+          message.createdAt = reader.string();
           continue;
         }
         case 3: {
@@ -654,7 +1298,7 @@ export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
             break;
           }
 
-          message.name = reader.string();
+          message.email = reader.string();
           continue;
         }
         case 4: {
@@ -662,7 +1306,23 @@ export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
             break;
           }
 
-          message.version = reader.string();
+          message.role = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.deviceQuota = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.deviceCount = longToNumber(reader.int64());
           continue;
         }
       }
@@ -674,120 +1334,264 @@ export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
     return message;
   },
 
-  fromJSON(object: any): DeviceRegisterRequest {
+  fromJSON(object: any): User {
     return {
-      token: isSet(object.token) ? globalThis.String(object.token) : "",
-      platform: isSet(object.platform) ? globalThis.String(object.platform) : "",
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      email: isSet(object.email) ? globalThis.String(object.email) : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      deviceQuota: isSet(object.deviceQuota)
+        ? globalThis.Number(object.deviceQuota)
+        : isSet(object.device_quota)
+        ? globalThis.Number(object.device_quota)
+        : 0,
+      deviceCount: isSet(object.deviceCount)
+        ? globalThis.Number(object.deviceCount)
+        : isSet(object.device_count)
+        ? globalThis.Number(object.device_count)
+        : 0,
     };
   },
 
-  toJSON(message: DeviceRegisterRequest): unknown {
+  toJSON(message: User): unknown {
     const obj: any = {};
-    if (message.token !== "") {
-      obj.token = message.token;
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
     }
-    if (message.platform !== "") {
-      obj.platform = message.platform;
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
     }
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.email !== "") {
+      obj.email = message.email;
     }
-    if (message.version !== "") {
-      obj.version = message.version;
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.deviceQuota !== 0) {
+      obj.deviceQuota = Math.round(message.deviceQuota);
+    }
+    if (message.deviceCount !== 0) {
+      obj.deviceCount = Math.round(message.deviceCount);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DeviceRegisterRequest>, I>>(base?: I): DeviceRegisterRequest {
-    return DeviceRegisterRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<User>, I>>(base?: I): User {
+    return User.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DeviceRegisterRequest>, I>>(object: I): DeviceRegisterRequest {
-    const message = createBaseDeviceRegisterRequest();
-    message.token = object.token ?? "";
-    message.platform = object.platform ?? "";
-    message.name = object.name ?? "";
-    message.version = object.version ?? "";
+  fromPartial<I extends Exact<DeepPartial<User>, I>>(object: I): User {
+    const message = createBaseUser();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.email = object.email ?? "";
+    message.role = object.role ?? "";
+    message.deviceQuota = object.deviceQuota ?? 0;
+    message.deviceCount = object.deviceCount ?? 0;
     return message;
   },
 };
 
-function createBaseDevice(): Device {
-  return {
-    id: 0,
-    createdAt: "",
-    updatedAt: "",
-    token: "",
-    platform: "",
-    name: "",
-    lastSeen: 0,
-    version: "",
-    iptvUrl: "",
-  };
+function createBaseUserList(): UserList {
+  return { data: [] };
 }
 
-export const Device: MessageFns<Device> = {
-  encode(message: Device, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
-    }
-    if (message.createdAt !== "") {
-      writer.uint32(18).string(message.createdAt);
-    }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
-    }
-    if (message.token !== "") {
-      writer.uint32(34).string(message.token);
-    }
-    if (message.platform !== "") {
-      writer.uint32(42).string(message.platform);
-    }
-    if (message.name !== "") {
-      writer.uint32(50).string(message.name);
-    }
-    if (message.lastSeen !== 0) {
-      writer.uint32(56).int64(message.lastSeen);
-    }
-    if (message.version !== "") {
-      writer.uint32(66).string(message.version);
-    }
-    if (message.iptvUrl !== "") {
-      writer.uint32(74).string(message.iptvUrl);
+export const UserList: MessageFns<UserList> = {
+  encode(message: UserList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      User.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Device {
+  decode(input: BinaryReader | Uint8Array, length?: number): UserList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDevice();
+    const message = createBaseUserList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.uint32();
+          message.data.push(User.decode(reader, reader.uint32()));
           continue;
         }
-        case 2: {
-          if (tag !== 18) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UserList {
+    return { data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => User.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: UserList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => User.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<UserList>, I>>(base?: I): UserList {
+    return UserList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<UserList>, I>>(object: I): UserList {
+    const message = createBaseUserList();
+    message.data = object.data?.map((e) => User.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseUserQuotaRequest(): UserQuotaRequest {
+  return { deviceQuota: 0 };
+}
+
+export const UserQuotaRequest: MessageFns<UserQuotaRequest> = {
+  encode(message: UserQuotaRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceQuota !== 0) {
+      writer.uint32(8).int32(message.deviceQuota);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UserQuotaRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUserQuotaRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
             break;
           }
 
-          message.createdAt = reader.string();
+          message.deviceQuota = reader.int32();
           continue;
         }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.updatedAt = reader.string();
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UserQuotaRequest {
+    return {
+      deviceQuota: isSet(object.deviceQuota)
+        ? globalThis.Number(object.deviceQuota)
+        : isSet(object.device_quota)
+        ? globalThis.Number(object.device_quota)
+        : 0,
+    };
+  },
+
+  toJSON(message: UserQuotaRequest): unknown {
+    const obj: any = {};
+    if (message.deviceQuota !== 0) {
+      obj.deviceQuota = Math.round(message.deviceQuota);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<UserQuotaRequest>, I>>(base?: I): UserQuotaRequest {
+    return UserQuotaRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<UserQuotaRequest>, I>>(object: I): UserQuotaRequest {
+    const message = createBaseUserQuotaRequest();
+    message.deviceQuota = object.deviceQuota ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceRegisterRequest(): DeviceRegisterRequest {
+  return {
+    token: "",
+    platform: "",
+    name: "",
+    version: "",
+    country: "",
+    packageName: "",
+    locale: "",
+    fingerprint: "",
+    enrollmentCode: "",
+  };
+}
+
+export const DeviceRegisterRequest: MessageFns<DeviceRegisterRequest> = {
+  encode(message: DeviceRegisterRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    if (message.platform !== "") {
+      writer.uint32(18).string(message.platform);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.version !== "") {
+      writer.uint32(34).string(message.version);
+    }
+    if (message.country !== "") {
+      writer.uint32(42).string(message.country);
+    }
+    if (message.packageName !== "") {
+      writer.uint32(50).string(message.packageName);
+    }
+    if (message.locale !== "") {
+      writer.uint32(58).string(message.locale);
+    }
+    if (message.fingerprint !== "") {
+      writer.uint32(66).string(message.fingerprint);
+    }
+    if (message.enrollmentCode !== "") {
+      writer.uint32(74).string(message.enrollmentCode);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceRegisterRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceRegisterRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.platform = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
           continue;
         }
         case 4: {
@@ -795,7 +1599,7 @@ export const Device: MessageFns<Device> = {
             break;
           }
 
-          message.token = reader.string();
+          message.version = reader.string();
           continue;
         }
         case 5: {
@@ -803,7 +1607,7 @@ export const Device: MessageFns<Device> = {
             break;
           }
 
-          message.platform = reader.string();
+          message.country = reader.string();
           continue;
         }
         case 6: {
@@ -811,15 +1615,15 @@ export const Device: MessageFns<Device> = {
             break;
           }
 
-          message.name = reader.string();
+          message.packageName = reader.string();
           continue;
         }
         case 7: {
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.lastSeen = longToNumber(reader.int64());
+          message.locale = reader.string();
           continue;
         }
         case 8: {
@@ -827,7 +1631,7 @@ export const Device: MessageFns<Device> = {
             break;
           }
 
-          message.version = reader.string();
+          message.fingerprint = reader.string();
           continue;
         }
         case 9: {
@@ -835,7 +1639,7 @@ export const Device: MessageFns<Device> = {
             break;
           }
 
-          message.iptvUrl = reader.string();
+          message.enrollmentCode = reader.string();
           continue;
         }
       }
@@ -847,47 +1651,30 @@ export const Device: MessageFns<Device> = {
     return message;
   },
 
-  fromJSON(object: any): Device {
+  fromJSON(object: any): DeviceRegisterRequest {
     return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
-        : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "",
       token: isSet(object.token) ? globalThis.String(object.token) : "",
       platform: isSet(object.platform) ? globalThis.String(object.platform) : "",
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      lastSeen: isSet(object.lastSeen)
-        ? globalThis.Number(object.lastSeen)
-        : isSet(object.last_seen)
-        ? globalThis.Number(object.last_seen)
-        : 0,
       version: isSet(object.version) ? globalThis.String(object.version) : "",
-      iptvUrl: isSet(object.iptvUrl)
-        ? globalThis.String(object.iptvUrl)
-        : isSet(object.iptv_url)
-        ? globalThis.String(object.iptv_url)
+      country: isSet(object.country) ? globalThis.String(object.country) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      locale: isSet(object.locale) ? globalThis.String(object.locale) : "",
+      fingerprint: isSet(object.fingerprint) ? globalThis.String(object.fingerprint) : "",
+      enrollmentCode: isSet(object.enrollmentCode)
+        ? globalThis.String(object.enrollmentCode)
+        : isSet(object.enrollment_code)
+        ? globalThis.String(object.enrollment_code)
         : "",
     };
   },
 
-  toJSON(message: Device): unknown {
+  toJSON(message: DeviceRegisterRequest): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
-    }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
-    }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
-    }
     if (message.token !== "") {
       obj.token = message.token;
     }
@@ -897,180 +1684,9879 @@ export const Device: MessageFns<Device> = {
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.lastSeen !== 0) {
-      obj.lastSeen = Math.round(message.lastSeen);
-    }
     if (message.version !== "") {
       obj.version = message.version;
     }
-    if (message.iptvUrl !== "") {
-      obj.iptvUrl = message.iptvUrl;
+    if (message.country !== "") {
+      obj.country = message.country;
+    }
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
+    }
+    if (message.locale !== "") {
+      obj.locale = message.locale;
+    }
+    if (message.fingerprint !== "") {
+      obj.fingerprint = message.fingerprint;
+    }
+    if (message.enrollmentCode !== "") {
+      obj.enrollmentCode = message.enrollmentCode;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Device>, I>>(base?: I): Device {
-    return Device.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<DeviceRegisterRequest>, I>>(base?: I): DeviceRegisterRequest {
+    return DeviceRegisterRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Device>, I>>(object: I): Device {
-    const message = createBaseDevice();
-    message.id = object.id ?? 0;
-    message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
+  fromPartial<I extends Exact<DeepPartial<DeviceRegisterRequest>, I>>(object: I): DeviceRegisterRequest {
+    const message = createBaseDeviceRegisterRequest();
     message.token = object.token ?? "";
     message.platform = object.platform ?? "";
     message.name = object.name ?? "";
-    message.lastSeen = object.lastSeen ?? 0;
     message.version = object.version ?? "";
-    message.iptvUrl = object.iptvUrl ?? "";
+    message.country = object.country ?? "";
+    message.packageName = object.packageName ?? "";
+    message.locale = object.locale ?? "";
+    message.fingerprint = object.fingerprint ?? "";
+    message.enrollmentCode = object.enrollmentCode ?? "";
     return message;
   },
 };
 
-function createBaseDeviceList(): DeviceList {
-  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+function createBaseDevice(): Device {
+  return {
+    id: 0,
+    createdAt: "",
+    updatedAt: "",
+    token: "",
+    platform: "",
+    name: "",
+    lastSeen: 0,
+    version: "",
+    iptvUrl: "",
+    country: "",
+    packageName: "",
+    locale: "",
+    secret: "",
+    revokedAt: 0,
+    managerId: 0,
+    managerEmail: "",
+    fingerprint: "",
+    blockedAt: 0,
+    blockReason: "",
+  };
 }
 
-export const DeviceList: MessageFns<DeviceList> = {
-  encode(message: DeviceList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.data) {
-      Device.encode(v!, writer.uint32(10).fork()).join();
+export const Device: MessageFns<Device> = {
+  encode(message: Device, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
     }
-    if (message.page !== 0) {
-      writer.uint32(16).int32(message.page);
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
     }
-    if (message.limit !== 0) {
-      writer.uint32(24).int32(message.limit);
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
     }
-    if (message.total !== 0) {
-      writer.uint32(32).int64(message.total);
+    if (message.token !== "") {
+      writer.uint32(34).string(message.token);
     }
-    if (message.totalPages !== 0) {
-      writer.uint32(40).int32(message.totalPages);
+    if (message.platform !== "") {
+      writer.uint32(42).string(message.platform);
+    }
+    if (message.name !== "") {
+      writer.uint32(50).string(message.name);
+    }
+    if (message.lastSeen !== 0) {
+      writer.uint32(56).int64(message.lastSeen);
+    }
+    if (message.version !== "") {
+      writer.uint32(66).string(message.version);
+    }
+    if (message.iptvUrl !== "") {
+      writer.uint32(74).string(message.iptvUrl);
+    }
+    if (message.country !== "") {
+      writer.uint32(82).string(message.country);
+    }
+    if (message.packageName !== "") {
+      writer.uint32(90).string(message.packageName);
+    }
+    if (message.locale !== "") {
+      writer.uint32(98).string(message.locale);
+    }
+    if (message.secret !== "") {
+      writer.uint32(106).string(message.secret);
+    }
+    if (message.revokedAt !== 0) {
+      writer.uint32(112).int64(message.revokedAt);
+    }
+    if (message.managerId !== 0) {
+      writer.uint32(120).uint32(message.managerId);
+    }
+    if (message.managerEmail !== "") {
+      writer.uint32(130).string(message.managerEmail);
+    }
+    if (message.fingerprint !== "") {
+      writer.uint32(138).string(message.fingerprint);
+    }
+    if (message.blockedAt !== 0) {
+      writer.uint32(144).int64(message.blockedAt);
+    }
+    if (message.blockReason !== "") {
+      writer.uint32(154).string(message.blockReason);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DeviceList {
+  decode(input: BinaryReader | Uint8Array, length?: number): Device {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeviceList();
+    const message = createBaseDevice();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.data.push(Device.decode(reader, reader.uint32()));
+          message.id = reader.uint32();
           continue;
         }
         case 2: {
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.page = reader.int32();
+          message.createdAt = reader.string();
           continue;
         }
         case 3: {
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.limit = reader.int32();
+          message.updatedAt = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.total = longToNumber(reader.int64());
+          message.token = reader.string();
           continue;
         }
         case 5: {
-          if (tag !== 40) {
+          if (tag !== 42) {
             break;
           }
 
-          message.totalPages = reader.int32();
+          message.platform = reader.string();
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.lastSeen = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.iptvUrl = reader.string();
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.country = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.packageName = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.locale = reader.string();
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
+        }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.revokedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
+
+          message.managerId = reader.uint32();
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.managerEmail = reader.string();
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.fingerprint = reader.string();
+          continue;
+        }
+        case 18: {
+          if (tag !== 144) {
+            break;
+          }
+
+          message.blockedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.blockReason = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Device {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      token: isSet(object.token) ? globalThis.String(object.token) : "",
+      platform: isSet(object.platform) ? globalThis.String(object.platform) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      lastSeen: isSet(object.lastSeen)
+        ? globalThis.Number(object.lastSeen)
+        : isSet(object.last_seen)
+        ? globalThis.Number(object.last_seen)
+        : 0,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      iptvUrl: isSet(object.iptvUrl)
+        ? globalThis.String(object.iptvUrl)
+        : isSet(object.iptv_url)
+        ? globalThis.String(object.iptv_url)
+        : "",
+      country: isSet(object.country) ? globalThis.String(object.country) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      locale: isSet(object.locale) ? globalThis.String(object.locale) : "",
+      secret: isSet(object.secret) ? globalThis.String(object.secret) : "",
+      revokedAt: isSet(object.revokedAt)
+        ? globalThis.Number(object.revokedAt)
+        : isSet(object.revoked_at)
+        ? globalThis.Number(object.revoked_at)
+        : 0,
+      managerId: isSet(object.managerId)
+        ? globalThis.Number(object.managerId)
+        : isSet(object.manager_id)
+        ? globalThis.Number(object.manager_id)
+        : 0,
+      managerEmail: isSet(object.managerEmail)
+        ? globalThis.String(object.managerEmail)
+        : isSet(object.manager_email)
+        ? globalThis.String(object.manager_email)
+        : "",
+      fingerprint: isSet(object.fingerprint) ? globalThis.String(object.fingerprint) : "",
+      blockedAt: isSet(object.blockedAt)
+        ? globalThis.Number(object.blockedAt)
+        : isSet(object.blocked_at)
+        ? globalThis.Number(object.blocked_at)
+        : 0,
+      blockReason: isSet(object.blockReason)
+        ? globalThis.String(object.blockReason)
+        : isSet(object.block_reason)
+        ? globalThis.String(object.block_reason)
+        : "",
+    };
+  },
+
+  toJSON(message: Device): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    if (message.platform !== "") {
+      obj.platform = message.platform;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.lastSeen !== 0) {
+      obj.lastSeen = Math.round(message.lastSeen);
+    }
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.iptvUrl !== "") {
+      obj.iptvUrl = message.iptvUrl;
+    }
+    if (message.country !== "") {
+      obj.country = message.country;
+    }
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
+    }
+    if (message.locale !== "") {
+      obj.locale = message.locale;
+    }
+    if (message.secret !== "") {
+      obj.secret = message.secret;
+    }
+    if (message.revokedAt !== 0) {
+      obj.revokedAt = Math.round(message.revokedAt);
+    }
+    if (message.managerId !== 0) {
+      obj.managerId = Math.round(message.managerId);
+    }
+    if (message.managerEmail !== "") {
+      obj.managerEmail = message.managerEmail;
+    }
+    if (message.fingerprint !== "") {
+      obj.fingerprint = message.fingerprint;
+    }
+    if (message.blockedAt !== 0) {
+      obj.blockedAt = Math.round(message.blockedAt);
+    }
+    if (message.blockReason !== "") {
+      obj.blockReason = message.blockReason;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Device>, I>>(base?: I): Device {
+    return Device.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Device>, I>>(object: I): Device {
+    const message = createBaseDevice();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.token = object.token ?? "";
+    message.platform = object.platform ?? "";
+    message.name = object.name ?? "";
+    message.lastSeen = object.lastSeen ?? 0;
+    message.version = object.version ?? "";
+    message.iptvUrl = object.iptvUrl ?? "";
+    message.country = object.country ?? "";
+    message.packageName = object.packageName ?? "";
+    message.locale = object.locale ?? "";
+    message.secret = object.secret ?? "";
+    message.revokedAt = object.revokedAt ?? 0;
+    message.managerId = object.managerId ?? 0;
+    message.managerEmail = object.managerEmail ?? "";
+    message.fingerprint = object.fingerprint ?? "";
+    message.blockedAt = object.blockedAt ?? 0;
+    message.blockReason = object.blockReason ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceList(): DeviceList {
+  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+}
+
+export const DeviceList: MessageFns<DeviceList> = {
+  encode(message: DeviceList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      Device.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.page !== 0) {
+      writer.uint32(16).int32(message.page);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    if (message.total !== 0) {
+      writer.uint32(32).int64(message.total);
+    }
+    if (message.totalPages !== 0) {
+      writer.uint32(40).int32(message.totalPages);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(Device.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.page = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.totalPages = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
   fromJSON(object: any): DeviceList {
     return {
-      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => Device.fromJSON(e)) : [],
-      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
-      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
-      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
-      totalPages: isSet(object.totalPages)
-        ? globalThis.Number(object.totalPages)
-        : isSet(object.total_pages)
-        ? globalThis.Number(object.total_pages)
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => Device.fromJSON(e)) : [],
+      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      totalPages: isSet(object.totalPages)
+        ? globalThis.Number(object.totalPages)
+        : isSet(object.total_pages)
+        ? globalThis.Number(object.total_pages)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => Device.toJSON(e));
+    }
+    if (message.page !== 0) {
+      obj.page = Math.round(message.page);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.totalPages !== 0) {
+      obj.totalPages = Math.round(message.totalPages);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceList>, I>>(base?: I): DeviceList {
+    return DeviceList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceList>, I>>(object: I): DeviceList {
+    const message = createBaseDeviceList();
+    message.data = object.data?.map((e) => Device.fromPartial(e)) || [];
+    message.page = object.page ?? 0;
+    message.limit = object.limit ?? 0;
+    message.total = object.total ?? 0;
+    message.totalPages = object.totalPages ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceUrl(): DeviceUrl {
+  return { url: "" };
+}
+
+export const DeviceUrl: MessageFns<DeviceUrl> = {
+  encode(message: DeviceUrl, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceUrl {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceUrl();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceUrl {
+    return { url: isSet(object.url) ? globalThis.String(object.url) : "" };
+  },
+
+  toJSON(message: DeviceUrl): unknown {
+    const obj: any = {};
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceUrl>, I>>(base?: I): DeviceUrl {
+    return DeviceUrl.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceUrl>, I>>(object: I): DeviceUrl {
+    const message = createBaseDeviceUrl();
+    message.url = object.url ?? "";
+    return message;
+  },
+};
+
+function createBaseHeartbeatRequest(): HeartbeatRequest {
+  return { version: "", packageName: "", networkType: "", content: "" };
+}
+
+export const HeartbeatRequest: MessageFns<HeartbeatRequest> = {
+  encode(message: HeartbeatRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
+    }
+    if (message.packageName !== "") {
+      writer.uint32(18).string(message.packageName);
+    }
+    if (message.networkType !== "") {
+      writer.uint32(26).string(message.networkType);
+    }
+    if (message.content !== "") {
+      writer.uint32(34).string(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HeartbeatRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHeartbeatRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.packageName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.networkType = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HeartbeatRequest {
+    return {
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      networkType: isSet(object.networkType)
+        ? globalThis.String(object.networkType)
+        : isSet(object.network_type)
+        ? globalThis.String(object.network_type)
+        : "",
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+    };
+  },
+
+  toJSON(message: HeartbeatRequest): unknown {
+    const obj: any = {};
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
+    }
+    if (message.networkType !== "") {
+      obj.networkType = message.networkType;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HeartbeatRequest>, I>>(base?: I): HeartbeatRequest {
+    return HeartbeatRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HeartbeatRequest>, I>>(object: I): HeartbeatRequest {
+    const message = createBaseHeartbeatRequest();
+    message.version = object.version ?? "";
+    message.packageName = object.packageName ?? "";
+    message.networkType = object.networkType ?? "";
+    message.content = object.content ?? "";
+    return message;
+  },
+};
+
+function createBaseHeartbeatResponse(): HeartbeatResponse {
+  return { sessionId: 0, serverTime: 0, intervalSeconds: 0 };
+}
+
+export const HeartbeatResponse: MessageFns<HeartbeatResponse> = {
+  encode(message: HeartbeatResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sessionId !== 0) {
+      writer.uint32(8).uint32(message.sessionId);
+    }
+    if (message.serverTime !== 0) {
+      writer.uint32(16).int64(message.serverTime);
+    }
+    if (message.intervalSeconds !== 0) {
+      writer.uint32(24).int32(message.intervalSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HeartbeatResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHeartbeatResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.sessionId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.serverTime = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.intervalSeconds = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HeartbeatResponse {
+    return {
+      sessionId: isSet(object.sessionId)
+        ? globalThis.Number(object.sessionId)
+        : isSet(object.session_id)
+        ? globalThis.Number(object.session_id)
+        : 0,
+      serverTime: isSet(object.serverTime)
+        ? globalThis.Number(object.serverTime)
+        : isSet(object.server_time)
+        ? globalThis.Number(object.server_time)
+        : 0,
+      intervalSeconds: isSet(object.intervalSeconds)
+        ? globalThis.Number(object.intervalSeconds)
+        : isSet(object.interval_seconds)
+        ? globalThis.Number(object.interval_seconds)
+        : 0,
+    };
+  },
+
+  toJSON(message: HeartbeatResponse): unknown {
+    const obj: any = {};
+    if (message.sessionId !== 0) {
+      obj.sessionId = Math.round(message.sessionId);
+    }
+    if (message.serverTime !== 0) {
+      obj.serverTime = Math.round(message.serverTime);
+    }
+    if (message.intervalSeconds !== 0) {
+      obj.intervalSeconds = Math.round(message.intervalSeconds);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HeartbeatResponse>, I>>(base?: I): HeartbeatResponse {
+    return HeartbeatResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HeartbeatResponse>, I>>(object: I): HeartbeatResponse {
+    const message = createBaseHeartbeatResponse();
+    message.sessionId = object.sessionId ?? 0;
+    message.serverTime = object.serverTime ?? 0;
+    message.intervalSeconds = object.intervalSeconds ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceSession(): DeviceSession {
+  return {
+    id: 0,
+    deviceId: 0,
+    deviceName: "",
+    startedAt: 0,
+    lastHeartbeatAt: 0,
+    endedAt: 0,
+    version: "",
+    packageName: "",
+    networkType: "",
+    content: "",
+  };
+}
+
+export const DeviceSession: MessageFns<DeviceSession> = {
+  encode(message: DeviceSession, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(16).uint32(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      writer.uint32(26).string(message.deviceName);
+    }
+    if (message.startedAt !== 0) {
+      writer.uint32(32).int64(message.startedAt);
+    }
+    if (message.lastHeartbeatAt !== 0) {
+      writer.uint32(40).int64(message.lastHeartbeatAt);
+    }
+    if (message.endedAt !== 0) {
+      writer.uint32(48).int64(message.endedAt);
+    }
+    if (message.version !== "") {
+      writer.uint32(58).string(message.version);
+    }
+    if (message.packageName !== "") {
+      writer.uint32(66).string(message.packageName);
+    }
+    if (message.networkType !== "") {
+      writer.uint32(74).string(message.networkType);
+    }
+    if (message.content !== "") {
+      writer.uint32(82).string(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceSession {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceSession();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.deviceName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.startedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.lastHeartbeatAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.endedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.packageName = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.networkType = reader.string();
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceSession {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      deviceName: isSet(object.deviceName)
+        ? globalThis.String(object.deviceName)
+        : isSet(object.device_name)
+        ? globalThis.String(object.device_name)
+        : "",
+      startedAt: isSet(object.startedAt)
+        ? globalThis.Number(object.startedAt)
+        : isSet(object.started_at)
+        ? globalThis.Number(object.started_at)
+        : 0,
+      lastHeartbeatAt: isSet(object.lastHeartbeatAt)
+        ? globalThis.Number(object.lastHeartbeatAt)
+        : isSet(object.last_heartbeat_at)
+        ? globalThis.Number(object.last_heartbeat_at)
+        : 0,
+      endedAt: isSet(object.endedAt)
+        ? globalThis.Number(object.endedAt)
+        : isSet(object.ended_at)
+        ? globalThis.Number(object.ended_at)
+        : 0,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      networkType: isSet(object.networkType)
+        ? globalThis.String(object.networkType)
+        : isSet(object.network_type)
+        ? globalThis.String(object.network_type)
+        : "",
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+    };
+  },
+
+  toJSON(message: DeviceSession): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      obj.deviceName = message.deviceName;
+    }
+    if (message.startedAt !== 0) {
+      obj.startedAt = Math.round(message.startedAt);
+    }
+    if (message.lastHeartbeatAt !== 0) {
+      obj.lastHeartbeatAt = Math.round(message.lastHeartbeatAt);
+    }
+    if (message.endedAt !== 0) {
+      obj.endedAt = Math.round(message.endedAt);
+    }
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
+    }
+    if (message.networkType !== "") {
+      obj.networkType = message.networkType;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceSession>, I>>(base?: I): DeviceSession {
+    return DeviceSession.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceSession>, I>>(object: I): DeviceSession {
+    const message = createBaseDeviceSession();
+    message.id = object.id ?? 0;
+    message.deviceId = object.deviceId ?? 0;
+    message.deviceName = object.deviceName ?? "";
+    message.startedAt = object.startedAt ?? 0;
+    message.lastHeartbeatAt = object.lastHeartbeatAt ?? 0;
+    message.endedAt = object.endedAt ?? 0;
+    message.version = object.version ?? "";
+    message.packageName = object.packageName ?? "";
+    message.networkType = object.networkType ?? "";
+    message.content = object.content ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceSessionList(): DeviceSessionList {
+  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+}
+
+export const DeviceSessionList: MessageFns<DeviceSessionList> = {
+  encode(message: DeviceSessionList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DeviceSession.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.page !== 0) {
+      writer.uint32(16).int32(message.page);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    if (message.total !== 0) {
+      writer.uint32(32).int64(message.total);
+    }
+    if (message.totalPages !== 0) {
+      writer.uint32(40).int32(message.totalPages);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceSessionList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceSessionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DeviceSession.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.page = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.totalPages = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceSessionList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DeviceSession.fromJSON(e)) : [],
+      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      totalPages: isSet(object.totalPages)
+        ? globalThis.Number(object.totalPages)
+        : isSet(object.total_pages)
+        ? globalThis.Number(object.total_pages)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceSessionList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DeviceSession.toJSON(e));
+    }
+    if (message.page !== 0) {
+      obj.page = Math.round(message.page);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.totalPages !== 0) {
+      obj.totalPages = Math.round(message.totalPages);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceSessionList>, I>>(base?: I): DeviceSessionList {
+    return DeviceSessionList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceSessionList>, I>>(object: I): DeviceSessionList {
+    const message = createBaseDeviceSessionList();
+    message.data = object.data?.map((e) => DeviceSession.fromPartial(e)) || [];
+    message.page = object.page ?? 0;
+    message.limit = object.limit ?? 0;
+    message.total = object.total ?? 0;
+    message.totalPages = object.totalPages ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceUptime(): DeviceUptime {
+  return { deviceId: 0, from: 0, to: 0, uptimeSeconds: 0, uptimeRatio: 0, sessions: [] };
+}
+
+export const DeviceUptime: MessageFns<DeviceUptime> = {
+  encode(message: DeviceUptime, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceId !== 0) {
+      writer.uint32(8).uint32(message.deviceId);
+    }
+    if (message.from !== 0) {
+      writer.uint32(16).int64(message.from);
+    }
+    if (message.to !== 0) {
+      writer.uint32(24).int64(message.to);
+    }
+    if (message.uptimeSeconds !== 0) {
+      writer.uint32(32).int64(message.uptimeSeconds);
+    }
+    if (message.uptimeRatio !== 0) {
+      writer.uint32(41).double(message.uptimeRatio);
+    }
+    for (const v of message.sessions) {
+      DeviceSession.encode(v!, writer.uint32(50).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceUptime {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceUptime();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.from = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.to = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.uptimeSeconds = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.uptimeRatio = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.sessions.push(DeviceSession.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceUptime {
+    return {
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      from: isSet(object.from) ? globalThis.Number(object.from) : 0,
+      to: isSet(object.to) ? globalThis.Number(object.to) : 0,
+      uptimeSeconds: isSet(object.uptimeSeconds)
+        ? globalThis.Number(object.uptimeSeconds)
+        : isSet(object.uptime_seconds)
+        ? globalThis.Number(object.uptime_seconds)
+        : 0,
+      uptimeRatio: isSet(object.uptimeRatio)
+        ? globalThis.Number(object.uptimeRatio)
+        : isSet(object.uptime_ratio)
+        ? globalThis.Number(object.uptime_ratio)
+        : 0,
+      sessions: globalThis.Array.isArray(object?.sessions)
+        ? object.sessions.map((e: any) => DeviceSession.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceUptime): unknown {
+    const obj: any = {};
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.from !== 0) {
+      obj.from = Math.round(message.from);
+    }
+    if (message.to !== 0) {
+      obj.to = Math.round(message.to);
+    }
+    if (message.uptimeSeconds !== 0) {
+      obj.uptimeSeconds = Math.round(message.uptimeSeconds);
+    }
+    if (message.uptimeRatio !== 0) {
+      obj.uptimeRatio = message.uptimeRatio;
+    }
+    if (message.sessions?.length) {
+      obj.sessions = message.sessions.map((e) => DeviceSession.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceUptime>, I>>(base?: I): DeviceUptime {
+    return DeviceUptime.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceUptime>, I>>(object: I): DeviceUptime {
+    const message = createBaseDeviceUptime();
+    message.deviceId = object.deviceId ?? 0;
+    message.from = object.from ?? 0;
+    message.to = object.to ?? 0;
+    message.uptimeSeconds = object.uptimeSeconds ?? 0;
+    message.uptimeRatio = object.uptimeRatio ?? 0;
+    message.sessions = object.sessions?.map((e) => DeviceSession.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseBlockDeviceRequest(): BlockDeviceRequest {
+  return { reason: "" };
+}
+
+export const BlockDeviceRequest: MessageFns<BlockDeviceRequest> = {
+  encode(message: BlockDeviceRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.reason !== "") {
+      writer.uint32(10).string(message.reason);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BlockDeviceRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBlockDeviceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.reason = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BlockDeviceRequest {
+    return { reason: isSet(object.reason) ? globalThis.String(object.reason) : "" };
+  },
+
+  toJSON(message: BlockDeviceRequest): unknown {
+    const obj: any = {};
+    if (message.reason !== "") {
+      obj.reason = message.reason;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<BlockDeviceRequest>, I>>(base?: I): BlockDeviceRequest {
+    return BlockDeviceRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<BlockDeviceRequest>, I>>(object: I): BlockDeviceRequest {
+    const message = createBaseBlockDeviceRequest();
+    message.reason = object.reason ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceAuditEntry(): DeviceAuditEntry {
+  return { id: 0, createdAt: "", deviceId: 0, userId: 0, userEmail: "", action: "", reason: "" };
+}
+
+export const DeviceAuditEntry: MessageFns<DeviceAuditEntry> = {
+  encode(message: DeviceAuditEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(24).uint32(message.deviceId);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(32).uint32(message.userId);
+    }
+    if (message.userEmail !== "") {
+      writer.uint32(42).string(message.userEmail);
+    }
+    if (message.action !== "") {
+      writer.uint32(50).string(message.action);
+    }
+    if (message.reason !== "") {
+      writer.uint32(58).string(message.reason);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceAuditEntry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceAuditEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.userId = reader.uint32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.userEmail = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.action = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.reason = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceAuditEntry {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      userId: isSet(object.userId)
+        ? globalThis.Number(object.userId)
+        : isSet(object.user_id)
+        ? globalThis.Number(object.user_id)
+        : 0,
+      userEmail: isSet(object.userEmail)
+        ? globalThis.String(object.userEmail)
+        : isSet(object.user_email)
+        ? globalThis.String(object.user_email)
+        : "",
+      action: isSet(object.action) ? globalThis.String(object.action) : "",
+      reason: isSet(object.reason) ? globalThis.String(object.reason) : "",
+    };
+  },
+
+  toJSON(message: DeviceAuditEntry): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.userId !== 0) {
+      obj.userId = Math.round(message.userId);
+    }
+    if (message.userEmail !== "") {
+      obj.userEmail = message.userEmail;
+    }
+    if (message.action !== "") {
+      obj.action = message.action;
+    }
+    if (message.reason !== "") {
+      obj.reason = message.reason;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceAuditEntry>, I>>(base?: I): DeviceAuditEntry {
+    return DeviceAuditEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceAuditEntry>, I>>(object: I): DeviceAuditEntry {
+    const message = createBaseDeviceAuditEntry();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.userId = object.userId ?? 0;
+    message.userEmail = object.userEmail ?? "";
+    message.action = object.action ?? "";
+    message.reason = object.reason ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceAuditList(): DeviceAuditList {
+  return { data: [] };
+}
+
+export const DeviceAuditList: MessageFns<DeviceAuditList> = {
+  encode(message: DeviceAuditList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DeviceAuditEntry.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceAuditList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceAuditList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DeviceAuditEntry.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceAuditList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DeviceAuditEntry.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: DeviceAuditList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DeviceAuditEntry.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceAuditList>, I>>(base?: I): DeviceAuditList {
+    return DeviceAuditList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceAuditList>, I>>(object: I): DeviceAuditList {
+    const message = createBaseDeviceAuditList();
+    message.data = object.data?.map((e) => DeviceAuditEntry.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDuplicateDeviceGroup(): DuplicateDeviceGroup {
+  return { fingerprint: "", devices: [] };
+}
+
+export const DuplicateDeviceGroup: MessageFns<DuplicateDeviceGroup> = {
+  encode(message: DuplicateDeviceGroup, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.fingerprint !== "") {
+      writer.uint32(10).string(message.fingerprint);
+    }
+    for (const v of message.devices) {
+      Device.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DuplicateDeviceGroup {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDuplicateDeviceGroup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fingerprint = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.devices.push(Device.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DuplicateDeviceGroup {
+    return {
+      fingerprint: isSet(object.fingerprint) ? globalThis.String(object.fingerprint) : "",
+      devices: globalThis.Array.isArray(object?.devices) ? object.devices.map((e: any) => Device.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: DuplicateDeviceGroup): unknown {
+    const obj: any = {};
+    if (message.fingerprint !== "") {
+      obj.fingerprint = message.fingerprint;
+    }
+    if (message.devices?.length) {
+      obj.devices = message.devices.map((e) => Device.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DuplicateDeviceGroup>, I>>(base?: I): DuplicateDeviceGroup {
+    return DuplicateDeviceGroup.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DuplicateDeviceGroup>, I>>(object: I): DuplicateDeviceGroup {
+    const message = createBaseDuplicateDeviceGroup();
+    message.fingerprint = object.fingerprint ?? "";
+    message.devices = object.devices?.map((e) => Device.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDuplicateDeviceGroupList(): DuplicateDeviceGroupList {
+  return { data: [] };
+}
+
+export const DuplicateDeviceGroupList: MessageFns<DuplicateDeviceGroupList> = {
+  encode(message: DuplicateDeviceGroupList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DuplicateDeviceGroup.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DuplicateDeviceGroupList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDuplicateDeviceGroupList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DuplicateDeviceGroup.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DuplicateDeviceGroupList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DuplicateDeviceGroup.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: DuplicateDeviceGroupList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DuplicateDeviceGroup.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DuplicateDeviceGroupList>, I>>(base?: I): DuplicateDeviceGroupList {
+    return DuplicateDeviceGroupList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DuplicateDeviceGroupList>, I>>(object: I): DuplicateDeviceGroupList {
+    const message = createBaseDuplicateDeviceGroupList();
+    message.data = object.data?.map((e) => DuplicateDeviceGroup.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseEnrollmentCodeRequest(): EnrollmentCodeRequest {
+  return { ttlMinutes: 0 };
+}
+
+export const EnrollmentCodeRequest: MessageFns<EnrollmentCodeRequest> = {
+  encode(message: EnrollmentCodeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.ttlMinutes !== 0) {
+      writer.uint32(8).int32(message.ttlMinutes);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EnrollmentCodeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnrollmentCodeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.ttlMinutes = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnrollmentCodeRequest {
+    return {
+      ttlMinutes: isSet(object.ttlMinutes)
+        ? globalThis.Number(object.ttlMinutes)
+        : isSet(object.ttl_minutes)
+        ? globalThis.Number(object.ttl_minutes)
+        : 0,
+    };
+  },
+
+  toJSON(message: EnrollmentCodeRequest): unknown {
+    const obj: any = {};
+    if (message.ttlMinutes !== 0) {
+      obj.ttlMinutes = Math.round(message.ttlMinutes);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EnrollmentCodeRequest>, I>>(base?: I): EnrollmentCodeRequest {
+    return EnrollmentCodeRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EnrollmentCodeRequest>, I>>(object: I): EnrollmentCodeRequest {
+    const message = createBaseEnrollmentCodeRequest();
+    message.ttlMinutes = object.ttlMinutes ?? 0;
+    return message;
+  },
+};
+
+function createBaseEnrollmentCode(): EnrollmentCode {
+  return { id: 0, createdAt: "", code: "", expiresAt: 0, redeemedAt: 0, deviceId: 0, deviceName: "", qrPayload: "" };
+}
+
+export const EnrollmentCode: MessageFns<EnrollmentCode> = {
+  encode(message: EnrollmentCode, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.code !== "") {
+      writer.uint32(26).string(message.code);
+    }
+    if (message.expiresAt !== 0) {
+      writer.uint32(32).int64(message.expiresAt);
+    }
+    if (message.redeemedAt !== 0) {
+      writer.uint32(40).int64(message.redeemedAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(48).uint32(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      writer.uint32(58).string(message.deviceName);
+    }
+    if (message.qrPayload !== "") {
+      writer.uint32(66).string(message.qrPayload);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EnrollmentCode {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnrollmentCode();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.expiresAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.redeemedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.deviceName = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.qrPayload = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnrollmentCode {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      code: isSet(object.code) ? globalThis.String(object.code) : "",
+      expiresAt: isSet(object.expiresAt)
+        ? globalThis.Number(object.expiresAt)
+        : isSet(object.expires_at)
+        ? globalThis.Number(object.expires_at)
+        : 0,
+      redeemedAt: isSet(object.redeemedAt)
+        ? globalThis.Number(object.redeemedAt)
+        : isSet(object.redeemed_at)
+        ? globalThis.Number(object.redeemed_at)
+        : 0,
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      deviceName: isSet(object.deviceName)
+        ? globalThis.String(object.deviceName)
+        : isSet(object.device_name)
+        ? globalThis.String(object.device_name)
+        : "",
+      qrPayload: isSet(object.qrPayload)
+        ? globalThis.String(object.qrPayload)
+        : isSet(object.qr_payload)
+        ? globalThis.String(object.qr_payload)
+        : "",
+    };
+  },
+
+  toJSON(message: EnrollmentCode): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.expiresAt !== 0) {
+      obj.expiresAt = Math.round(message.expiresAt);
+    }
+    if (message.redeemedAt !== 0) {
+      obj.redeemedAt = Math.round(message.redeemedAt);
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      obj.deviceName = message.deviceName;
+    }
+    if (message.qrPayload !== "") {
+      obj.qrPayload = message.qrPayload;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EnrollmentCode>, I>>(base?: I): EnrollmentCode {
+    return EnrollmentCode.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EnrollmentCode>, I>>(object: I): EnrollmentCode {
+    const message = createBaseEnrollmentCode();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.code = object.code ?? "";
+    message.expiresAt = object.expiresAt ?? 0;
+    message.redeemedAt = object.redeemedAt ?? 0;
+    message.deviceId = object.deviceId ?? 0;
+    message.deviceName = object.deviceName ?? "";
+    message.qrPayload = object.qrPayload ?? "";
+    return message;
+  },
+};
+
+function createBaseEnrollmentCodeList(): EnrollmentCodeList {
+  return { data: [] };
+}
+
+export const EnrollmentCodeList: MessageFns<EnrollmentCodeList> = {
+  encode(message: EnrollmentCodeList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      EnrollmentCode.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EnrollmentCodeList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnrollmentCodeList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(EnrollmentCode.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnrollmentCodeList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => EnrollmentCode.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: EnrollmentCodeList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => EnrollmentCode.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EnrollmentCodeList>, I>>(base?: I): EnrollmentCodeList {
+    return EnrollmentCodeList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EnrollmentCodeList>, I>>(object: I): EnrollmentCodeList {
+    const message = createBaseEnrollmentCodeList();
+    message.data = object.data?.map((e) => EnrollmentCode.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseEnrollDeviceRequest(): EnrollDeviceRequest {
+  return { code: "" };
+}
+
+export const EnrollDeviceRequest: MessageFns<EnrollDeviceRequest> = {
+  encode(message: EnrollDeviceRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EnrollDeviceRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnrollDeviceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnrollDeviceRequest {
+    return { code: isSet(object.code) ? globalThis.String(object.code) : "" };
+  },
+
+  toJSON(message: EnrollDeviceRequest): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EnrollDeviceRequest>, I>>(base?: I): EnrollDeviceRequest {
+    return EnrollDeviceRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EnrollDeviceRequest>, I>>(object: I): EnrollDeviceRequest {
+    const message = createBaseEnrollDeviceRequest();
+    message.code = object.code ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceCommandRequest(): DeviceCommandRequest {
+  return { type: "", payload: "", ttlMinutes: 0 };
+}
+
+export const DeviceCommandRequest: MessageFns<DeviceCommandRequest> = {
+  encode(message: DeviceCommandRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.type !== "") {
+      writer.uint32(10).string(message.type);
+    }
+    if (message.payload !== "") {
+      writer.uint32(18).string(message.payload);
+    }
+    if (message.ttlMinutes !== 0) {
+      writer.uint32(24).int32(message.ttlMinutes);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceCommandRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceCommandRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.ttlMinutes = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceCommandRequest {
+    return {
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      payload: isSet(object.payload) ? globalThis.String(object.payload) : "",
+      ttlMinutes: isSet(object.ttlMinutes)
+        ? globalThis.Number(object.ttlMinutes)
+        : isSet(object.ttl_minutes)
+        ? globalThis.Number(object.ttl_minutes)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceCommandRequest): unknown {
+    const obj: any = {};
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.payload !== "") {
+      obj.payload = message.payload;
+    }
+    if (message.ttlMinutes !== 0) {
+      obj.ttlMinutes = Math.round(message.ttlMinutes);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceCommandRequest>, I>>(base?: I): DeviceCommandRequest {
+    return DeviceCommandRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceCommandRequest>, I>>(object: I): DeviceCommandRequest {
+    const message = createBaseDeviceCommandRequest();
+    message.type = object.type ?? "";
+    message.payload = object.payload ?? "";
+    message.ttlMinutes = object.ttlMinutes ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceCommand(): DeviceCommand {
+  return {
+    id: 0,
+    createdAt: "",
+    deviceId: 0,
+    type: "",
+    payload: "",
+    status: "",
+    expiresAt: 0,
+    deliveredAt: 0,
+    completedAt: 0,
+    result: "",
+    createdBy: "",
+  };
+}
+
+export const DeviceCommand: MessageFns<DeviceCommand> = {
+  encode(message: DeviceCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(24).uint32(message.deviceId);
+    }
+    if (message.type !== "") {
+      writer.uint32(34).string(message.type);
+    }
+    if (message.payload !== "") {
+      writer.uint32(42).string(message.payload);
+    }
+    if (message.status !== "") {
+      writer.uint32(50).string(message.status);
+    }
+    if (message.expiresAt !== 0) {
+      writer.uint32(56).int64(message.expiresAt);
+    }
+    if (message.deliveredAt !== 0) {
+      writer.uint32(64).int64(message.deliveredAt);
+    }
+    if (message.completedAt !== 0) {
+      writer.uint32(72).int64(message.completedAt);
+    }
+    if (message.result !== "") {
+      writer.uint32(82).string(message.result);
+    }
+    if (message.createdBy !== "") {
+      writer.uint32(90).string(message.createdBy);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.status = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.expiresAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.deliveredAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.completedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.result = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.createdBy = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceCommand {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      payload: isSet(object.payload) ? globalThis.String(object.payload) : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+      expiresAt: isSet(object.expiresAt)
+        ? globalThis.Number(object.expiresAt)
+        : isSet(object.expires_at)
+        ? globalThis.Number(object.expires_at)
+        : 0,
+      deliveredAt: isSet(object.deliveredAt)
+        ? globalThis.Number(object.deliveredAt)
+        : isSet(object.delivered_at)
+        ? globalThis.Number(object.delivered_at)
+        : 0,
+      completedAt: isSet(object.completedAt)
+        ? globalThis.Number(object.completedAt)
+        : isSet(object.completed_at)
+        ? globalThis.Number(object.completed_at)
+        : 0,
+      result: isSet(object.result) ? globalThis.String(object.result) : "",
+      createdBy: isSet(object.createdBy)
+        ? globalThis.String(object.createdBy)
+        : isSet(object.created_by)
+        ? globalThis.String(object.created_by)
+        : "",
+    };
+  },
+
+  toJSON(message: DeviceCommand): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.payload !== "") {
+      obj.payload = message.payload;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    if (message.expiresAt !== 0) {
+      obj.expiresAt = Math.round(message.expiresAt);
+    }
+    if (message.deliveredAt !== 0) {
+      obj.deliveredAt = Math.round(message.deliveredAt);
+    }
+    if (message.completedAt !== 0) {
+      obj.completedAt = Math.round(message.completedAt);
+    }
+    if (message.result !== "") {
+      obj.result = message.result;
+    }
+    if (message.createdBy !== "") {
+      obj.createdBy = message.createdBy;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceCommand>, I>>(base?: I): DeviceCommand {
+    return DeviceCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceCommand>, I>>(object: I): DeviceCommand {
+    const message = createBaseDeviceCommand();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.type = object.type ?? "";
+    message.payload = object.payload ?? "";
+    message.status = object.status ?? "";
+    message.expiresAt = object.expiresAt ?? 0;
+    message.deliveredAt = object.deliveredAt ?? 0;
+    message.completedAt = object.completedAt ?? 0;
+    message.result = object.result ?? "";
+    message.createdBy = object.createdBy ?? "";
+    return message;
+  },
+};
+
+function createBaseDeviceCommandList(): DeviceCommandList {
+  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+}
+
+export const DeviceCommandList: MessageFns<DeviceCommandList> = {
+  encode(message: DeviceCommandList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DeviceCommand.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.page !== 0) {
+      writer.uint32(16).int32(message.page);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    if (message.total !== 0) {
+      writer.uint32(32).int64(message.total);
+    }
+    if (message.totalPages !== 0) {
+      writer.uint32(40).int32(message.totalPages);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceCommandList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceCommandList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DeviceCommand.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.page = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.totalPages = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceCommandList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DeviceCommand.fromJSON(e)) : [],
+      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      totalPages: isSet(object.totalPages)
+        ? globalThis.Number(object.totalPages)
+        : isSet(object.total_pages)
+        ? globalThis.Number(object.total_pages)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceCommandList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DeviceCommand.toJSON(e));
+    }
+    if (message.page !== 0) {
+      obj.page = Math.round(message.page);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.totalPages !== 0) {
+      obj.totalPages = Math.round(message.totalPages);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceCommandList>, I>>(base?: I): DeviceCommandList {
+    return DeviceCommandList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceCommandList>, I>>(object: I): DeviceCommandList {
+    const message = createBaseDeviceCommandList();
+    message.data = object.data?.map((e) => DeviceCommand.fromPartial(e)) || [];
+    message.page = object.page ?? 0;
+    message.limit = object.limit ?? 0;
+    message.total = object.total ?? 0;
+    message.totalPages = object.totalPages ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceCommandAck(): DeviceCommandAck {
+  return { success: false, result: "" };
+}
+
+export const DeviceCommandAck: MessageFns<DeviceCommandAck> = {
+  encode(message: DeviceCommandAck, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.success !== false) {
+      writer.uint32(8).bool(message.success);
+    }
+    if (message.result !== "") {
+      writer.uint32(18).string(message.result);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceCommandAck {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceCommandAck();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.success = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.result = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceCommandAck {
+    return {
+      success: isSet(object.success) ? globalThis.Boolean(object.success) : false,
+      result: isSet(object.result) ? globalThis.String(object.result) : "",
+    };
+  },
+
+  toJSON(message: DeviceCommandAck): unknown {
+    const obj: any = {};
+    if (message.success !== false) {
+      obj.success = message.success;
+    }
+    if (message.result !== "") {
+      obj.result = message.result;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceCommandAck>, I>>(base?: I): DeviceCommandAck {
+    return DeviceCommandAck.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceCommandAck>, I>>(object: I): DeviceCommandAck {
+    const message = createBaseDeviceCommandAck();
+    message.success = object.success ?? false;
+    message.result = object.result ?? "";
+    return message;
+  },
+};
+
+function createBasePlanRequest(): PlanRequest {
+  return { name: "", durationDays: 0, maxTournaments: 0, liveUpdates: false };
+}
+
+export const PlanRequest: MessageFns<PlanRequest> = {
+  encode(message: PlanRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.durationDays !== 0) {
+      writer.uint32(16).int32(message.durationDays);
+    }
+    if (message.maxTournaments !== 0) {
+      writer.uint32(24).int32(message.maxTournaments);
+    }
+    if (message.liveUpdates !== false) {
+      writer.uint32(32).bool(message.liveUpdates);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PlanRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.durationDays = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxTournaments = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.liveUpdates = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      durationDays: isSet(object.durationDays)
+        ? globalThis.Number(object.durationDays)
+        : isSet(object.duration_days)
+        ? globalThis.Number(object.duration_days)
+        : 0,
+      maxTournaments: isSet(object.maxTournaments)
+        ? globalThis.Number(object.maxTournaments)
+        : isSet(object.max_tournaments)
+        ? globalThis.Number(object.max_tournaments)
+        : 0,
+      liveUpdates: isSet(object.liveUpdates)
+        ? globalThis.Boolean(object.liveUpdates)
+        : isSet(object.live_updates)
+        ? globalThis.Boolean(object.live_updates)
+        : false,
+    };
+  },
+
+  toJSON(message: PlanRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.durationDays !== 0) {
+      obj.durationDays = Math.round(message.durationDays);
+    }
+    if (message.maxTournaments !== 0) {
+      obj.maxTournaments = Math.round(message.maxTournaments);
+    }
+    if (message.liveUpdates !== false) {
+      obj.liveUpdates = message.liveUpdates;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PlanRequest>, I>>(base?: I): PlanRequest {
+    return PlanRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PlanRequest>, I>>(object: I): PlanRequest {
+    const message = createBasePlanRequest();
+    message.name = object.name ?? "";
+    message.durationDays = object.durationDays ?? 0;
+    message.maxTournaments = object.maxTournaments ?? 0;
+    message.liveUpdates = object.liveUpdates ?? false;
+    return message;
+  },
+};
+
+function createBasePlan(): Plan {
+  return { id: 0, createdAt: "", name: "", durationDays: 0, maxTournaments: 0, liveUpdates: false };
+}
+
+export const Plan: MessageFns<Plan> = {
+  encode(message: Plan, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.durationDays !== 0) {
+      writer.uint32(32).int32(message.durationDays);
+    }
+    if (message.maxTournaments !== 0) {
+      writer.uint32(40).int32(message.maxTournaments);
+    }
+    if (message.liveUpdates !== false) {
+      writer.uint32(48).bool(message.liveUpdates);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Plan {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.durationDays = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.maxTournaments = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.liveUpdates = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      durationDays: isSet(object.durationDays)
+        ? globalThis.Number(object.durationDays)
+        : isSet(object.duration_days)
+        ? globalThis.Number(object.duration_days)
+        : 0,
+      maxTournaments: isSet(object.maxTournaments)
+        ? globalThis.Number(object.maxTournaments)
+        : isSet(object.max_tournaments)
+        ? globalThis.Number(object.max_tournaments)
+        : 0,
+      liveUpdates: isSet(object.liveUpdates)
+        ? globalThis.Boolean(object.liveUpdates)
+        : isSet(object.live_updates)
+        ? globalThis.Boolean(object.live_updates)
+        : false,
+    };
+  },
+
+  toJSON(message: Plan): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.durationDays !== 0) {
+      obj.durationDays = Math.round(message.durationDays);
+    }
+    if (message.maxTournaments !== 0) {
+      obj.maxTournaments = Math.round(message.maxTournaments);
+    }
+    if (message.liveUpdates !== false) {
+      obj.liveUpdates = message.liveUpdates;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Plan>, I>>(base?: I): Plan {
+    return Plan.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Plan>, I>>(object: I): Plan {
+    const message = createBasePlan();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.name = object.name ?? "";
+    message.durationDays = object.durationDays ?? 0;
+    message.maxTournaments = object.maxTournaments ?? 0;
+    message.liveUpdates = object.liveUpdates ?? false;
+    return message;
+  },
+};
+
+function createBasePlanList(): PlanList {
+  return { data: [] };
+}
+
+export const PlanList: MessageFns<PlanList> = {
+  encode(message: PlanList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      Plan.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PlanList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(Plan.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanList {
+    return { data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => Plan.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: PlanList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => Plan.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PlanList>, I>>(base?: I): PlanList {
+    return PlanList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PlanList>, I>>(object: I): PlanList {
+    const message = createBasePlanList();
+    message.data = object.data?.map((e) => Plan.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSubscriptionRequest(): SubscriptionRequest {
+  return { planId: 0, days: 0 };
+}
+
+export const SubscriptionRequest: MessageFns<SubscriptionRequest> = {
+  encode(message: SubscriptionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.planId !== 0) {
+      writer.uint32(8).uint32(message.planId);
+    }
+    if (message.days !== 0) {
+      writer.uint32(16).int32(message.days);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SubscriptionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSubscriptionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.planId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.days = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SubscriptionRequest {
+    return {
+      planId: isSet(object.planId)
+        ? globalThis.Number(object.planId)
+        : isSet(object.plan_id)
+        ? globalThis.Number(object.plan_id)
+        : 0,
+      days: isSet(object.days) ? globalThis.Number(object.days) : 0,
+    };
+  },
+
+  toJSON(message: SubscriptionRequest): unknown {
+    const obj: any = {};
+    if (message.planId !== 0) {
+      obj.planId = Math.round(message.planId);
+    }
+    if (message.days !== 0) {
+      obj.days = Math.round(message.days);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SubscriptionRequest>, I>>(base?: I): SubscriptionRequest {
+    return SubscriptionRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SubscriptionRequest>, I>>(object: I): SubscriptionRequest {
+    const message = createBaseSubscriptionRequest();
+    message.planId = object.planId ?? 0;
+    message.days = object.days ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceSubscription(): DeviceSubscription {
+  return {
+    id: 0,
+    deviceId: 0,
+    deviceName: "",
+    plan: undefined,
+    startsAt: 0,
+    expiresAt: 0,
+    active: false,
+    expiryFlaggedAt: 0,
+  };
+}
+
+export const DeviceSubscription: MessageFns<DeviceSubscription> = {
+  encode(message: DeviceSubscription, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(16).uint32(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      writer.uint32(26).string(message.deviceName);
+    }
+    if (message.plan !== undefined) {
+      Plan.encode(message.plan, writer.uint32(34).fork()).join();
+    }
+    if (message.startsAt !== 0) {
+      writer.uint32(40).int64(message.startsAt);
+    }
+    if (message.expiresAt !== 0) {
+      writer.uint32(48).int64(message.expiresAt);
+    }
+    if (message.active !== false) {
+      writer.uint32(56).bool(message.active);
+    }
+    if (message.expiryFlaggedAt !== 0) {
+      writer.uint32(64).int64(message.expiryFlaggedAt);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceSubscription {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceSubscription();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.deviceName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.plan = Plan.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.startsAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.expiresAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.active = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.expiryFlaggedAt = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceSubscription {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      deviceName: isSet(object.deviceName)
+        ? globalThis.String(object.deviceName)
+        : isSet(object.device_name)
+        ? globalThis.String(object.device_name)
+        : "",
+      plan: isSet(object.plan) ? Plan.fromJSON(object.plan) : undefined,
+      startsAt: isSet(object.startsAt)
+        ? globalThis.Number(object.startsAt)
+        : isSet(object.starts_at)
+        ? globalThis.Number(object.starts_at)
+        : 0,
+      expiresAt: isSet(object.expiresAt)
+        ? globalThis.Number(object.expiresAt)
+        : isSet(object.expires_at)
+        ? globalThis.Number(object.expires_at)
+        : 0,
+      active: isSet(object.active) ? globalThis.Boolean(object.active) : false,
+      expiryFlaggedAt: isSet(object.expiryFlaggedAt)
+        ? globalThis.Number(object.expiryFlaggedAt)
+        : isSet(object.expiry_flagged_at)
+        ? globalThis.Number(object.expiry_flagged_at)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceSubscription): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.deviceName !== "") {
+      obj.deviceName = message.deviceName;
+    }
+    if (message.plan !== undefined) {
+      obj.plan = Plan.toJSON(message.plan);
+    }
+    if (message.startsAt !== 0) {
+      obj.startsAt = Math.round(message.startsAt);
+    }
+    if (message.expiresAt !== 0) {
+      obj.expiresAt = Math.round(message.expiresAt);
+    }
+    if (message.active !== false) {
+      obj.active = message.active;
+    }
+    if (message.expiryFlaggedAt !== 0) {
+      obj.expiryFlaggedAt = Math.round(message.expiryFlaggedAt);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceSubscription>, I>>(base?: I): DeviceSubscription {
+    return DeviceSubscription.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceSubscription>, I>>(object: I): DeviceSubscription {
+    const message = createBaseDeviceSubscription();
+    message.id = object.id ?? 0;
+    message.deviceId = object.deviceId ?? 0;
+    message.deviceName = object.deviceName ?? "";
+    message.plan = (object.plan !== undefined && object.plan !== null) ? Plan.fromPartial(object.plan) : undefined;
+    message.startsAt = object.startsAt ?? 0;
+    message.expiresAt = object.expiresAt ?? 0;
+    message.active = object.active ?? false;
+    message.expiryFlaggedAt = object.expiryFlaggedAt ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceSubscriptionList(): DeviceSubscriptionList {
+  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+}
+
+export const DeviceSubscriptionList: MessageFns<DeviceSubscriptionList> = {
+  encode(message: DeviceSubscriptionList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DeviceSubscription.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.page !== 0) {
+      writer.uint32(16).int32(message.page);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    if (message.total !== 0) {
+      writer.uint32(32).int64(message.total);
+    }
+    if (message.totalPages !== 0) {
+      writer.uint32(40).int32(message.totalPages);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceSubscriptionList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceSubscriptionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DeviceSubscription.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.page = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.totalPages = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceSubscriptionList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DeviceSubscription.fromJSON(e)) : [],
+      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      totalPages: isSet(object.totalPages)
+        ? globalThis.Number(object.totalPages)
+        : isSet(object.total_pages)
+        ? globalThis.Number(object.total_pages)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceSubscriptionList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DeviceSubscription.toJSON(e));
+    }
+    if (message.page !== 0) {
+      obj.page = Math.round(message.page);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.totalPages !== 0) {
+      obj.totalPages = Math.round(message.totalPages);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceSubscriptionList>, I>>(base?: I): DeviceSubscriptionList {
+    return DeviceSubscriptionList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceSubscriptionList>, I>>(object: I): DeviceSubscriptionList {
+    const message = createBaseDeviceSubscriptionList();
+    message.data = object.data?.map((e) => DeviceSubscription.fromPartial(e)) || [];
+    message.page = object.page ?? 0;
+    message.limit = object.limit ?? 0;
+    message.total = object.total ?? 0;
+    message.totalPages = object.totalPages ?? 0;
+    return message;
+  },
+};
+
+function createBaseSubscriptionError(): SubscriptionError {
+  return { code: "", message: "", expiresAt: 0 };
+}
+
+export const SubscriptionError: MessageFns<SubscriptionError> = {
+  encode(message: SubscriptionError, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    if (message.expiresAt !== 0) {
+      writer.uint32(24).int64(message.expiresAt);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SubscriptionError {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSubscriptionError();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.expiresAt = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SubscriptionError {
+    return {
+      code: isSet(object.code) ? globalThis.String(object.code) : "",
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+      expiresAt: isSet(object.expiresAt)
+        ? globalThis.Number(object.expiresAt)
+        : isSet(object.expires_at)
+        ? globalThis.Number(object.expires_at)
+        : 0,
+    };
+  },
+
+  toJSON(message: SubscriptionError): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    if (message.expiresAt !== 0) {
+      obj.expiresAt = Math.round(message.expiresAt);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SubscriptionError>, I>>(base?: I): SubscriptionError {
+    return SubscriptionError.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SubscriptionError>, I>>(object: I): SubscriptionError {
+    const message = createBaseSubscriptionError();
+    message.code = object.code ?? "";
+    message.message = object.message ?? "";
+    message.expiresAt = object.expiresAt ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceGroupRequest(): DeviceGroupRequest {
+  return {
+    name: "",
+    description: "",
+    priority: 0,
+    rulePackage: "",
+    ruleVersion: "",
+    rulePlatform: "",
+    ruleManagerId: 0,
+  };
+}
+
+export const DeviceGroupRequest: MessageFns<DeviceGroupRequest> = {
+  encode(message: DeviceGroupRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.priority !== 0) {
+      writer.uint32(24).int32(message.priority);
+    }
+    if (message.rulePackage !== "") {
+      writer.uint32(34).string(message.rulePackage);
+    }
+    if (message.ruleVersion !== "") {
+      writer.uint32(42).string(message.ruleVersion);
+    }
+    if (message.rulePlatform !== "") {
+      writer.uint32(50).string(message.rulePlatform);
+    }
+    if (message.ruleManagerId !== 0) {
+      writer.uint32(56).uint32(message.ruleManagerId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroupRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.rulePackage = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.ruleVersion = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.rulePlatform = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.ruleManagerId = reader.uint32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroupRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      rulePackage: isSet(object.rulePackage)
+        ? globalThis.String(object.rulePackage)
+        : isSet(object.rule_package)
+        ? globalThis.String(object.rule_package)
+        : "",
+      ruleVersion: isSet(object.ruleVersion)
+        ? globalThis.String(object.ruleVersion)
+        : isSet(object.rule_version)
+        ? globalThis.String(object.rule_version)
+        : "",
+      rulePlatform: isSet(object.rulePlatform)
+        ? globalThis.String(object.rulePlatform)
+        : isSet(object.rule_platform)
+        ? globalThis.String(object.rule_platform)
+        : "",
+      ruleManagerId: isSet(object.ruleManagerId)
+        ? globalThis.Number(object.ruleManagerId)
+        : isSet(object.rule_manager_id)
+        ? globalThis.Number(object.rule_manager_id)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceGroupRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.rulePackage !== "") {
+      obj.rulePackage = message.rulePackage;
+    }
+    if (message.ruleVersion !== "") {
+      obj.ruleVersion = message.ruleVersion;
+    }
+    if (message.rulePlatform !== "") {
+      obj.rulePlatform = message.rulePlatform;
+    }
+    if (message.ruleManagerId !== 0) {
+      obj.ruleManagerId = Math.round(message.ruleManagerId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroupRequest>, I>>(base?: I): DeviceGroupRequest {
+    return DeviceGroupRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroupRequest>, I>>(object: I): DeviceGroupRequest {
+    const message = createBaseDeviceGroupRequest();
+    message.name = object.name ?? "";
+    message.description = object.description ?? "";
+    message.priority = object.priority ?? 0;
+    message.rulePackage = object.rulePackage ?? "";
+    message.ruleVersion = object.ruleVersion ?? "";
+    message.rulePlatform = object.rulePlatform ?? "";
+    message.ruleManagerId = object.ruleManagerId ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceGroup(): DeviceGroup {
+  return {
+    id: 0,
+    createdAt: "",
+    name: "",
+    description: "",
+    priority: 0,
+    deviceCount: 0,
+    rulePackage: "",
+    ruleVersion: "",
+    rulePlatform: "",
+    ruleManagerId: 0,
+  };
+}
+
+export const DeviceGroup: MessageFns<DeviceGroup> = {
+  encode(message: DeviceGroup, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    if (message.priority !== 0) {
+      writer.uint32(40).int32(message.priority);
+    }
+    if (message.deviceCount !== 0) {
+      writer.uint32(48).int64(message.deviceCount);
+    }
+    if (message.rulePackage !== "") {
+      writer.uint32(58).string(message.rulePackage);
+    }
+    if (message.ruleVersion !== "") {
+      writer.uint32(66).string(message.ruleVersion);
+    }
+    if (message.rulePlatform !== "") {
+      writer.uint32(74).string(message.rulePlatform);
+    }
+    if (message.ruleManagerId !== 0) {
+      writer.uint32(80).uint32(message.ruleManagerId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroup {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.deviceCount = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.rulePackage = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.ruleVersion = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.rulePlatform = reader.string();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.ruleManagerId = reader.uint32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroup {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      deviceCount: isSet(object.deviceCount)
+        ? globalThis.Number(object.deviceCount)
+        : isSet(object.device_count)
+        ? globalThis.Number(object.device_count)
+        : 0,
+      rulePackage: isSet(object.rulePackage)
+        ? globalThis.String(object.rulePackage)
+        : isSet(object.rule_package)
+        ? globalThis.String(object.rule_package)
+        : "",
+      ruleVersion: isSet(object.ruleVersion)
+        ? globalThis.String(object.ruleVersion)
+        : isSet(object.rule_version)
+        ? globalThis.String(object.rule_version)
+        : "",
+      rulePlatform: isSet(object.rulePlatform)
+        ? globalThis.String(object.rulePlatform)
+        : isSet(object.rule_platform)
+        ? globalThis.String(object.rule_platform)
+        : "",
+      ruleManagerId: isSet(object.ruleManagerId)
+        ? globalThis.Number(object.ruleManagerId)
+        : isSet(object.rule_manager_id)
+        ? globalThis.Number(object.rule_manager_id)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceGroup): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.deviceCount !== 0) {
+      obj.deviceCount = Math.round(message.deviceCount);
+    }
+    if (message.rulePackage !== "") {
+      obj.rulePackage = message.rulePackage;
+    }
+    if (message.ruleVersion !== "") {
+      obj.ruleVersion = message.ruleVersion;
+    }
+    if (message.rulePlatform !== "") {
+      obj.rulePlatform = message.rulePlatform;
+    }
+    if (message.ruleManagerId !== 0) {
+      obj.ruleManagerId = Math.round(message.ruleManagerId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroup>, I>>(base?: I): DeviceGroup {
+    return DeviceGroup.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroup>, I>>(object: I): DeviceGroup {
+    const message = createBaseDeviceGroup();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.name = object.name ?? "";
+    message.description = object.description ?? "";
+    message.priority = object.priority ?? 0;
+    message.deviceCount = object.deviceCount ?? 0;
+    message.rulePackage = object.rulePackage ?? "";
+    message.ruleVersion = object.ruleVersion ?? "";
+    message.rulePlatform = object.rulePlatform ?? "";
+    message.ruleManagerId = object.ruleManagerId ?? 0;
+    return message;
+  },
+};
+
+function createBaseDeviceGroupList(): DeviceGroupList {
+  return { data: [] };
+}
+
+export const DeviceGroupList: MessageFns<DeviceGroupList> = {
+  encode(message: DeviceGroupList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      DeviceGroup.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroupList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroupList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(DeviceGroup.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroupList {
+    return { data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => DeviceGroup.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: DeviceGroupList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => DeviceGroup.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroupList>, I>>(base?: I): DeviceGroupList {
+    return DeviceGroupList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroupList>, I>>(object: I): DeviceGroupList {
+    const message = createBaseDeviceGroupList();
+    message.data = object.data?.map((e) => DeviceGroup.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceGroupMembersRequest(): DeviceGroupMembersRequest {
+  return { deviceIds: [] };
+}
+
+export const DeviceGroupMembersRequest: MessageFns<DeviceGroupMembersRequest> = {
+  encode(message: DeviceGroupMembersRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    writer.uint32(10).fork();
+    for (const v of message.deviceIds) {
+      writer.uint32(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroupMembersRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroupMembersRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag === 8) {
+            message.deviceIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.deviceIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroupMembersRequest {
+    return {
+      deviceIds: globalThis.Array.isArray(object?.deviceIds)
+        ? object.deviceIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.device_ids)
+        ? object.device_ids.map((e: any) => globalThis.Number(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceGroupMembersRequest): unknown {
+    const obj: any = {};
+    if (message.deviceIds?.length) {
+      obj.deviceIds = message.deviceIds.map((e) => Math.round(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroupMembersRequest>, I>>(base?: I): DeviceGroupMembersRequest {
+    return DeviceGroupMembersRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroupMembersRequest>, I>>(object: I): DeviceGroupMembersRequest {
+    const message = createBaseDeviceGroupMembersRequest();
+    message.deviceIds = object.deviceIds?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceGroupMembersResult(): DeviceGroupMembersResult {
+  return { affected: 0, rejectedDeviceIds: [] };
+}
+
+export const DeviceGroupMembersResult: MessageFns<DeviceGroupMembersResult> = {
+  encode(message: DeviceGroupMembersResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.affected !== 0) {
+      writer.uint32(8).int64(message.affected);
+    }
+    writer.uint32(18).fork();
+    for (const v of message.rejectedDeviceIds) {
+      writer.uint32(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroupMembersResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroupMembersResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.affected = longToNumber(reader.int64());
+          continue;
+        }
+        case 2: {
+          if (tag === 16) {
+            message.rejectedDeviceIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.rejectedDeviceIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroupMembersResult {
+    return {
+      affected: isSet(object.affected) ? globalThis.Number(object.affected) : 0,
+      rejectedDeviceIds: globalThis.Array.isArray(object?.rejectedDeviceIds)
+        ? object.rejectedDeviceIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.rejected_device_ids)
+        ? object.rejected_device_ids.map((e: any) => globalThis.Number(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceGroupMembersResult): unknown {
+    const obj: any = {};
+    if (message.affected !== 0) {
+      obj.affected = Math.round(message.affected);
+    }
+    if (message.rejectedDeviceIds?.length) {
+      obj.rejectedDeviceIds = message.rejectedDeviceIds.map((e) => Math.round(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroupMembersResult>, I>>(base?: I): DeviceGroupMembersResult {
+    return DeviceGroupMembersResult.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroupMembersResult>, I>>(object: I): DeviceGroupMembersResult {
+    const message = createBaseDeviceGroupMembersResult();
+    message.affected = object.affected ?? 0;
+    message.rejectedDeviceIds = object.rejectedDeviceIds?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceGroupTournamentList(): DeviceGroupTournamentList {
+  return { deviceGroupId: 0, tournaments: [] };
+}
+
+export const DeviceGroupTournamentList: MessageFns<DeviceGroupTournamentList> = {
+  encode(message: DeviceGroupTournamentList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceGroupId !== 0) {
+      writer.uint32(8).uint32(message.deviceGroupId);
+    }
+    for (const v of message.tournaments) {
+      Tournament.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceGroupTournamentList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceGroupTournamentList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.deviceGroupId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tournaments.push(Tournament.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceGroupTournamentList {
+    return {
+      deviceGroupId: isSet(object.deviceGroupId)
+        ? globalThis.Number(object.deviceGroupId)
+        : isSet(object.device_group_id)
+        ? globalThis.Number(object.device_group_id)
+        : 0,
+      tournaments: globalThis.Array.isArray(object?.tournaments)
+        ? object.tournaments.map((e: any) => Tournament.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceGroupTournamentList): unknown {
+    const obj: any = {};
+    if (message.deviceGroupId !== 0) {
+      obj.deviceGroupId = Math.round(message.deviceGroupId);
+    }
+    if (message.tournaments?.length) {
+      obj.tournaments = message.tournaments.map((e) => Tournament.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceGroupTournamentList>, I>>(base?: I): DeviceGroupTournamentList {
+    return DeviceGroupTournamentList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceGroupTournamentList>, I>>(object: I): DeviceGroupTournamentList {
+    const message = createBaseDeviceGroupTournamentList();
+    message.deviceGroupId = object.deviceGroupId ?? 0;
+    message.tournaments = object.tournaments?.map((e) => Tournament.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseConfigKeyRequest(): ConfigKeyRequest {
+  return { key: "", type: "", defaultValue: "", description: "" };
+}
+
+export const ConfigKeyRequest: MessageFns<ConfigKeyRequest> = {
+  encode(message: ConfigKeyRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.defaultValue !== "") {
+      writer.uint32(26).string(message.defaultValue);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigKeyRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigKeyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.defaultValue = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigKeyRequest {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      defaultValue: isSet(object.defaultValue)
+        ? globalThis.String(object.defaultValue)
+        : isSet(object.default_value)
+        ? globalThis.String(object.default_value)
+        : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+    };
+  },
+
+  toJSON(message: ConfigKeyRequest): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.defaultValue !== "") {
+      obj.defaultValue = message.defaultValue;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigKeyRequest>, I>>(base?: I): ConfigKeyRequest {
+    return ConfigKeyRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigKeyRequest>, I>>(object: I): ConfigKeyRequest {
+    const message = createBaseConfigKeyRequest();
+    message.key = object.key ?? "";
+    message.type = object.type ?? "";
+    message.defaultValue = object.defaultValue ?? "";
+    message.description = object.description ?? "";
+    return message;
+  },
+};
+
+function createBaseConfigKey(): ConfigKey {
+  return { id: 0, createdAt: "", updatedAt: "", key: "", type: "", defaultValue: "", description: "" };
+}
+
+export const ConfigKey: MessageFns<ConfigKey> = {
+  encode(message: ConfigKey, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.key !== "") {
+      writer.uint32(34).string(message.key);
+    }
+    if (message.type !== "") {
+      writer.uint32(42).string(message.type);
+    }
+    if (message.defaultValue !== "") {
+      writer.uint32(50).string(message.defaultValue);
+    }
+    if (message.description !== "") {
+      writer.uint32(58).string(message.description);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigKey {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigKey();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.defaultValue = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigKey {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      defaultValue: isSet(object.defaultValue)
+        ? globalThis.String(object.defaultValue)
+        : isSet(object.default_value)
+        ? globalThis.String(object.default_value)
+        : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+    };
+  },
+
+  toJSON(message: ConfigKey): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.defaultValue !== "") {
+      obj.defaultValue = message.defaultValue;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigKey>, I>>(base?: I): ConfigKey {
+    return ConfigKey.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigKey>, I>>(object: I): ConfigKey {
+    const message = createBaseConfigKey();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.key = object.key ?? "";
+    message.type = object.type ?? "";
+    message.defaultValue = object.defaultValue ?? "";
+    message.description = object.description ?? "";
+    return message;
+  },
+};
+
+function createBaseConfigKeyList(): ConfigKeyList {
+  return { data: [] };
+}
+
+export const ConfigKeyList: MessageFns<ConfigKeyList> = {
+  encode(message: ConfigKeyList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      ConfigKey.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigKeyList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigKeyList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(ConfigKey.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigKeyList {
+    return { data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => ConfigKey.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: ConfigKeyList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => ConfigKey.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigKeyList>, I>>(base?: I): ConfigKeyList {
+    return ConfigKeyList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigKeyList>, I>>(object: I): ConfigKeyList {
+    const message = createBaseConfigKeyList();
+    message.data = object.data?.map((e) => ConfigKey.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseConfigValueRequest(): ConfigValueRequest {
+  return { key: "", scope: "", scopeId: "", value: "" };
+}
+
+export const ConfigValueRequest: MessageFns<ConfigValueRequest> = {
+  encode(message: ConfigValueRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.scope !== "") {
+      writer.uint32(18).string(message.scope);
+    }
+    if (message.scopeId !== "") {
+      writer.uint32(26).string(message.scopeId);
+    }
+    if (message.value !== "") {
+      writer.uint32(34).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigValueRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigValueRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.scope = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.scopeId = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigValueRequest {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      scope: isSet(object.scope) ? globalThis.String(object.scope) : "",
+      scopeId: isSet(object.scopeId)
+        ? globalThis.String(object.scopeId)
+        : isSet(object.scope_id)
+        ? globalThis.String(object.scope_id)
+        : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: ConfigValueRequest): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.scope !== "") {
+      obj.scope = message.scope;
+    }
+    if (message.scopeId !== "") {
+      obj.scopeId = message.scopeId;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigValueRequest>, I>>(base?: I): ConfigValueRequest {
+    return ConfigValueRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigValueRequest>, I>>(object: I): ConfigValueRequest {
+    const message = createBaseConfigValueRequest();
+    message.key = object.key ?? "";
+    message.scope = object.scope ?? "";
+    message.scopeId = object.scopeId ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseConfigValue(): ConfigValue {
+  return { id: 0, updatedAt: "", key: "", scope: "", scopeId: "", value: "" };
+}
+
+export const ConfigValue: MessageFns<ConfigValue> = {
+  encode(message: ConfigValue, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(18).string(message.updatedAt);
+    }
+    if (message.key !== "") {
+      writer.uint32(26).string(message.key);
+    }
+    if (message.scope !== "") {
+      writer.uint32(34).string(message.scope);
+    }
+    if (message.scopeId !== "") {
+      writer.uint32(42).string(message.scopeId);
+    }
+    if (message.value !== "") {
+      writer.uint32(50).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigValue {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigValue();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.scope = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.scopeId = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigValue {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      scope: isSet(object.scope) ? globalThis.String(object.scope) : "",
+      scopeId: isSet(object.scopeId)
+        ? globalThis.String(object.scopeId)
+        : isSet(object.scope_id)
+        ? globalThis.String(object.scope_id)
+        : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: ConfigValue): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.scope !== "") {
+      obj.scope = message.scope;
+    }
+    if (message.scopeId !== "") {
+      obj.scopeId = message.scopeId;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigValue>, I>>(base?: I): ConfigValue {
+    return ConfigValue.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigValue>, I>>(object: I): ConfigValue {
+    const message = createBaseConfigValue();
+    message.id = object.id ?? 0;
+    message.updatedAt = object.updatedAt ?? "";
+    message.key = object.key ?? "";
+    message.scope = object.scope ?? "";
+    message.scopeId = object.scopeId ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseConfigValueList(): ConfigValueList {
+  return { data: [] };
+}
+
+export const ConfigValueList: MessageFns<ConfigValueList> = {
+  encode(message: ConfigValueList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      ConfigValue.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigValueList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigValueList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(ConfigValue.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigValueList {
+    return { data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => ConfigValue.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: ConfigValueList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => ConfigValue.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigValueList>, I>>(base?: I): ConfigValueList {
+    return ConfigValueList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigValueList>, I>>(object: I): ConfigValueList {
+    const message = createBaseConfigValueList();
+    message.data = object.data?.map((e) => ConfigValue.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseConfigEntry(): ConfigEntry {
+  return { key: "", type: "", value: "", source: "" };
+}
+
+export const ConfigEntry: MessageFns<ConfigEntry> = {
+  encode(message: ConfigEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.value !== "") {
+      writer.uint32(26).string(message.value);
+    }
+    if (message.source !== "") {
+      writer.uint32(34).string(message.source);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigEntry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.source = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
+    };
+  },
+
+  toJSON(message: ConfigEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    if (message.source !== "") {
+      obj.source = message.source;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigEntry>, I>>(base?: I): ConfigEntry {
+    return ConfigEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigEntry>, I>>(object: I): ConfigEntry {
+    const message = createBaseConfigEntry();
+    message.key = object.key ?? "";
+    message.type = object.type ?? "";
+    message.value = object.value ?? "";
+    message.source = object.source ?? "";
+    return message;
+  },
+};
+
+function createBaseConfigBundle(): ConfigBundle {
+  return { version: "", entries: [] };
+}
+
+export const ConfigBundle: MessageFns<ConfigBundle> = {
+  encode(message: ConfigBundle, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
+    }
+    for (const v of message.entries) {
+      ConfigEntry.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ConfigBundle {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfigBundle();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.entries.push(ConfigEntry.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConfigBundle {
+    return {
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      entries: globalThis.Array.isArray(object?.entries) ? object.entries.map((e: any) => ConfigEntry.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ConfigBundle): unknown {
+    const obj: any = {};
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.entries?.length) {
+      obj.entries = message.entries.map((e) => ConfigEntry.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ConfigBundle>, I>>(base?: I): ConfigBundle {
+    return ConfigBundle.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ConfigBundle>, I>>(object: I): ConfigBundle {
+    const message = createBaseConfigBundle();
+    message.version = object.version ?? "";
+    message.entries = object.entries?.map((e) => ConfigEntry.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTournamentRequest(): TournamentRequest {
+  return { name: "", slug: "" };
+}
+
+export const TournamentRequest: MessageFns<TournamentRequest> = {
+  encode(message: TournamentRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.slug !== "") {
+      writer.uint32(18).string(message.slug);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TournamentRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTournamentRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.slug = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TournamentRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+    };
+  },
+
+  toJSON(message: TournamentRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.slug !== "") {
+      obj.slug = message.slug;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TournamentRequest>, I>>(base?: I): TournamentRequest {
+    return TournamentRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TournamentRequest>, I>>(object: I): TournamentRequest {
+    const message = createBaseTournamentRequest();
+    message.name = object.name ?? "";
+    message.slug = object.slug ?? "";
+    return message;
+  },
+};
+
+function createBaseTournament(): Tournament {
+  return { id: 0, createdAt: "", updatedAt: "", name: "", slug: "", region: "" };
+}
+
+export const Tournament: MessageFns<Tournament> = {
+  encode(message: Tournament, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.name !== "") {
+      writer.uint32(34).string(message.name);
+    }
+    if (message.slug !== "") {
+      writer.uint32(42).string(message.slug);
+    }
+    if (message.region !== "") {
+      writer.uint32(50).string(message.region);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Tournament {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTournament();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.slug = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.region = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Tournament {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+      region: isSet(object.region) ? globalThis.String(object.region) : "",
+    };
+  },
+
+  toJSON(message: Tournament): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.slug !== "") {
+      obj.slug = message.slug;
+    }
+    if (message.region !== "") {
+      obj.region = message.region;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Tournament>, I>>(base?: I): Tournament {
+    return Tournament.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Tournament>, I>>(object: I): Tournament {
+    const message = createBaseTournament();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.name = object.name ?? "";
+    message.slug = object.slug ?? "";
+    message.region = object.region ?? "";
+    return message;
+  },
+};
+
+function createBaseTournamentList(): TournamentList {
+  return { tournaments: [] };
+}
+
+export const TournamentList: MessageFns<TournamentList> = {
+  encode(message: TournamentList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.tournaments) {
+      Tournament.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TournamentList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTournamentList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.tournaments.push(Tournament.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TournamentList {
+    return {
+      tournaments: globalThis.Array.isArray(object?.tournaments)
+        ? object.tournaments.map((e: any) => Tournament.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TournamentList): unknown {
+    const obj: any = {};
+    if (message.tournaments?.length) {
+      obj.tournaments = message.tournaments.map((e) => Tournament.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TournamentList>, I>>(base?: I): TournamentList {
+    return TournamentList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TournamentList>, I>>(object: I): TournamentList {
+    const message = createBaseTournamentList();
+    message.tournaments = object.tournaments?.map((e) => Tournament.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAssignTournamentRequest(): AssignTournamentRequest {
+  return { deviceId: 0, tournamentId: 0 };
+}
+
+export const AssignTournamentRequest: MessageFns<AssignTournamentRequest> = {
+  encode(message: AssignTournamentRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceId !== 0) {
+      writer.uint32(8).uint32(message.deviceId);
+    }
+    if (message.tournamentId !== 0) {
+      writer.uint32(16).uint32(message.tournamentId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AssignTournamentRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAssignTournamentRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.tournamentId = reader.uint32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AssignTournamentRequest {
+    return {
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      tournamentId: isSet(object.tournamentId)
+        ? globalThis.Number(object.tournamentId)
+        : isSet(object.tournament_id)
+        ? globalThis.Number(object.tournament_id)
+        : 0,
+    };
+  },
+
+  toJSON(message: AssignTournamentRequest): unknown {
+    const obj: any = {};
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.tournamentId !== 0) {
+      obj.tournamentId = Math.round(message.tournamentId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AssignTournamentRequest>, I>>(base?: I): AssignTournamentRequest {
+    return AssignTournamentRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AssignTournamentRequest>, I>>(object: I): AssignTournamentRequest {
+    const message = createBaseAssignTournamentRequest();
+    message.deviceId = object.deviceId ?? 0;
+    message.tournamentId = object.tournamentId ?? 0;
+    return message;
+  },
+};
+
+function createBaseSetTournamentIdsRequest(): SetTournamentIdsRequest {
+  return { tournamentIds: [] };
+}
+
+export const SetTournamentIdsRequest: MessageFns<SetTournamentIdsRequest> = {
+  encode(message: SetTournamentIdsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    writer.uint32(10).fork();
+    for (const v of message.tournamentIds) {
+      writer.uint32(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SetTournamentIdsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetTournamentIdsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag === 8) {
+            message.tournamentIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.tournamentIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SetTournamentIdsRequest {
+    return {
+      tournamentIds: globalThis.Array.isArray(object?.tournamentIds)
+        ? object.tournamentIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.tournament_ids)
+        ? object.tournament_ids.map((e: any) => globalThis.Number(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SetTournamentIdsRequest): unknown {
+    const obj: any = {};
+    if (message.tournamentIds?.length) {
+      obj.tournamentIds = message.tournamentIds.map((e) => Math.round(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SetTournamentIdsRequest>, I>>(base?: I): SetTournamentIdsRequest {
+    return SetTournamentIdsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SetTournamentIdsRequest>, I>>(object: I): SetTournamentIdsRequest {
+    const message = createBaseSetTournamentIdsRequest();
+    message.tournamentIds = object.tournamentIds?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceTournament(): DeviceTournament {
+  return {
+    id: 0,
+    createdAt: "",
+    updatedAt: "",
+    deviceId: 0,
+    tournamentId: 0,
+    device: undefined,
+    tournament: undefined,
+  };
+}
+
+export const DeviceTournament: MessageFns<DeviceTournament> = {
+  encode(message: DeviceTournament, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(32).uint32(message.deviceId);
+    }
+    if (message.tournamentId !== 0) {
+      writer.uint32(40).uint32(message.tournamentId);
+    }
+    if (message.device !== undefined) {
+      Device.encode(message.device, writer.uint32(50).fork()).join();
+    }
+    if (message.tournament !== undefined) {
+      Tournament.encode(message.tournament, writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTournament {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceTournament();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.tournamentId = reader.uint32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.device = Device.decode(reader, reader.uint32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.tournament = Tournament.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceTournament {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      tournamentId: isSet(object.tournamentId)
+        ? globalThis.Number(object.tournamentId)
+        : isSet(object.tournament_id)
+        ? globalThis.Number(object.tournament_id)
+        : 0,
+      device: isSet(object.device) ? Device.fromJSON(object.device) : undefined,
+      tournament: isSet(object.tournament) ? Tournament.fromJSON(object.tournament) : undefined,
+    };
+  },
+
+  toJSON(message: DeviceTournament): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.tournamentId !== 0) {
+      obj.tournamentId = Math.round(message.tournamentId);
+    }
+    if (message.device !== undefined) {
+      obj.device = Device.toJSON(message.device);
+    }
+    if (message.tournament !== undefined) {
+      obj.tournament = Tournament.toJSON(message.tournament);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceTournament>, I>>(base?: I): DeviceTournament {
+    return DeviceTournament.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceTournament>, I>>(object: I): DeviceTournament {
+    const message = createBaseDeviceTournament();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.tournamentId = object.tournamentId ?? 0;
+    message.device = (object.device !== undefined && object.device !== null)
+      ? Device.fromPartial(object.device)
+      : undefined;
+    message.tournament = (object.tournament !== undefined && object.tournament !== null)
+      ? Tournament.fromPartial(object.tournament)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseDeviceTournamentList(): DeviceTournamentList {
+  return { deviceTournaments: [] };
+}
+
+export const DeviceTournamentList: MessageFns<DeviceTournamentList> = {
+  encode(message: DeviceTournamentList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.deviceTournaments) {
+      DeviceTournament.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTournamentList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceTournamentList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deviceTournaments.push(DeviceTournament.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceTournamentList {
+    return {
+      deviceTournaments: globalThis.Array.isArray(object?.deviceTournaments)
+        ? object.deviceTournaments.map((e: any) => DeviceTournament.fromJSON(e))
+        : globalThis.Array.isArray(object?.device_tournaments)
+        ? object.device_tournaments.map((e: any) => DeviceTournament.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceTournamentList): unknown {
+    const obj: any = {};
+    if (message.deviceTournaments?.length) {
+      obj.deviceTournaments = message.deviceTournaments.map((e) => DeviceTournament.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceTournamentList>, I>>(base?: I): DeviceTournamentList {
+    return DeviceTournamentList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceTournamentList>, I>>(object: I): DeviceTournamentList {
+    const message = createBaseDeviceTournamentList();
+    message.deviceTournaments = object.deviceTournaments?.map((e) => DeviceTournament.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAssignTeamRequest(): AssignTeamRequest {
+  return { deviceId: 0, teamId: 0 };
+}
+
+export const AssignTeamRequest: MessageFns<AssignTeamRequest> = {
+  encode(message: AssignTeamRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceId !== 0) {
+      writer.uint32(8).uint32(message.deviceId);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(16).int64(message.teamId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AssignTeamRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAssignTeamRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.teamId = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AssignTeamRequest {
+    return {
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      teamId: isSet(object.teamId)
+        ? globalThis.Number(object.teamId)
+        : isSet(object.team_id)
+        ? globalThis.Number(object.team_id)
+        : 0,
+    };
+  },
+
+  toJSON(message: AssignTeamRequest): unknown {
+    const obj: any = {};
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.teamId !== 0) {
+      obj.teamId = Math.round(message.teamId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AssignTeamRequest>, I>>(base?: I): AssignTeamRequest {
+    return AssignTeamRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AssignTeamRequest>, I>>(object: I): AssignTeamRequest {
+    const message = createBaseAssignTeamRequest();
+    message.deviceId = object.deviceId ?? 0;
+    message.teamId = object.teamId ?? 0;
+    return message;
+  },
+};
+
+function createBaseSetTeamIdsRequest(): SetTeamIdsRequest {
+  return { teamIds: [] };
+}
+
+export const SetTeamIdsRequest: MessageFns<SetTeamIdsRequest> = {
+  encode(message: SetTeamIdsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    writer.uint32(10).fork();
+    for (const v of message.teamIds) {
+      writer.int64(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SetTeamIdsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetTeamIdsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag === 8) {
+            message.teamIds.push(longToNumber(reader.int64()));
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.teamIds.push(longToNumber(reader.int64()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SetTeamIdsRequest {
+    return {
+      teamIds: globalThis.Array.isArray(object?.teamIds)
+        ? object.teamIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.team_ids)
+        ? object.team_ids.map((e: any) => globalThis.Number(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SetTeamIdsRequest): unknown {
+    const obj: any = {};
+    if (message.teamIds?.length) {
+      obj.teamIds = message.teamIds.map((e) => Math.round(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SetTeamIdsRequest>, I>>(base?: I): SetTeamIdsRequest {
+    return SetTeamIdsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SetTeamIdsRequest>, I>>(object: I): SetTeamIdsRequest {
+    const message = createBaseSetTeamIdsRequest();
+    message.teamIds = object.teamIds?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceTeam(): DeviceTeam {
+  return { id: 0, createdAt: "", updatedAt: "", deviceId: 0, teamId: 0, device: undefined, team: undefined };
+}
+
+export const DeviceTeam: MessageFns<DeviceTeam> = {
+  encode(message: DeviceTeam, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(32).uint32(message.deviceId);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(40).int64(message.teamId);
+    }
+    if (message.device !== undefined) {
+      Device.encode(message.device, writer.uint32(50).fork()).join();
+    }
+    if (message.team !== undefined) {
+      Team.encode(message.team, writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTeam {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceTeam();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.teamId = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.device = Device.decode(reader, reader.uint32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.team = Team.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceTeam {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      teamId: isSet(object.teamId)
+        ? globalThis.Number(object.teamId)
+        : isSet(object.team_id)
+        ? globalThis.Number(object.team_id)
+        : 0,
+      device: isSet(object.device) ? Device.fromJSON(object.device) : undefined,
+      team: isSet(object.team) ? Team.fromJSON(object.team) : undefined,
+    };
+  },
+
+  toJSON(message: DeviceTeam): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.teamId !== 0) {
+      obj.teamId = Math.round(message.teamId);
+    }
+    if (message.device !== undefined) {
+      obj.device = Device.toJSON(message.device);
+    }
+    if (message.team !== undefined) {
+      obj.team = Team.toJSON(message.team);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceTeam>, I>>(base?: I): DeviceTeam {
+    return DeviceTeam.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceTeam>, I>>(object: I): DeviceTeam {
+    const message = createBaseDeviceTeam();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.teamId = object.teamId ?? 0;
+    message.device = (object.device !== undefined && object.device !== null)
+      ? Device.fromPartial(object.device)
+      : undefined;
+    message.team = (object.team !== undefined && object.team !== null) ? Team.fromPartial(object.team) : undefined;
+    return message;
+  },
+};
+
+function createBaseDeviceTeamList(): DeviceTeamList {
+  return { deviceTeams: [] };
+}
+
+export const DeviceTeamList: MessageFns<DeviceTeamList> = {
+  encode(message: DeviceTeamList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.deviceTeams) {
+      DeviceTeam.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTeamList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceTeamList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deviceTeams.push(DeviceTeam.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceTeamList {
+    return {
+      deviceTeams: globalThis.Array.isArray(object?.deviceTeams)
+        ? object.deviceTeams.map((e: any) => DeviceTeam.fromJSON(e))
+        : globalThis.Array.isArray(object?.device_teams)
+        ? object.device_teams.map((e: any) => DeviceTeam.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DeviceTeamList): unknown {
+    const obj: any = {};
+    if (message.deviceTeams?.length) {
+      obj.deviceTeams = message.deviceTeams.map((e) => DeviceTeam.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceTeamList>, I>>(base?: I): DeviceTeamList {
+    return DeviceTeamList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceTeamList>, I>>(object: I): DeviceTeamList {
+    const message = createBaseDeviceTeamList();
+    message.deviceTeams = object.deviceTeams?.map((e) => DeviceTeam.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGlobalTournamentConfig(): GlobalTournamentConfig {
+  return { id: 0, createdAt: "", updatedAt: "", tournamentId: 0, tournament: undefined };
+}
+
+export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
+  encode(message: GlobalTournamentConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.tournamentId !== 0) {
+      writer.uint32(32).uint32(message.tournamentId);
+    }
+    if (message.tournament !== undefined) {
+      Tournament.encode(message.tournament, writer.uint32(42).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GlobalTournamentConfig {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGlobalTournamentConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.tournamentId = reader.uint32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tournament = Tournament.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GlobalTournamentConfig {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      tournamentId: isSet(object.tournamentId)
+        ? globalThis.Number(object.tournamentId)
+        : isSet(object.tournament_id)
+        ? globalThis.Number(object.tournament_id)
+        : 0,
+      tournament: isSet(object.tournament) ? Tournament.fromJSON(object.tournament) : undefined,
+    };
+  },
+
+  toJSON(message: GlobalTournamentConfig): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.tournamentId !== 0) {
+      obj.tournamentId = Math.round(message.tournamentId);
+    }
+    if (message.tournament !== undefined) {
+      obj.tournament = Tournament.toJSON(message.tournament);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GlobalTournamentConfig>, I>>(base?: I): GlobalTournamentConfig {
+    return GlobalTournamentConfig.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GlobalTournamentConfig>, I>>(object: I): GlobalTournamentConfig {
+    const message = createBaseGlobalTournamentConfig();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.tournamentId = object.tournamentId ?? 0;
+    message.tournament = (object.tournament !== undefined && object.tournament !== null)
+      ? Tournament.fromPartial(object.tournament)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGlobalTournamentConfigList(): GlobalTournamentConfigList {
+  return { configs: [] };
+}
+
+export const GlobalTournamentConfigList: MessageFns<GlobalTournamentConfigList> = {
+  encode(message: GlobalTournamentConfigList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.configs) {
+      GlobalTournamentConfig.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GlobalTournamentConfigList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGlobalTournamentConfigList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.configs.push(GlobalTournamentConfig.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GlobalTournamentConfigList {
+    return {
+      configs: globalThis.Array.isArray(object?.configs)
+        ? object.configs.map((e: any) => GlobalTournamentConfig.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GlobalTournamentConfigList): unknown {
+    const obj: any = {};
+    if (message.configs?.length) {
+      obj.configs = message.configs.map((e) => GlobalTournamentConfig.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GlobalTournamentConfigList>, I>>(base?: I): GlobalTournamentConfigList {
+    return GlobalTournamentConfigList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GlobalTournamentConfigList>, I>>(object: I): GlobalTournamentConfigList {
+    const message = createBaseGlobalTournamentConfigList();
+    message.configs = object.configs?.map((e) => GlobalTournamentConfig.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSportRequest(): SportRequest {
+  return { slug: "", name: "", enabled: false };
+}
+
+export const SportRequest: MessageFns<SportRequest> = {
+  encode(message: SportRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.slug !== "") {
+      writer.uint32(10).string(message.slug);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(24).bool(message.enabled);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SportRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSportRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.slug = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SportRequest {
+    return {
+      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+    };
+  },
+
+  toJSON(message: SportRequest): unknown {
+    const obj: any = {};
+    if (message.slug !== "") {
+      obj.slug = message.slug;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.enabled !== false) {
+      obj.enabled = message.enabled;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SportRequest>, I>>(base?: I): SportRequest {
+    return SportRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SportRequest>, I>>(object: I): SportRequest {
+    const message = createBaseSportRequest();
+    message.slug = object.slug ?? "";
+    message.name = object.name ?? "";
+    message.enabled = object.enabled ?? false;
+    return message;
+  },
+};
+
+function createBaseSport(): Sport {
+  return { id: 0, createdAt: "", updatedAt: "", slug: "", name: "", enabled: false };
+}
+
+export const Sport: MessageFns<Sport> = {
+  encode(message: Sport, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.slug !== "") {
+      writer.uint32(34).string(message.slug);
+    }
+    if (message.name !== "") {
+      writer.uint32(42).string(message.name);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(48).bool(message.enabled);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Sport {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSport();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.slug = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Sport {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+    };
+  },
+
+  toJSON(message: Sport): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.slug !== "") {
+      obj.slug = message.slug;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.enabled !== false) {
+      obj.enabled = message.enabled;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Sport>, I>>(base?: I): Sport {
+    return Sport.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Sport>, I>>(object: I): Sport {
+    const message = createBaseSport();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.slug = object.slug ?? "";
+    message.name = object.name ?? "";
+    message.enabled = object.enabled ?? false;
+    return message;
+  },
+};
+
+function createBaseSportList(): SportList {
+  return { sports: [] };
+}
+
+export const SportList: MessageFns<SportList> = {
+  encode(message: SportList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.sports) {
+      Sport.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SportList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSportList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sports.push(Sport.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SportList {
+    return { sports: globalThis.Array.isArray(object?.sports) ? object.sports.map((e: any) => Sport.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: SportList): unknown {
+    const obj: any = {};
+    if (message.sports?.length) {
+      obj.sports = message.sports.map((e) => Sport.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SportList>, I>>(base?: I): SportList {
+    return SportList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SportList>, I>>(object: I): SportList {
+    const message = createBaseSportList();
+    message.sports = object.sports?.map((e) => Sport.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTrendingCountryRequest(): TrendingCountryRequest {
+  return { code: "", name: "", enabled: false };
+}
+
+export const TrendingCountryRequest: MessageFns<TrendingCountryRequest> = {
+  encode(message: TrendingCountryRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(24).bool(message.enabled);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TrendingCountryRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrendingCountryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrendingCountryRequest {
+    return {
+      code: isSet(object.code) ? globalThis.String(object.code) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+    };
+  },
+
+  toJSON(message: TrendingCountryRequest): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.enabled !== false) {
+      obj.enabled = message.enabled;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrendingCountryRequest>, I>>(base?: I): TrendingCountryRequest {
+    return TrendingCountryRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrendingCountryRequest>, I>>(object: I): TrendingCountryRequest {
+    const message = createBaseTrendingCountryRequest();
+    message.code = object.code ?? "";
+    message.name = object.name ?? "";
+    message.enabled = object.enabled ?? false;
+    return message;
+  },
+};
+
+function createBaseTrendingCountry(): TrendingCountry {
+  return { id: 0, createdAt: "", updatedAt: "", code: "", name: "", enabled: false };
+}
+
+export const TrendingCountry: MessageFns<TrendingCountry> = {
+  encode(message: TrendingCountry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.code !== "") {
+      writer.uint32(34).string(message.code);
+    }
+    if (message.name !== "") {
+      writer.uint32(42).string(message.name);
+    }
+    if (message.enabled !== false) {
+      writer.uint32(48).bool(message.enabled);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TrendingCountry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrendingCountry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrendingCountry {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      code: isSet(object.code) ? globalThis.String(object.code) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+    };
+  },
+
+  toJSON(message: TrendingCountry): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.enabled !== false) {
+      obj.enabled = message.enabled;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrendingCountry>, I>>(base?: I): TrendingCountry {
+    return TrendingCountry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrendingCountry>, I>>(object: I): TrendingCountry {
+    const message = createBaseTrendingCountry();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.code = object.code ?? "";
+    message.name = object.name ?? "";
+    message.enabled = object.enabled ?? false;
+    return message;
+  },
+};
+
+function createBaseTrendingCountryList(): TrendingCountryList {
+  return { countries: [] };
+}
+
+export const TrendingCountryList: MessageFns<TrendingCountryList> = {
+  encode(message: TrendingCountryList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.countries) {
+      TrendingCountry.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TrendingCountryList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrendingCountryList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.countries.push(TrendingCountry.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrendingCountryList {
+    return {
+      countries: globalThis.Array.isArray(object?.countries)
+        ? object.countries.map((e: any) => TrendingCountry.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TrendingCountryList): unknown {
+    const obj: any = {};
+    if (message.countries?.length) {
+      obj.countries = message.countries.map((e) => TrendingCountry.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrendingCountryList>, I>>(base?: I): TrendingCountryList {
+    return TrendingCountryList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrendingCountryList>, I>>(object: I): TrendingCountryList {
+    const message = createBaseTrendingCountryList();
+    message.countries = object.countries?.map((e) => TrendingCountry.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTeam(): Team {
+  return { id: 0, teamId: 0, logoUrl: "", primaryColor: "", secondaryColor: "", textColor: "", name: "" };
+}
+
+export const Team: MessageFns<Team> = {
+  encode(message: Team, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.teamId !== 0) {
+      writer.uint32(16).int64(message.teamId);
+    }
+    if (message.logoUrl !== "") {
+      writer.uint32(26).string(message.logoUrl);
+    }
+    if (message.primaryColor !== "") {
+      writer.uint32(34).string(message.primaryColor);
+    }
+    if (message.secondaryColor !== "") {
+      writer.uint32(42).string(message.secondaryColor);
+    }
+    if (message.textColor !== "") {
+      writer.uint32(50).string(message.textColor);
+    }
+    if (message.name !== "") {
+      writer.uint32(58).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Team {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTeam();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.teamId = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.logoUrl = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.primaryColor = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.secondaryColor = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.textColor = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Team {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      teamId: isSet(object.teamId)
+        ? globalThis.Number(object.teamId)
+        : isSet(object.team_id)
+        ? globalThis.Number(object.team_id)
+        : 0,
+      logoUrl: isSet(object.logoUrl)
+        ? globalThis.String(object.logoUrl)
+        : isSet(object.logo_url)
+        ? globalThis.String(object.logo_url)
+        : "",
+      primaryColor: isSet(object.primaryColor)
+        ? globalThis.String(object.primaryColor)
+        : isSet(object.primary_color)
+        ? globalThis.String(object.primary_color)
+        : "",
+      secondaryColor: isSet(object.secondaryColor)
+        ? globalThis.String(object.secondaryColor)
+        : isSet(object.secondary_color)
+        ? globalThis.String(object.secondary_color)
+        : "",
+      textColor: isSet(object.textColor)
+        ? globalThis.String(object.textColor)
+        : isSet(object.text_color)
+        ? globalThis.String(object.text_color)
+        : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+    };
+  },
+
+  toJSON(message: Team): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.teamId !== 0) {
+      obj.teamId = Math.round(message.teamId);
+    }
+    if (message.logoUrl !== "") {
+      obj.logoUrl = message.logoUrl;
+    }
+    if (message.primaryColor !== "") {
+      obj.primaryColor = message.primaryColor;
+    }
+    if (message.secondaryColor !== "") {
+      obj.secondaryColor = message.secondaryColor;
+    }
+    if (message.textColor !== "") {
+      obj.textColor = message.textColor;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Team>, I>>(base?: I): Team {
+    return Team.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Team>, I>>(object: I): Team {
+    const message = createBaseTeam();
+    message.id = object.id ?? 0;
+    message.teamId = object.teamId ?? 0;
+    message.logoUrl = object.logoUrl ?? "";
+    message.primaryColor = object.primaryColor ?? "";
+    message.secondaryColor = object.secondaryColor ?? "";
+    message.textColor = object.textColor ?? "";
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseSofaScoreEvent(): SofaScoreEvent {
+  return {
+    id: 0,
+    createdAt: "",
+    updatedAt: "",
+    sofaScoreEventId: 0,
+    sport: "",
+    homeScore: 0,
+    homeTeamId: 0,
+    awayScore: 0,
+    awayTeamId: 0,
+    scrapedAt: 0,
+    category: "",
+    startTimestamp: 0,
+    currentPeriodStartTimestamp: 0,
+    slug: "",
+    teamHome: undefined,
+    teamAway: undefined,
+    league: undefined,
+    statusCode: 0,
+    statusType: "",
+    statusDescription: "",
+    leagueId: 0,
+  };
+}
+
+export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
+  encode(message: SofaScoreEvent, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.sofaScoreEventId !== 0) {
+      writer.uint32(32).int64(message.sofaScoreEventId);
+    }
+    if (message.sport !== "") {
+      writer.uint32(42).string(message.sport);
+    }
+    if (message.homeScore !== 0) {
+      writer.uint32(48).int32(message.homeScore);
+    }
+    if (message.homeTeamId !== 0) {
+      writer.uint32(56).int64(message.homeTeamId);
+    }
+    if (message.awayScore !== 0) {
+      writer.uint32(64).int32(message.awayScore);
+    }
+    if (message.awayTeamId !== 0) {
+      writer.uint32(72).int64(message.awayTeamId);
+    }
+    if (message.scrapedAt !== 0) {
+      writer.uint32(80).int64(message.scrapedAt);
+    }
+    if (message.category !== "") {
+      writer.uint32(90).string(message.category);
+    }
+    if (message.startTimestamp !== 0) {
+      writer.uint32(96).int64(message.startTimestamp);
+    }
+    if (message.currentPeriodStartTimestamp !== 0) {
+      writer.uint32(104).int64(message.currentPeriodStartTimestamp);
+    }
+    if (message.slug !== "") {
+      writer.uint32(114).string(message.slug);
+    }
+    if (message.teamHome !== undefined) {
+      Team.encode(message.teamHome, writer.uint32(122).fork()).join();
+    }
+    if (message.teamAway !== undefined) {
+      Team.encode(message.teamAway, writer.uint32(130).fork()).join();
+    }
+    if (message.league !== undefined) {
+      Tournament.encode(message.league, writer.uint32(138).fork()).join();
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(144).int32(message.statusCode);
+    }
+    if (message.statusType !== "") {
+      writer.uint32(154).string(message.statusType);
+    }
+    if (message.statusDescription !== "") {
+      writer.uint32(162).string(message.statusDescription);
+    }
+    if (message.leagueId !== 0) {
+      writer.uint32(168).uint32(message.leagueId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SofaScoreEvent {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSofaScoreEvent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.sofaScoreEventId = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sport = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.homeScore = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.homeTeamId = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.awayScore = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.awayTeamId = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.scrapedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.category = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 96) {
+            break;
+          }
+
+          message.startTimestamp = longToNumber(reader.int64());
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.currentPeriodStartTimestamp = longToNumber(reader.int64());
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.slug = reader.string();
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.teamHome = Team.decode(reader, reader.uint32());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.teamAway = Team.decode(reader, reader.uint32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.league = Tournament.decode(reader, reader.uint32());
+          continue;
+        }
+        case 18: {
+          if (tag !== 144) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.statusType = reader.string();
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.statusDescription = reader.string();
+          continue;
+        }
+        case 21: {
+          if (tag !== 168) {
+            break;
+          }
+
+          message.leagueId = reader.uint32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SofaScoreEvent {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      sofaScoreEventId: isSet(object.sofaScoreEventId)
+        ? globalThis.Number(object.sofaScoreEventId)
+        : isSet(object.sofa_score_event_id)
+        ? globalThis.Number(object.sofa_score_event_id)
+        : 0,
+      sport: isSet(object.sport) ? globalThis.String(object.sport) : "",
+      homeScore: isSet(object.homeScore)
+        ? globalThis.Number(object.homeScore)
+        : isSet(object.home_score)
+        ? globalThis.Number(object.home_score)
+        : 0,
+      homeTeamId: isSet(object.homeTeamId)
+        ? globalThis.Number(object.homeTeamId)
+        : isSet(object.home_team_id)
+        ? globalThis.Number(object.home_team_id)
+        : 0,
+      awayScore: isSet(object.awayScore)
+        ? globalThis.Number(object.awayScore)
+        : isSet(object.away_score)
+        ? globalThis.Number(object.away_score)
+        : 0,
+      awayTeamId: isSet(object.awayTeamId)
+        ? globalThis.Number(object.awayTeamId)
+        : isSet(object.away_team_id)
+        ? globalThis.Number(object.away_team_id)
+        : 0,
+      scrapedAt: isSet(object.scrapedAt)
+        ? globalThis.Number(object.scrapedAt)
+        : isSet(object.scraped_at)
+        ? globalThis.Number(object.scraped_at)
+        : 0,
+      category: isSet(object.category) ? globalThis.String(object.category) : "",
+      startTimestamp: isSet(object.startTimestamp)
+        ? globalThis.Number(object.startTimestamp)
+        : isSet(object.start_timestamp)
+        ? globalThis.Number(object.start_timestamp)
+        : 0,
+      currentPeriodStartTimestamp: isSet(object.currentPeriodStartTimestamp)
+        ? globalThis.Number(object.currentPeriodStartTimestamp)
+        : isSet(object.current_period_start_timestamp)
+        ? globalThis.Number(object.current_period_start_timestamp)
+        : 0,
+      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+      teamHome: isSet(object.teamHome)
+        ? Team.fromJSON(object.teamHome)
+        : isSet(object.team_home)
+        ? Team.fromJSON(object.team_home)
+        : undefined,
+      teamAway: isSet(object.teamAway)
+        ? Team.fromJSON(object.teamAway)
+        : isSet(object.team_away)
+        ? Team.fromJSON(object.team_away)
+        : undefined,
+      league: isSet(object.league) ? Tournament.fromJSON(object.league) : undefined,
+      statusCode: isSet(object.statusCode)
+        ? globalThis.Number(object.statusCode)
+        : isSet(object.status_code)
+        ? globalThis.Number(object.status_code)
+        : 0,
+      statusType: isSet(object.statusType)
+        ? globalThis.String(object.statusType)
+        : isSet(object.status_type)
+        ? globalThis.String(object.status_type)
+        : "",
+      statusDescription: isSet(object.statusDescription)
+        ? globalThis.String(object.statusDescription)
+        : isSet(object.status_description)
+        ? globalThis.String(object.status_description)
+        : "",
+      leagueId: isSet(object.leagueId)
+        ? globalThis.Number(object.leagueId)
+        : isSet(object.league_id)
+        ? globalThis.Number(object.league_id)
+        : 0,
+    };
+  },
+
+  toJSON(message: SofaScoreEvent): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.sofaScoreEventId !== 0) {
+      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
+    }
+    if (message.sport !== "") {
+      obj.sport = message.sport;
+    }
+    if (message.homeScore !== 0) {
+      obj.homeScore = Math.round(message.homeScore);
+    }
+    if (message.homeTeamId !== 0) {
+      obj.homeTeamId = Math.round(message.homeTeamId);
+    }
+    if (message.awayScore !== 0) {
+      obj.awayScore = Math.round(message.awayScore);
+    }
+    if (message.awayTeamId !== 0) {
+      obj.awayTeamId = Math.round(message.awayTeamId);
+    }
+    if (message.scrapedAt !== 0) {
+      obj.scrapedAt = Math.round(message.scrapedAt);
+    }
+    if (message.category !== "") {
+      obj.category = message.category;
+    }
+    if (message.startTimestamp !== 0) {
+      obj.startTimestamp = Math.round(message.startTimestamp);
+    }
+    if (message.currentPeriodStartTimestamp !== 0) {
+      obj.currentPeriodStartTimestamp = Math.round(message.currentPeriodStartTimestamp);
+    }
+    if (message.slug !== "") {
+      obj.slug = message.slug;
+    }
+    if (message.teamHome !== undefined) {
+      obj.teamHome = Team.toJSON(message.teamHome);
+    }
+    if (message.teamAway !== undefined) {
+      obj.teamAway = Team.toJSON(message.teamAway);
+    }
+    if (message.league !== undefined) {
+      obj.league = Tournament.toJSON(message.league);
+    }
+    if (message.statusCode !== 0) {
+      obj.statusCode = Math.round(message.statusCode);
+    }
+    if (message.statusType !== "") {
+      obj.statusType = message.statusType;
+    }
+    if (message.statusDescription !== "") {
+      obj.statusDescription = message.statusDescription;
+    }
+    if (message.leagueId !== 0) {
+      obj.leagueId = Math.round(message.leagueId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SofaScoreEvent>, I>>(base?: I): SofaScoreEvent {
+    return SofaScoreEvent.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SofaScoreEvent>, I>>(object: I): SofaScoreEvent {
+    const message = createBaseSofaScoreEvent();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
+    message.sport = object.sport ?? "";
+    message.homeScore = object.homeScore ?? 0;
+    message.homeTeamId = object.homeTeamId ?? 0;
+    message.awayScore = object.awayScore ?? 0;
+    message.awayTeamId = object.awayTeamId ?? 0;
+    message.scrapedAt = object.scrapedAt ?? 0;
+    message.category = object.category ?? "";
+    message.startTimestamp = object.startTimestamp ?? 0;
+    message.currentPeriodStartTimestamp = object.currentPeriodStartTimestamp ?? 0;
+    message.slug = object.slug ?? "";
+    message.teamHome = (object.teamHome !== undefined && object.teamHome !== null)
+      ? Team.fromPartial(object.teamHome)
+      : undefined;
+    message.teamAway = (object.teamAway !== undefined && object.teamAway !== null)
+      ? Team.fromPartial(object.teamAway)
+      : undefined;
+    message.league = (object.league !== undefined && object.league !== null)
+      ? Tournament.fromPartial(object.league)
+      : undefined;
+    message.statusCode = object.statusCode ?? 0;
+    message.statusType = object.statusType ?? "";
+    message.statusDescription = object.statusDescription ?? "";
+    message.leagueId = object.leagueId ?? 0;
+    return message;
+  },
+};
+
+function createBaseEventsList(): EventsList {
+  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0, live: [], upcoming: [], recent: [], nextCursor: "" };
+}
+
+export const EventsList: MessageFns<EventsList> = {
+  encode(message: EventsList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.data) {
+      SofaScoreEvent.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.page !== 0) {
+      writer.uint32(16).int32(message.page);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(24).int32(message.limit);
+    }
+    if (message.total !== 0) {
+      writer.uint32(32).int64(message.total);
+    }
+    if (message.totalPages !== 0) {
+      writer.uint32(40).int32(message.totalPages);
+    }
+    for (const v of message.live) {
+      SofaScoreEvent.encode(v!, writer.uint32(50).fork()).join();
+    }
+    for (const v of message.upcoming) {
+      SofaScoreEvent.encode(v!, writer.uint32(58).fork()).join();
+    }
+    for (const v of message.recent) {
+      SofaScoreEvent.encode(v!, writer.uint32(66).fork()).join();
+    }
+    if (message.nextCursor !== "") {
+      writer.uint32(74).string(message.nextCursor);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EventsList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEventsList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.data.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.page = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.totalPages = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.live.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.upcoming.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.recent.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.nextCursor = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EventsList {
+    return {
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
+      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      totalPages: isSet(object.totalPages)
+        ? globalThis.Number(object.totalPages)
+        : isSet(object.total_pages)
+        ? globalThis.Number(object.total_pages)
+        : 0,
+      live: globalThis.Array.isArray(object?.live) ? object.live.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
+      upcoming: globalThis.Array.isArray(object?.upcoming)
+        ? object.upcoming.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : [],
+      recent: globalThis.Array.isArray(object?.recent) ? object.recent.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
+      nextCursor: isSet(object.nextCursor)
+        ? globalThis.String(object.nextCursor)
+        : isSet(object.next_cursor)
+        ? globalThis.String(object.next_cursor)
+        : "",
+    };
+  },
+
+  toJSON(message: EventsList): unknown {
+    const obj: any = {};
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.page !== 0) {
+      obj.page = Math.round(message.page);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.totalPages !== 0) {
+      obj.totalPages = Math.round(message.totalPages);
+    }
+    if (message.live?.length) {
+      obj.live = message.live.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.upcoming?.length) {
+      obj.upcoming = message.upcoming.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.recent?.length) {
+      obj.recent = message.recent.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.nextCursor !== "") {
+      obj.nextCursor = message.nextCursor;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EventsList>, I>>(base?: I): EventsList {
+    return EventsList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EventsList>, I>>(object: I): EventsList {
+    const message = createBaseEventsList();
+    message.data = object.data?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.page = object.page ?? 0;
+    message.limit = object.limit ?? 0;
+    message.total = object.total ?? 0;
+    message.totalPages = object.totalPages ?? 0;
+    message.live = object.live?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.upcoming = object.upcoming?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.recent = object.recent?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.nextCursor = object.nextCursor ?? "";
+    return message;
+  },
+};
+
+function createBaseEventDelta(): EventDelta {
+  return {
+    sofaScoreEventId: 0,
+    leagueId: 0,
+    homeScore: 0,
+    awayScore: 0,
+    statusCode: 0,
+    statusType: "",
+    statusDescription: "",
+    startTimestamp: 0,
+    currentPeriodStartTimestamp: 0,
+    updatedAt: "",
+    isNew: false,
+  };
+}
+
+export const EventDelta: MessageFns<EventDelta> = {
+  encode(message: EventDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sofaScoreEventId !== 0) {
+      writer.uint32(8).int64(message.sofaScoreEventId);
+    }
+    if (message.leagueId !== 0) {
+      writer.uint32(16).uint32(message.leagueId);
+    }
+    if (message.homeScore !== 0) {
+      writer.uint32(24).int32(message.homeScore);
+    }
+    if (message.awayScore !== 0) {
+      writer.uint32(32).int32(message.awayScore);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(40).int32(message.statusCode);
+    }
+    if (message.statusType !== "") {
+      writer.uint32(50).string(message.statusType);
+    }
+    if (message.statusDescription !== "") {
+      writer.uint32(58).string(message.statusDescription);
+    }
+    if (message.startTimestamp !== 0) {
+      writer.uint32(64).int64(message.startTimestamp);
+    }
+    if (message.currentPeriodStartTimestamp !== 0) {
+      writer.uint32(72).int64(message.currentPeriodStartTimestamp);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(82).string(message.updatedAt);
+    }
+    if (message.isNew !== false) {
+      writer.uint32(88).bool(message.isNew);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EventDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEventDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.sofaScoreEventId = longToNumber(reader.int64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.leagueId = reader.uint32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.homeScore = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.awayScore = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.statusType = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.statusDescription = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.startTimestamp = longToNumber(reader.int64());
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.currentPeriodStartTimestamp = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 88) {
+            break;
+          }
+
+          message.isNew = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EventDelta {
+    return {
+      sofaScoreEventId: isSet(object.sofaScoreEventId)
+        ? globalThis.Number(object.sofaScoreEventId)
+        : isSet(object.sofa_score_event_id)
+        ? globalThis.Number(object.sofa_score_event_id)
+        : 0,
+      leagueId: isSet(object.leagueId)
+        ? globalThis.Number(object.leagueId)
+        : isSet(object.league_id)
+        ? globalThis.Number(object.league_id)
+        : 0,
+      homeScore: isSet(object.homeScore)
+        ? globalThis.Number(object.homeScore)
+        : isSet(object.home_score)
+        ? globalThis.Number(object.home_score)
+        : 0,
+      awayScore: isSet(object.awayScore)
+        ? globalThis.Number(object.awayScore)
+        : isSet(object.away_score)
+        ? globalThis.Number(object.away_score)
+        : 0,
+      statusCode: isSet(object.statusCode)
+        ? globalThis.Number(object.statusCode)
+        : isSet(object.status_code)
+        ? globalThis.Number(object.status_code)
+        : 0,
+      statusType: isSet(object.statusType)
+        ? globalThis.String(object.statusType)
+        : isSet(object.status_type)
+        ? globalThis.String(object.status_type)
+        : "",
+      statusDescription: isSet(object.statusDescription)
+        ? globalThis.String(object.statusDescription)
+        : isSet(object.status_description)
+        ? globalThis.String(object.status_description)
+        : "",
+      startTimestamp: isSet(object.startTimestamp)
+        ? globalThis.Number(object.startTimestamp)
+        : isSet(object.start_timestamp)
+        ? globalThis.Number(object.start_timestamp)
+        : 0,
+      currentPeriodStartTimestamp: isSet(object.currentPeriodStartTimestamp)
+        ? globalThis.Number(object.currentPeriodStartTimestamp)
+        : isSet(object.current_period_start_timestamp)
+        ? globalThis.Number(object.current_period_start_timestamp)
+        : 0,
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      isNew: isSet(object.isNew)
+        ? globalThis.Boolean(object.isNew)
+        : isSet(object.is_new)
+        ? globalThis.Boolean(object.is_new)
+        : false,
+    };
+  },
+
+  toJSON(message: EventDelta): unknown {
+    const obj: any = {};
+    if (message.sofaScoreEventId !== 0) {
+      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
+    }
+    if (message.leagueId !== 0) {
+      obj.leagueId = Math.round(message.leagueId);
+    }
+    if (message.homeScore !== 0) {
+      obj.homeScore = Math.round(message.homeScore);
+    }
+    if (message.awayScore !== 0) {
+      obj.awayScore = Math.round(message.awayScore);
+    }
+    if (message.statusCode !== 0) {
+      obj.statusCode = Math.round(message.statusCode);
+    }
+    if (message.statusType !== "") {
+      obj.statusType = message.statusType;
+    }
+    if (message.statusDescription !== "") {
+      obj.statusDescription = message.statusDescription;
+    }
+    if (message.startTimestamp !== 0) {
+      obj.startTimestamp = Math.round(message.startTimestamp);
+    }
+    if (message.currentPeriodStartTimestamp !== 0) {
+      obj.currentPeriodStartTimestamp = Math.round(message.currentPeriodStartTimestamp);
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.isNew !== false) {
+      obj.isNew = message.isNew;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EventDelta>, I>>(base?: I): EventDelta {
+    return EventDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EventDelta>, I>>(object: I): EventDelta {
+    const message = createBaseEventDelta();
+    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
+    message.leagueId = object.leagueId ?? 0;
+    message.homeScore = object.homeScore ?? 0;
+    message.awayScore = object.awayScore ?? 0;
+    message.statusCode = object.statusCode ?? 0;
+    message.statusType = object.statusType ?? "";
+    message.statusDescription = object.statusDescription ?? "";
+    message.startTimestamp = object.startTimestamp ?? 0;
+    message.currentPeriodStartTimestamp = object.currentPeriodStartTimestamp ?? 0;
+    message.updatedAt = object.updatedAt ?? "";
+    message.isNew = object.isNew ?? false;
+    return message;
+  },
+};
+
+function createBaseTrendingEventsList(): TrendingEventsList {
+  return { countryCode: "", fetchedAt: 0, data: [] };
+}
+
+export const TrendingEventsList: MessageFns<TrendingEventsList> = {
+  encode(message: TrendingEventsList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.countryCode !== "") {
+      writer.uint32(10).string(message.countryCode);
+    }
+    if (message.fetchedAt !== 0) {
+      writer.uint32(16).int64(message.fetchedAt);
+    }
+    for (const v of message.data) {
+      SofaScoreEvent.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TrendingEventsList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrendingEventsList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.countryCode = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.fetchedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.data.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrendingEventsList {
+    return {
+      countryCode: isSet(object.countryCode)
+        ? globalThis.String(object.countryCode)
+        : isSet(object.country_code)
+        ? globalThis.String(object.country_code)
+        : "",
+      fetchedAt: isSet(object.fetchedAt)
+        ? globalThis.Number(object.fetchedAt)
+        : isSet(object.fetched_at)
+        ? globalThis.Number(object.fetched_at)
+        : 0,
+      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: TrendingEventsList): unknown {
+    const obj: any = {};
+    if (message.countryCode !== "") {
+      obj.countryCode = message.countryCode;
+    }
+    if (message.fetchedAt !== 0) {
+      obj.fetchedAt = Math.round(message.fetchedAt);
+    }
+    if (message.data?.length) {
+      obj.data = message.data.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrendingEventsList>, I>>(base?: I): TrendingEventsList {
+    return TrendingEventsList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrendingEventsList>, I>>(object: I): TrendingEventsList {
+    const message = createBaseTrendingEventsList();
+    message.countryCode = object.countryCode ?? "";
+    message.fetchedAt = object.fetchedAt ?? 0;
+    message.data = object.data?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeviceStatusChange(): DeviceStatusChange {
+  return { deviceId: 0, name: "", online: false, lastSeen: 0 };
+}
+
+export const DeviceStatusChange: MessageFns<DeviceStatusChange> = {
+  encode(message: DeviceStatusChange, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceId !== 0) {
+      writer.uint32(8).uint32(message.deviceId);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.online !== false) {
+      writer.uint32(24).bool(message.online);
+    }
+    if (message.lastSeen !== 0) {
+      writer.uint32(32).int64(message.lastSeen);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeviceStatusChange {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeviceStatusChange();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.online = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.lastSeen = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeviceStatusChange {
+    return {
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      online: isSet(object.online) ? globalThis.Boolean(object.online) : false,
+      lastSeen: isSet(object.lastSeen)
+        ? globalThis.Number(object.lastSeen)
+        : isSet(object.last_seen)
+        ? globalThis.Number(object.last_seen)
+        : 0,
+    };
+  },
+
+  toJSON(message: DeviceStatusChange): unknown {
+    const obj: any = {};
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.online !== false) {
+      obj.online = message.online;
+    }
+    if (message.lastSeen !== 0) {
+      obj.lastSeen = Math.round(message.lastSeen);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeviceStatusChange>, I>>(base?: I): DeviceStatusChange {
+    return DeviceStatusChange.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeviceStatusChange>, I>>(object: I): DeviceStatusChange {
+    const message = createBaseDeviceStatusChange();
+    message.deviceId = object.deviceId ?? 0;
+    message.name = object.name ?? "";
+    message.online = object.online ?? false;
+    message.lastSeen = object.lastSeen ?? 0;
+    return message;
+  },
+};
+
+function createBaseCrashReportSummary(): CrashReportSummary {
+  return { id: 0, createdAt: "", fatal: false, error: "", appName: "", appVersion: "", platform: "" };
+}
+
+export const CrashReportSummary: MessageFns<CrashReportSummary> = {
+  encode(message: CrashReportSummary, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.fatal !== false) {
+      writer.uint32(24).bool(message.fatal);
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    if (message.appName !== "") {
+      writer.uint32(42).string(message.appName);
+    }
+    if (message.appVersion !== "") {
+      writer.uint32(50).string(message.appVersion);
+    }
+    if (message.platform !== "") {
+      writer.uint32(58).string(message.platform);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CrashReportSummary {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCrashReportSummary();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.fatal = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.appName = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.appVersion = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.platform = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CrashReportSummary {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      fatal: isSet(object.fatal) ? globalThis.Boolean(object.fatal) : false,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+      appName: isSet(object.appName)
+        ? globalThis.String(object.appName)
+        : isSet(object.app_name)
+        ? globalThis.String(object.app_name)
+        : "",
+      appVersion: isSet(object.appVersion)
+        ? globalThis.String(object.appVersion)
+        : isSet(object.app_version)
+        ? globalThis.String(object.app_version)
+        : "",
+      platform: isSet(object.platform) ? globalThis.String(object.platform) : "",
+    };
+  },
+
+  toJSON(message: CrashReportSummary): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.fatal !== false) {
+      obj.fatal = message.fatal;
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    if (message.appName !== "") {
+      obj.appName = message.appName;
+    }
+    if (message.appVersion !== "") {
+      obj.appVersion = message.appVersion;
+    }
+    if (message.platform !== "") {
+      obj.platform = message.platform;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CrashReportSummary>, I>>(base?: I): CrashReportSummary {
+    return CrashReportSummary.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CrashReportSummary>, I>>(object: I): CrashReportSummary {
+    const message = createBaseCrashReportSummary();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.fatal = object.fatal ?? false;
+    message.error = object.error ?? "";
+    message.appName = object.appName ?? "";
+    message.appVersion = object.appVersion ?? "";
+    message.platform = object.platform ?? "";
+    return message;
+  },
+};
+
+function createBaseJobRun(): JobRun {
+  return { name: "", startedAt: "", durationMs: 0, tasks: 0, skipped: 0, error: "" };
+}
+
+export const JobRun: MessageFns<JobRun> = {
+  encode(message: JobRun, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.startedAt !== "") {
+      writer.uint32(18).string(message.startedAt);
+    }
+    if (message.durationMs !== 0) {
+      writer.uint32(24).int64(message.durationMs);
+    }
+    if (message.tasks !== 0) {
+      writer.uint32(32).int32(message.tasks);
+    }
+    if (message.skipped !== 0) {
+      writer.uint32(40).int32(message.skipped);
+    }
+    if (message.error !== "") {
+      writer.uint32(50).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): JobRun {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseJobRun();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.startedAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.durationMs = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.tasks = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.skipped = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobRun {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      startedAt: isSet(object.startedAt)
+        ? globalThis.String(object.startedAt)
+        : isSet(object.started_at)
+        ? globalThis.String(object.started_at)
+        : "",
+      durationMs: isSet(object.durationMs)
+        ? globalThis.Number(object.durationMs)
+        : isSet(object.duration_ms)
+        ? globalThis.Number(object.duration_ms)
+        : 0,
+      tasks: isSet(object.tasks) ? globalThis.Number(object.tasks) : 0,
+      skipped: isSet(object.skipped) ? globalThis.Number(object.skipped) : 0,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: JobRun): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.startedAt !== "") {
+      obj.startedAt = message.startedAt;
+    }
+    if (message.durationMs !== 0) {
+      obj.durationMs = Math.round(message.durationMs);
+    }
+    if (message.tasks !== 0) {
+      obj.tasks = Math.round(message.tasks);
+    }
+    if (message.skipped !== 0) {
+      obj.skipped = Math.round(message.skipped);
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<JobRun>, I>>(base?: I): JobRun {
+    return JobRun.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<JobRun>, I>>(object: I): JobRun {
+    const message = createBaseJobRun();
+    message.name = object.name ?? "";
+    message.startedAt = object.startedAt ?? "";
+    message.durationMs = object.durationMs ?? 0;
+    message.tasks = object.tasks ?? 0;
+    message.skipped = object.skipped ?? 0;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseWebhookRequest(): WebhookRequest {
+  return { url: "", secret: "", eventTypes: [], tournamentIds: [], active: false };
+}
+
+export const WebhookRequest: MessageFns<WebhookRequest> = {
+  encode(message: WebhookRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
+    }
+    if (message.secret !== "") {
+      writer.uint32(18).string(message.secret);
+    }
+    for (const v of message.eventTypes) {
+      writer.uint32(26).string(v!);
+    }
+    writer.uint32(34).fork();
+    for (const v of message.tournamentIds) {
+      writer.uint32(v);
+    }
+    writer.join();
+    if (message.active !== false) {
+      writer.uint32(40).bool(message.active);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WebhookRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.eventTypes.push(reader.string());
+          continue;
+        }
+        case 4: {
+          if (tag === 32) {
+            message.tournamentIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 34) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.tournamentIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.active = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookRequest {
+    return {
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      secret: isSet(object.secret) ? globalThis.String(object.secret) : "",
+      eventTypes: globalThis.Array.isArray(object?.eventTypes)
+        ? object.eventTypes.map((e: any) => globalThis.String(e))
+        : globalThis.Array.isArray(object?.event_types)
+        ? object.event_types.map((e: any) => globalThis.String(e))
+        : [],
+      tournamentIds: globalThis.Array.isArray(object?.tournamentIds)
+        ? object.tournamentIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.tournament_ids)
+        ? object.tournament_ids.map((e: any) => globalThis.Number(e))
+        : [],
+      active: isSet(object.active) ? globalThis.Boolean(object.active) : false,
+    };
+  },
+
+  toJSON(message: WebhookRequest): unknown {
+    const obj: any = {};
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.secret !== "") {
+      obj.secret = message.secret;
+    }
+    if (message.eventTypes?.length) {
+      obj.eventTypes = message.eventTypes;
+    }
+    if (message.tournamentIds?.length) {
+      obj.tournamentIds = message.tournamentIds.map((e) => Math.round(e));
+    }
+    if (message.active !== false) {
+      obj.active = message.active;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WebhookRequest>, I>>(base?: I): WebhookRequest {
+    return WebhookRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WebhookRequest>, I>>(object: I): WebhookRequest {
+    const message = createBaseWebhookRequest();
+    message.url = object.url ?? "";
+    message.secret = object.secret ?? "";
+    message.eventTypes = object.eventTypes?.map((e) => e) || [];
+    message.tournamentIds = object.tournamentIds?.map((e) => e) || [];
+    message.active = object.active ?? false;
+    return message;
+  },
+};
+
+function createBaseWebhook(): Webhook {
+  return { id: 0, createdAt: "", updatedAt: "", url: "", eventTypes: [], tournamentIds: [], active: false, secret: "" };
+}
+
+export const Webhook: MessageFns<Webhook> = {
+  encode(message: Webhook, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.url !== "") {
+      writer.uint32(34).string(message.url);
+    }
+    for (const v of message.eventTypes) {
+      writer.uint32(42).string(v!);
+    }
+    writer.uint32(50).fork();
+    for (const v of message.tournamentIds) {
+      writer.uint32(v);
+    }
+    writer.join();
+    if (message.active !== false) {
+      writer.uint32(56).bool(message.active);
+    }
+    if (message.secret !== "") {
+      writer.uint32(66).string(message.secret);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Webhook {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhook();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.eventTypes.push(reader.string());
+          continue;
+        }
+        case 6: {
+          if (tag === 48) {
+            message.tournamentIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 50) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.tournamentIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.active = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Webhook {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      eventTypes: globalThis.Array.isArray(object?.eventTypes)
+        ? object.eventTypes.map((e: any) => globalThis.String(e))
+        : globalThis.Array.isArray(object?.event_types)
+        ? object.event_types.map((e: any) => globalThis.String(e))
+        : [],
+      tournamentIds: globalThis.Array.isArray(object?.tournamentIds)
+        ? object.tournamentIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.tournament_ids)
+        ? object.tournament_ids.map((e: any) => globalThis.Number(e))
+        : [],
+      active: isSet(object.active) ? globalThis.Boolean(object.active) : false,
+      secret: isSet(object.secret) ? globalThis.String(object.secret) : "",
+    };
+  },
+
+  toJSON(message: Webhook): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.eventTypes?.length) {
+      obj.eventTypes = message.eventTypes;
+    }
+    if (message.tournamentIds?.length) {
+      obj.tournamentIds = message.tournamentIds.map((e) => Math.round(e));
+    }
+    if (message.active !== false) {
+      obj.active = message.active;
+    }
+    if (message.secret !== "") {
+      obj.secret = message.secret;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Webhook>, I>>(base?: I): Webhook {
+    return Webhook.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Webhook>, I>>(object: I): Webhook {
+    const message = createBaseWebhook();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.url = object.url ?? "";
+    message.eventTypes = object.eventTypes?.map((e) => e) || [];
+    message.tournamentIds = object.tournamentIds?.map((e) => e) || [];
+    message.active = object.active ?? false;
+    message.secret = object.secret ?? "";
+    return message;
+  },
+};
+
+function createBaseWebhookList(): WebhookList {
+  return { webhooks: [] };
+}
+
+export const WebhookList: MessageFns<WebhookList> = {
+  encode(message: WebhookList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.webhooks) {
+      Webhook.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WebhookList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.webhooks.push(Webhook.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookList {
+    return {
+      webhooks: globalThis.Array.isArray(object?.webhooks) ? object.webhooks.map((e: any) => Webhook.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: WebhookList): unknown {
+    const obj: any = {};
+    if (message.webhooks?.length) {
+      obj.webhooks = message.webhooks.map((e) => Webhook.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WebhookList>, I>>(base?: I): WebhookList {
+    return WebhookList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WebhookList>, I>>(object: I): WebhookList {
+    const message = createBaseWebhookList();
+    message.webhooks = object.webhooks?.map((e) => Webhook.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWebhookDelivery(): WebhookDelivery {
+  return {
+    id: 0,
+    createdAt: "",
+    subscriptionId: 0,
+    eventType: "",
+    status: "",
+    attempts: 0,
+    nextAttemptAt: 0,
+    responseStatus: 0,
+    lastError: "",
+    deliveredAt: 0,
+    payload: "",
+  };
+}
+
+export const WebhookDelivery: MessageFns<WebhookDelivery> = {
+  encode(message: WebhookDelivery, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.subscriptionId !== 0) {
+      writer.uint32(24).uint32(message.subscriptionId);
+    }
+    if (message.eventType !== "") {
+      writer.uint32(34).string(message.eventType);
+    }
+    if (message.status !== "") {
+      writer.uint32(42).string(message.status);
+    }
+    if (message.attempts !== 0) {
+      writer.uint32(48).int32(message.attempts);
+    }
+    if (message.nextAttemptAt !== 0) {
+      writer.uint32(56).int64(message.nextAttemptAt);
+    }
+    if (message.responseStatus !== 0) {
+      writer.uint32(64).int32(message.responseStatus);
+    }
+    if (message.lastError !== "") {
+      writer.uint32(74).string(message.lastError);
+    }
+    if (message.deliveredAt !== 0) {
+      writer.uint32(80).int64(message.deliveredAt);
+    }
+    if (message.payload !== "") {
+      writer.uint32(90).string(message.payload);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WebhookDelivery {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookDelivery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.subscriptionId = reader.uint32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.eventType = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.status = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.attempts = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.nextAttemptAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.responseStatus = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.lastError = reader.string();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.deliveredAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookDelivery {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      subscriptionId: isSet(object.subscriptionId)
+        ? globalThis.Number(object.subscriptionId)
+        : isSet(object.subscription_id)
+        ? globalThis.Number(object.subscription_id)
+        : 0,
+      eventType: isSet(object.eventType)
+        ? globalThis.String(object.eventType)
+        : isSet(object.event_type)
+        ? globalThis.String(object.event_type)
+        : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+      attempts: isSet(object.attempts) ? globalThis.Number(object.attempts) : 0,
+      nextAttemptAt: isSet(object.nextAttemptAt)
+        ? globalThis.Number(object.nextAttemptAt)
+        : isSet(object.next_attempt_at)
+        ? globalThis.Number(object.next_attempt_at)
+        : 0,
+      responseStatus: isSet(object.responseStatus)
+        ? globalThis.Number(object.responseStatus)
+        : isSet(object.response_status)
+        ? globalThis.Number(object.response_status)
+        : 0,
+      lastError: isSet(object.lastError)
+        ? globalThis.String(object.lastError)
+        : isSet(object.last_error)
+        ? globalThis.String(object.last_error)
+        : "",
+      deliveredAt: isSet(object.deliveredAt)
+        ? globalThis.Number(object.deliveredAt)
+        : isSet(object.delivered_at)
+        ? globalThis.Number(object.delivered_at)
+        : 0,
+      payload: isSet(object.payload) ? globalThis.String(object.payload) : "",
+    };
+  },
+
+  toJSON(message: WebhookDelivery): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.subscriptionId !== 0) {
+      obj.subscriptionId = Math.round(message.subscriptionId);
+    }
+    if (message.eventType !== "") {
+      obj.eventType = message.eventType;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    if (message.attempts !== 0) {
+      obj.attempts = Math.round(message.attempts);
+    }
+    if (message.nextAttemptAt !== 0) {
+      obj.nextAttemptAt = Math.round(message.nextAttemptAt);
+    }
+    if (message.responseStatus !== 0) {
+      obj.responseStatus = Math.round(message.responseStatus);
+    }
+    if (message.lastError !== "") {
+      obj.lastError = message.lastError;
+    }
+    if (message.deliveredAt !== 0) {
+      obj.deliveredAt = Math.round(message.deliveredAt);
+    }
+    if (message.payload !== "") {
+      obj.payload = message.payload;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WebhookDelivery>, I>>(base?: I): WebhookDelivery {
+    return WebhookDelivery.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WebhookDelivery>, I>>(object: I): WebhookDelivery {
+    const message = createBaseWebhookDelivery();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.subscriptionId = object.subscriptionId ?? 0;
+    message.eventType = object.eventType ?? "";
+    message.status = object.status ?? "";
+    message.attempts = object.attempts ?? 0;
+    message.nextAttemptAt = object.nextAttemptAt ?? 0;
+    message.responseStatus = object.responseStatus ?? 0;
+    message.lastError = object.lastError ?? "";
+    message.deliveredAt = object.deliveredAt ?? 0;
+    message.payload = object.payload ?? "";
+    return message;
+  },
+};
+
+function createBaseWebhookDeliveryList(): WebhookDeliveryList {
+  return { deliveries: [], total: 0 };
+}
+
+export const WebhookDeliveryList: MessageFns<WebhookDeliveryList> = {
+  encode(message: WebhookDeliveryList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.deliveries) {
+      WebhookDelivery.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.total !== 0) {
+      writer.uint32(16).int64(message.total);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WebhookDeliveryList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookDeliveryList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deliveries.push(WebhookDelivery.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.total = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookDeliveryList {
+    return {
+      deliveries: globalThis.Array.isArray(object?.deliveries)
+        ? object.deliveries.map((e: any) => WebhookDelivery.fromJSON(e))
+        : [],
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+    };
+  },
+
+  toJSON(message: WebhookDeliveryList): unknown {
+    const obj: any = {};
+    if (message.deliveries?.length) {
+      obj.deliveries = message.deliveries.map((e) => WebhookDelivery.toJSON(e));
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WebhookDeliveryList>, I>>(base?: I): WebhookDeliveryList {
+    return WebhookDeliveryList.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WebhookDeliveryList>, I>>(object: I): WebhookDeliveryList {
+    const message = createBaseWebhookDeliveryList();
+    message.deliveries = object.deliveries?.map((e) => WebhookDelivery.fromPartial(e)) || [];
+    message.total = object.total ?? 0;
+    return message;
+  },
+};
+
+function createBaseLogPlaybackRequest(): LogPlaybackRequest {
+  return { deviceToken: "", content: "", startedAt: 0 };
+}
+
+export const LogPlaybackRequest: MessageFns<LogPlaybackRequest> = {
+  encode(message: LogPlaybackRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.deviceToken !== "") {
+      writer.uint32(10).string(message.deviceToken);
+    }
+    if (message.content !== "") {
+      writer.uint32(18).string(message.content);
+    }
+    if (message.startedAt !== 0) {
+      writer.uint32(24).int64(message.startedAt);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LogPlaybackRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLogPlaybackRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deviceToken = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.startedAt = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LogPlaybackRequest {
+    return {
+      deviceToken: isSet(object.deviceToken)
+        ? globalThis.String(object.deviceToken)
+        : isSet(object.device_token)
+        ? globalThis.String(object.device_token)
+        : "",
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+      startedAt: isSet(object.startedAt)
+        ? globalThis.Number(object.startedAt)
+        : isSet(object.started_at)
+        ? globalThis.Number(object.started_at)
+        : 0,
+    };
+  },
+
+  toJSON(message: LogPlaybackRequest): unknown {
+    const obj: any = {};
+    if (message.deviceToken !== "") {
+      obj.deviceToken = message.deviceToken;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.startedAt !== 0) {
+      obj.startedAt = Math.round(message.startedAt);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<LogPlaybackRequest>, I>>(base?: I): LogPlaybackRequest {
+    return LogPlaybackRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<LogPlaybackRequest>, I>>(object: I): LogPlaybackRequest {
+    const message = createBaseLogPlaybackRequest();
+    message.deviceToken = object.deviceToken ?? "";
+    message.content = object.content ?? "";
+    message.startedAt = object.startedAt ?? 0;
+    return message;
+  },
+};
+
+function createBaseUpdatePlaybackRequest(): UpdatePlaybackRequest {
+  return { endedAt: 0 };
+}
+
+export const UpdatePlaybackRequest: MessageFns<UpdatePlaybackRequest> = {
+  encode(message: UpdatePlaybackRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.endedAt !== 0) {
+      writer.uint32(8).int64(message.endedAt);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdatePlaybackRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdatePlaybackRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.endedAt = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdatePlaybackRequest {
+    return {
+      endedAt: isSet(object.endedAt)
+        ? globalThis.Number(object.endedAt)
+        : isSet(object.ended_at)
+        ? globalThis.Number(object.ended_at)
+        : 0,
+    };
+  },
+
+  toJSON(message: UpdatePlaybackRequest): unknown {
+    const obj: any = {};
+    if (message.endedAt !== 0) {
+      obj.endedAt = Math.round(message.endedAt);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<UpdatePlaybackRequest>, I>>(base?: I): UpdatePlaybackRequest {
+    return UpdatePlaybackRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<UpdatePlaybackRequest>, I>>(object: I): UpdatePlaybackRequest {
+    const message = createBaseUpdatePlaybackRequest();
+    message.endedAt = object.endedAt ?? 0;
+    return message;
+  },
+};
+
+function createBasePlaybackLog(): PlaybackLog {
+  return { id: 0, createdAt: "", updatedAt: "", deviceId: 0, content: "", startedAt: 0, endedAt: 0 };
+}
+
+export const PlaybackLog: MessageFns<PlaybackLog> = {
+  encode(message: PlaybackLog, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
+    }
+    if (message.deviceId !== 0) {
+      writer.uint32(32).uint32(message.deviceId);
+    }
+    if (message.content !== "") {
+      writer.uint32(42).string(message.content);
+    }
+    if (message.startedAt !== 0) {
+      writer.uint32(48).int64(message.startedAt);
+    }
+    if (message.endedAt !== 0) {
+      writer.uint32(56).int64(message.endedAt);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PlaybackLog {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlaybackLog();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.startedAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.endedAt = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlaybackLog {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
+        : 0,
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+      startedAt: isSet(object.startedAt)
+        ? globalThis.Number(object.startedAt)
+        : isSet(object.started_at)
+        ? globalThis.Number(object.started_at)
+        : 0,
+      endedAt: isSet(object.endedAt)
+        ? globalThis.Number(object.endedAt)
+        : isSet(object.ended_at)
+        ? globalThis.Number(object.ended_at)
+        : 0,
+    };
+  },
+
+  toJSON(message: PlaybackLog): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.startedAt !== 0) {
+      obj.startedAt = Math.round(message.startedAt);
+    }
+    if (message.endedAt !== 0) {
+      obj.endedAt = Math.round(message.endedAt);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PlaybackLog>, I>>(base?: I): PlaybackLog {
+    return PlaybackLog.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PlaybackLog>, I>>(object: I): PlaybackLog {
+    const message = createBasePlaybackLog();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.content = object.content ?? "";
+    message.startedAt = object.startedAt ?? 0;
+    message.endedAt = object.endedAt ?? 0;
+    return message;
+  },
+};
+
+function createBasePlaybackSession(): PlaybackSession {
+  return { playback: undefined, heartbeatIntervalSeconds: 0 };
+}
+
+export const PlaybackSession: MessageFns<PlaybackSession> = {
+  encode(message: PlaybackSession, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.playback !== undefined) {
+      PlaybackLog.encode(message.playback, writer.uint32(10).fork()).join();
+    }
+    if (message.heartbeatIntervalSeconds !== 0) {
+      writer.uint32(16).int32(message.heartbeatIntervalSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PlaybackSession {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlaybackSession();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.playback = PlaybackLog.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.heartbeatIntervalSeconds = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlaybackSession {
+    return {
+      playback: isSet(object.playback) ? PlaybackLog.fromJSON(object.playback) : undefined,
+      heartbeatIntervalSeconds: isSet(object.heartbeatIntervalSeconds)
+        ? globalThis.Number(object.heartbeatIntervalSeconds)
+        : isSet(object.heartbeat_interval_seconds)
+        ? globalThis.Number(object.heartbeat_interval_seconds)
         : 0,
     };
   },
 
-  toJSON(message: DeviceList): unknown {
+  toJSON(message: PlaybackSession): unknown {
     const obj: any = {};
-    if (message.data?.length) {
-      obj.data = message.data.map((e) => Device.toJSON(e));
-    }
-    if (message.page !== 0) {
-      obj.page = Math.round(message.page);
-    }
-    if (message.limit !== 0) {
-      obj.limit = Math.round(message.limit);
-    }
-    if (message.total !== 0) {
-      obj.total = Math.round(message.total);
+    if (message.playback !== undefined) {
+      obj.playback = PlaybackLog.toJSON(message.playback);
     }
-    if (message.totalPages !== 0) {
-      obj.totalPages = Math.round(message.totalPages);
+    if (message.heartbeatIntervalSeconds !== 0) {
+      obj.heartbeatIntervalSeconds = Math.round(message.heartbeatIntervalSeconds);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DeviceList>, I>>(base?: I): DeviceList {
-    return DeviceList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<PlaybackSession>, I>>(base?: I): PlaybackSession {
+    return PlaybackSession.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DeviceList>, I>>(object: I): DeviceList {
-    const message = createBaseDeviceList();
-    message.data = object.data?.map((e) => Device.fromPartial(e)) || [];
-    message.page = object.page ?? 0;
-    message.limit = object.limit ?? 0;
-    message.total = object.total ?? 0;
-    message.totalPages = object.totalPages ?? 0;
+  fromPartial<I extends Exact<DeepPartial<PlaybackSession>, I>>(object: I): PlaybackSession {
+    const message = createBasePlaybackSession();
+    message.playback = (object.playback !== undefined && object.playback !== null)
+      ? PlaybackLog.fromPartial(object.playback)
+      : undefined;
+    message.heartbeatIntervalSeconds = object.heartbeatIntervalSeconds ?? 0;
     return message;
   },
 };
 
-function createBaseDeviceUrl(): DeviceUrl {
-  return { url: "" };
+function createBasePlaybackLogList(): PlaybackLogList {
+  return { list: [], total: 0 };
 }
 
-export const DeviceUrl: MessageFns<DeviceUrl> = {
-  encode(message: DeviceUrl, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.url !== "") {
-      writer.uint32(10).string(message.url);
+export const PlaybackLogList: MessageFns<PlaybackLogList> = {
+  encode(message: PlaybackLogList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.list) {
+      PlaybackLog.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.total !== 0) {
+      writer.uint32(16).uint32(message.total);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DeviceUrl {
+  decode(input: BinaryReader | Uint8Array, length?: number): PlaybackLogList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeviceUrl();
+    const message = createBasePlaybackLogList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1079,7 +11565,15 @@ export const DeviceUrl: MessageFns<DeviceUrl> = {
             break;
           }
 
-          message.url = reader.string();
+          message.list.push(PlaybackLog.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.total = reader.uint32();
           continue;
         }
       }
@@ -1091,64 +11585,71 @@ export const DeviceUrl: MessageFns<DeviceUrl> = {
     return message;
   },
 
-  fromJSON(object: any): DeviceUrl {
-    return { url: isSet(object.url) ? globalThis.String(object.url) : "" };
+  fromJSON(object: any): PlaybackLogList {
+    return {
+      list: globalThis.Array.isArray(object?.list) ? object.list.map((e: any) => PlaybackLog.fromJSON(e)) : [],
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+    };
   },
 
-  toJSON(message: DeviceUrl): unknown {
+  toJSON(message: PlaybackLogList): unknown {
     const obj: any = {};
-    if (message.url !== "") {
-      obj.url = message.url;
+    if (message.list?.length) {
+      obj.list = message.list.map((e) => PlaybackLog.toJSON(e));
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DeviceUrl>, I>>(base?: I): DeviceUrl {
-    return DeviceUrl.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<PlaybackLogList>, I>>(base?: I): PlaybackLogList {
+    return PlaybackLogList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DeviceUrl>, I>>(object: I): DeviceUrl {
-    const message = createBaseDeviceUrl();
-    message.url = object.url ?? "";
+  fromPartial<I extends Exact<DeepPartial<PlaybackLogList>, I>>(object: I): PlaybackLogList {
+    const message = createBasePlaybackLogList();
+    message.list = object.list?.map((e) => PlaybackLog.fromPartial(e)) || [];
+    message.total = object.total ?? 0;
     return message;
   },
 };
 
-function createBaseTournamentRequest(): TournamentRequest {
-  return { name: "", slug: "" };
+function createBaseEventStats(): EventStats {
+  return { sofaScoreEventId: 0, viewCount: 0 };
 }
 
-export const TournamentRequest: MessageFns<TournamentRequest> = {
-  encode(message: TournamentRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const EventStats: MessageFns<EventStats> = {
+  encode(message: EventStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sofaScoreEventId !== 0) {
+      writer.uint32(8).int64(message.sofaScoreEventId);
     }
-    if (message.slug !== "") {
-      writer.uint32(18).string(message.slug);
+    if (message.viewCount !== 0) {
+      writer.uint32(16).int64(message.viewCount);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): TournamentRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): EventStats {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTournamentRequest();
+    const message = createBaseEventStats();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.sofaScoreEventId = longToNumber(reader.int64());
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.slug = reader.string();
+          message.viewCount = longToNumber(reader.int64());
           continue;
         }
       }
@@ -1160,66 +11661,177 @@ export const TournamentRequest: MessageFns<TournamentRequest> = {
     return message;
   },
 
-  fromJSON(object: any): TournamentRequest {
+  fromJSON(object: any): EventStats {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
+      sofaScoreEventId: isSet(object.sofaScoreEventId)
+        ? globalThis.Number(object.sofaScoreEventId)
+        : isSet(object.sofa_score_event_id)
+        ? globalThis.Number(object.sofa_score_event_id)
+        : 0,
+      viewCount: isSet(object.viewCount)
+        ? globalThis.Number(object.viewCount)
+        : isSet(object.view_count)
+        ? globalThis.Number(object.view_count)
+        : 0,
+    };
+  },
+
+  toJSON(message: EventStats): unknown {
+    const obj: any = {};
+    if (message.sofaScoreEventId !== 0) {
+      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
+    }
+    if (message.viewCount !== 0) {
+      obj.viewCount = Math.round(message.viewCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EventStats>, I>>(base?: I): EventStats {
+    return EventStats.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EventStats>, I>>(object: I): EventStats {
+    const message = createBaseEventStats();
+    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
+    message.viewCount = object.viewCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseTopEventsResponse(): TopEventsResponse {
+  return { stats: [] };
+}
+
+export const TopEventsResponse: MessageFns<TopEventsResponse> = {
+  encode(message: TopEventsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.stats) {
+      EventStats.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TopEventsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTopEventsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.stats.push(EventStats.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TopEventsResponse {
+    return {
+      stats: globalThis.Array.isArray(object?.stats) ? object.stats.map((e: any) => EventStats.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: TournamentRequest): unknown {
+  toJSON(message: TopEventsResponse): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.slug !== "") {
-      obj.slug = message.slug;
+    if (message.stats?.length) {
+      obj.stats = message.stats.map((e) => EventStats.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TournamentRequest>, I>>(base?: I): TournamentRequest {
-    return TournamentRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<TopEventsResponse>, I>>(base?: I): TopEventsResponse {
+    return TopEventsResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<TournamentRequest>, I>>(object: I): TournamentRequest {
-    const message = createBaseTournamentRequest();
-    message.name = object.name ?? "";
-    message.slug = object.slug ?? "";
+  fromPartial<I extends Exact<DeepPartial<TopEventsResponse>, I>>(object: I): TopEventsResponse {
+    const message = createBaseTopEventsResponse();
+    message.stats = object.stats?.map((e) => EventStats.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseTournament(): Tournament {
-  return { id: 0, createdAt: "", updatedAt: "", name: "", slug: "", region: "" };
+function createBaseApkInfo(): ApkInfo {
+  return {
+    id: 0,
+    version: "",
+    fileName: "",
+    fileSize: 0,
+    description: "",
+    packageName: "",
+    versionCode: 0,
+    minSdkVersion: 0,
+    targetSdkVersion: 0,
+    downloadToken: "",
+    downloadUrl: "",
+    createdAt: "",
+    isActive: false,
+    downloads: 0,
+    panelUrl: "",
+  };
 }
 
-export const Tournament: MessageFns<Tournament> = {
-  encode(message: Tournament, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const ApkInfo: MessageFns<ApkInfo> = {
+  encode(message: ApkInfo, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.id !== 0) {
       writer.uint32(8).uint32(message.id);
     }
-    if (message.createdAt !== "") {
-      writer.uint32(18).string(message.createdAt);
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
     }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
+    if (message.fileName !== "") {
+      writer.uint32(26).string(message.fileName);
     }
-    if (message.name !== "") {
-      writer.uint32(34).string(message.name);
+    if (message.fileSize !== 0) {
+      writer.uint32(32).int64(message.fileSize);
     }
-    if (message.slug !== "") {
-      writer.uint32(42).string(message.slug);
+    if (message.description !== "") {
+      writer.uint32(42).string(message.description);
     }
-    if (message.region !== "") {
-      writer.uint32(50).string(message.region);
+    if (message.packageName !== "") {
+      writer.uint32(50).string(message.packageName);
+    }
+    if (message.versionCode !== 0) {
+      writer.uint32(56).int32(message.versionCode);
+    }
+    if (message.minSdkVersion !== 0) {
+      writer.uint32(64).int32(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      writer.uint32(72).int32(message.targetSdkVersion);
+    }
+    if (message.downloadToken !== "") {
+      writer.uint32(82).string(message.downloadToken);
+    }
+    if (message.downloadUrl !== "") {
+      writer.uint32(90).string(message.downloadUrl);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(98).string(message.createdAt);
+    }
+    if (message.isActive !== false) {
+      writer.uint32(104).bool(message.isActive);
+    }
+    if (message.downloads !== 0) {
+      writer.uint32(112).int32(message.downloads);
+    }
+    if (message.panelUrl !== "") {
+      writer.uint32(122).string(message.panelUrl);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Tournament {
+  decode(input: BinaryReader | Uint8Array, length?: number): ApkInfo {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTournament();
+    const message = createBaseApkInfo();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1236,7 +11848,7 @@ export const Tournament: MessageFns<Tournament> = {
             break;
           }
 
-          message.createdAt = reader.string();
+          message.version = reader.string();
           continue;
         }
         case 3: {
@@ -1244,15 +11856,15 @@ export const Tournament: MessageFns<Tournament> = {
             break;
           }
 
-          message.updatedAt = reader.string();
+          message.fileName = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.name = reader.string();
+          message.fileSize = longToNumber(reader.int64());
           continue;
         }
         case 5: {
@@ -1260,7 +11872,7 @@ export const Tournament: MessageFns<Tournament> = {
             break;
           }
 
-          message.slug = reader.string();
+          message.description = reader.string();
           continue;
         }
         case 6: {
@@ -1268,173 +11880,79 @@ export const Tournament: MessageFns<Tournament> = {
             break;
           }
 
-          message.region = reader.string();
+          message.packageName = reader.string();
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): Tournament {
-    return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
-        : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "",
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
-      region: isSet(object.region) ? globalThis.String(object.region) : "",
-    };
-  },
-
-  toJSON(message: Tournament): unknown {
-    const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
-    }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
-    }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
-    }
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.slug !== "") {
-      obj.slug = message.slug;
-    }
-    if (message.region !== "") {
-      obj.region = message.region;
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<Tournament>, I>>(base?: I): Tournament {
-    return Tournament.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<Tournament>, I>>(object: I): Tournament {
-    const message = createBaseTournament();
-    message.id = object.id ?? 0;
-    message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
-    message.name = object.name ?? "";
-    message.slug = object.slug ?? "";
-    message.region = object.region ?? "";
-    return message;
-  },
-};
-
-function createBaseTournamentList(): TournamentList {
-  return { tournaments: [] };
-}
-
-export const TournamentList: MessageFns<TournamentList> = {
-  encode(message: TournamentList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.tournaments) {
-      Tournament.encode(v!, writer.uint32(10).fork()).join();
-    }
-    return writer;
-  },
-
-  decode(input: BinaryReader | Uint8Array, length?: number): TournamentList {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTournamentList();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
+        case 7: {
+          if (tag !== 56) {
             break;
           }
 
-          message.tournaments.push(Tournament.decode(reader, reader.uint32()));
+          message.versionCode = reader.int32();
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
 
-  fromJSON(object: any): TournamentList {
-    return {
-      tournaments: globalThis.Array.isArray(object?.tournaments)
-        ? object.tournaments.map((e: any) => Tournament.fromJSON(e))
-        : [],
-    };
-  },
+          message.minSdkVersion = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
 
-  toJSON(message: TournamentList): unknown {
-    const obj: any = {};
-    if (message.tournaments?.length) {
-      obj.tournaments = message.tournaments.map((e) => Tournament.toJSON(e));
-    }
-    return obj;
-  },
+          message.targetSdkVersion = reader.int32();
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
 
-  create<I extends Exact<DeepPartial<TournamentList>, I>>(base?: I): TournamentList {
-    return TournamentList.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<TournamentList>, I>>(object: I): TournamentList {
-    const message = createBaseTournamentList();
-    message.tournaments = object.tournaments?.map((e) => Tournament.fromPartial(e)) || [];
-    return message;
-  },
-};
+          message.downloadToken = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
 
-function createBaseAssignTournamentRequest(): AssignTournamentRequest {
-  return { deviceId: 0, tournamentId: 0 };
-}
+          message.downloadUrl = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
 
-export const AssignTournamentRequest: MessageFns<AssignTournamentRequest> = {
-  encode(message: AssignTournamentRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.deviceId !== 0) {
-      writer.uint32(8).uint32(message.deviceId);
-    }
-    if (message.tournamentId !== 0) {
-      writer.uint32(16).uint32(message.tournamentId);
-    }
-    return writer;
-  },
+          message.createdAt = reader.string();
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AssignTournamentRequest {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAssignTournamentRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 8) {
+          message.isActive = reader.bool();
+          continue;
+        }
+        case 14: {
+          if (tag !== 112) {
             break;
           }
 
-          message.deviceId = reader.uint32();
+          message.downloads = reader.int32();
           continue;
         }
-        case 2: {
-          if (tag !== 16) {
+        case 15: {
+          if (tag !== 122) {
             break;
           }
 
-          message.tournamentId = reader.uint32();
+          message.panelUrl = reader.string();
           continue;
         }
       }
@@ -1446,81 +11964,170 @@ export const AssignTournamentRequest: MessageFns<AssignTournamentRequest> = {
     return message;
   },
 
-  fromJSON(object: any): AssignTournamentRequest {
+  fromJSON(object: any): ApkInfo {
     return {
-      deviceId: isSet(object.deviceId)
-        ? globalThis.Number(object.deviceId)
-        : isSet(object.device_id)
-        ? globalThis.Number(object.device_id)
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      fileName: isSet(object.fileName)
+        ? globalThis.String(object.fileName)
+        : isSet(object.file_name)
+        ? globalThis.String(object.file_name)
+        : "",
+      fileSize: isSet(object.fileSize)
+        ? globalThis.Number(object.fileSize)
+        : isSet(object.file_size)
+        ? globalThis.Number(object.file_size)
         : 0,
-      tournamentId: isSet(object.tournamentId)
-        ? globalThis.Number(object.tournamentId)
-        : isSet(object.tournament_id)
-        ? globalThis.Number(object.tournament_id)
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      versionCode: isSet(object.versionCode)
+        ? globalThis.Number(object.versionCode)
+        : isSet(object.version_code)
+        ? globalThis.Number(object.version_code)
         : 0,
+      minSdkVersion: isSet(object.minSdkVersion)
+        ? globalThis.Number(object.minSdkVersion)
+        : isSet(object.min_sdk_version)
+        ? globalThis.Number(object.min_sdk_version)
+        : 0,
+      targetSdkVersion: isSet(object.targetSdkVersion)
+        ? globalThis.Number(object.targetSdkVersion)
+        : isSet(object.target_sdk_version)
+        ? globalThis.Number(object.target_sdk_version)
+        : 0,
+      downloadToken: isSet(object.downloadToken)
+        ? globalThis.String(object.downloadToken)
+        : isSet(object.download_token)
+        ? globalThis.String(object.download_token)
+        : "",
+      downloadUrl: isSet(object.downloadUrl)
+        ? globalThis.String(object.downloadUrl)
+        : isSet(object.download_url)
+        ? globalThis.String(object.download_url)
+        : "",
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "",
+      isActive: isSet(object.isActive)
+        ? globalThis.Boolean(object.isActive)
+        : isSet(object.is_active)
+        ? globalThis.Boolean(object.is_active)
+        : false,
+      downloads: isSet(object.downloads) ? globalThis.Number(object.downloads) : 0,
+      panelUrl: isSet(object.panelUrl)
+        ? globalThis.String(object.panelUrl)
+        : isSet(object.panel_url)
+        ? globalThis.String(object.panel_url)
+        : "",
     };
   },
 
-  toJSON(message: AssignTournamentRequest): unknown {
+  toJSON(message: ApkInfo): unknown {
     const obj: any = {};
-    if (message.deviceId !== 0) {
-      obj.deviceId = Math.round(message.deviceId);
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
     }
-    if (message.tournamentId !== 0) {
-      obj.tournamentId = Math.round(message.tournamentId);
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.fileName !== "") {
+      obj.fileName = message.fileName;
+    }
+    if (message.fileSize !== 0) {
+      obj.fileSize = Math.round(message.fileSize);
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
+    }
+    if (message.versionCode !== 0) {
+      obj.versionCode = Math.round(message.versionCode);
+    }
+    if (message.minSdkVersion !== 0) {
+      obj.minSdkVersion = Math.round(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
+    }
+    if (message.downloadToken !== "") {
+      obj.downloadToken = message.downloadToken;
+    }
+    if (message.downloadUrl !== "") {
+      obj.downloadUrl = message.downloadUrl;
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.isActive !== false) {
+      obj.isActive = message.isActive;
+    }
+    if (message.downloads !== 0) {
+      obj.downloads = Math.round(message.downloads);
+    }
+    if (message.panelUrl !== "") {
+      obj.panelUrl = message.panelUrl;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AssignTournamentRequest>, I>>(base?: I): AssignTournamentRequest {
-    return AssignTournamentRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ApkInfo>, I>>(base?: I): ApkInfo {
+    return ApkInfo.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AssignTournamentRequest>, I>>(object: I): AssignTournamentRequest {
-    const message = createBaseAssignTournamentRequest();
-    message.deviceId = object.deviceId ?? 0;
-    message.tournamentId = object.tournamentId ?? 0;
+  fromPartial<I extends Exact<DeepPartial<ApkInfo>, I>>(object: I): ApkInfo {
+    const message = createBaseApkInfo();
+    message.id = object.id ?? 0;
+    message.version = object.version ?? "";
+    message.fileName = object.fileName ?? "";
+    message.fileSize = object.fileSize ?? 0;
+    message.description = object.description ?? "";
+    message.packageName = object.packageName ?? "";
+    message.versionCode = object.versionCode ?? 0;
+    message.minSdkVersion = object.minSdkVersion ?? 0;
+    message.targetSdkVersion = object.targetSdkVersion ?? 0;
+    message.downloadToken = object.downloadToken ?? "";
+    message.downloadUrl = object.downloadUrl ?? "";
+    message.createdAt = object.createdAt ?? "";
+    message.isActive = object.isActive ?? false;
+    message.downloads = object.downloads ?? 0;
+    message.panelUrl = object.panelUrl ?? "";
     return message;
   },
 };
 
-function createBaseSetTournamentIdsRequest(): SetTournamentIdsRequest {
-  return { tournamentIds: [] };
+function createBaseApkList(): ApkList {
+  return { versions: [] };
 }
 
-export const SetTournamentIdsRequest: MessageFns<SetTournamentIdsRequest> = {
-  encode(message: SetTournamentIdsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    writer.uint32(10).fork();
-    for (const v of message.tournamentIds) {
-      writer.uint32(v);
+export const ApkList: MessageFns<ApkList> = {
+  encode(message: ApkList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.versions) {
+      ApkInfo.encode(v!, writer.uint32(10).fork()).join();
     }
-    writer.join();
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): SetTournamentIdsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): ApkList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSetTournamentIdsRequest();
+    const message = createBaseApkList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag === 8) {
-            message.tournamentIds.push(reader.uint32());
-
-            continue;
-          }
-
-          if (tag === 10) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.tournamentIds.push(reader.uint32());
-            }
-
-            continue;
+          if (tag !== 10) {
+            break;
           }
 
-          break;
+          message.versions.push(ApkInfo.decode(reader, reader.uint32()));
+          continue;
         }
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1531,76 +12138,92 @@ export const SetTournamentIdsRequest: MessageFns<SetTournamentIdsRequest> = {
     return message;
   },
 
-  fromJSON(object: any): SetTournamentIdsRequest {
+  fromJSON(object: any): ApkList {
     return {
-      tournamentIds: globalThis.Array.isArray(object?.tournamentIds)
-        ? object.tournamentIds.map((e: any) => globalThis.Number(e))
-        : globalThis.Array.isArray(object?.tournament_ids)
-        ? object.tournament_ids.map((e: any) => globalThis.Number(e))
-        : [],
+      versions: globalThis.Array.isArray(object?.versions) ? object.versions.map((e: any) => ApkInfo.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: SetTournamentIdsRequest): unknown {
+  toJSON(message: ApkList): unknown {
     const obj: any = {};
-    if (message.tournamentIds?.length) {
-      obj.tournamentIds = message.tournamentIds.map((e) => Math.round(e));
+    if (message.versions?.length) {
+      obj.versions = message.versions.map((e) => ApkInfo.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<SetTournamentIdsRequest>, I>>(base?: I): SetTournamentIdsRequest {
-    return SetTournamentIdsRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ApkList>, I>>(base?: I): ApkList {
+    return ApkList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<SetTournamentIdsRequest>, I>>(object: I): SetTournamentIdsRequest {
-    const message = createBaseSetTournamentIdsRequest();
-    message.tournamentIds = object.tournamentIds?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<ApkList>, I>>(object: I): ApkList {
+    const message = createBaseApkList();
+    message.versions = object.versions?.map((e) => ApkInfo.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseDeviceTournament(): DeviceTournament {
+function createBaseApkUploadResponse(): ApkUploadResponse {
   return {
     id: 0,
+    version: "",
+    fileName: "",
+    fileSize: 0,
+    description: "",
+    packageName: "",
+    versionCode: 0,
+    minSdkVersion: 0,
+    targetSdkVersion: 0,
+    downloadToken: "",
+    downloadUrl: "",
     createdAt: "",
-    updatedAt: "",
-    deviceId: 0,
-    tournamentId: 0,
-    device: undefined,
-    tournament: undefined,
   };
 }
 
-export const DeviceTournament: MessageFns<DeviceTournament> = {
-  encode(message: DeviceTournament, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
+  encode(message: ApkUploadResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.id !== 0) {
       writer.uint32(8).uint32(message.id);
     }
-    if (message.createdAt !== "") {
-      writer.uint32(18).string(message.createdAt);
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
     }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
+    if (message.fileName !== "") {
+      writer.uint32(26).string(message.fileName);
     }
-    if (message.deviceId !== 0) {
-      writer.uint32(32).uint32(message.deviceId);
+    if (message.fileSize !== 0) {
+      writer.uint32(32).int64(message.fileSize);
     }
-    if (message.tournamentId !== 0) {
-      writer.uint32(40).uint32(message.tournamentId);
+    if (message.description !== "") {
+      writer.uint32(42).string(message.description);
     }
-    if (message.device !== undefined) {
-      Device.encode(message.device, writer.uint32(50).fork()).join();
+    if (message.packageName !== "") {
+      writer.uint32(50).string(message.packageName);
     }
-    if (message.tournament !== undefined) {
-      Tournament.encode(message.tournament, writer.uint32(58).fork()).join();
+    if (message.versionCode !== 0) {
+      writer.uint32(56).int32(message.versionCode);
+    }
+    if (message.minSdkVersion !== 0) {
+      writer.uint32(64).int32(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      writer.uint32(72).int32(message.targetSdkVersion);
+    }
+    if (message.downloadToken !== "") {
+      writer.uint32(82).string(message.downloadToken);
+    }
+    if (message.downloadUrl !== "") {
+      writer.uint32(90).string(message.downloadUrl);
+    }
+    if (message.createdAt !== "") {
+      writer.uint32(98).string(message.createdAt);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTournament {
+  decode(input: BinaryReader | Uint8Array, length?: number): ApkUploadResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeviceTournament();
+    const message = createBaseApkUploadResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1617,7 +12240,7 @@ export const DeviceTournament: MessageFns<DeviceTournament> = {
             break;
           }
 
-          message.createdAt = reader.string();
+          message.version = reader.string();
           continue;
         }
         case 3: {
@@ -1625,7 +12248,7 @@ export const DeviceTournament: MessageFns<DeviceTournament> = {
             break;
           }
 
-          message.updatedAt = reader.string();
+          message.fileName = reader.string();
           continue;
         }
         case 4: {
@@ -1633,15 +12256,15 @@ export const DeviceTournament: MessageFns<DeviceTournament> = {
             break;
           }
 
-          message.deviceId = reader.uint32();
+          message.fileSize = longToNumber(reader.int64());
           continue;
         }
         case 5: {
-          if (tag !== 40) {
+          if (tag !== 42) {
             break;
           }
 
-          message.tournamentId = reader.uint32();
+          message.description = reader.string();
           continue;
         }
         case 6: {
@@ -1649,15 +12272,55 @@ export const DeviceTournament: MessageFns<DeviceTournament> = {
             break;
           }
 
-          message.device = Device.decode(reader, reader.uint32());
+          message.packageName = reader.string();
           continue;
         }
         case 7: {
-          if (tag !== 58) {
+          if (tag !== 56) {
             break;
           }
 
-          message.tournament = Tournament.decode(reader, reader.uint32());
+          message.versionCode = reader.int32();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.minSdkVersion = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.targetSdkVersion = reader.int32();
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.downloadToken = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.downloadUrl = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.createdAt = reader.string();
           continue;
         }
       }
@@ -1669,172 +12332,171 @@ export const DeviceTournament: MessageFns<DeviceTournament> = {
     return message;
   },
 
-  fromJSON(object: any): DeviceTournament {
+  fromJSON(object: any): ApkUploadResponse {
     return {
       id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      fileName: isSet(object.fileName)
+        ? globalThis.String(object.fileName)
+        : isSet(object.file_name)
+        ? globalThis.String(object.file_name)
+        : "",
+      fileSize: isSet(object.fileSize)
+        ? globalThis.Number(object.fileSize)
+        : isSet(object.file_size)
+        ? globalThis.Number(object.file_size)
+        : 0,
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
+        : "",
+      versionCode: isSet(object.versionCode)
+        ? globalThis.Number(object.versionCode)
+        : isSet(object.version_code)
+        ? globalThis.Number(object.version_code)
+        : 0,
+      minSdkVersion: isSet(object.minSdkVersion)
+        ? globalThis.Number(object.minSdkVersion)
+        : isSet(object.min_sdk_version)
+        ? globalThis.Number(object.min_sdk_version)
+        : 0,
+      targetSdkVersion: isSet(object.targetSdkVersion)
+        ? globalThis.Number(object.targetSdkVersion)
+        : isSet(object.target_sdk_version)
+        ? globalThis.Number(object.target_sdk_version)
+        : 0,
+      downloadToken: isSet(object.downloadToken)
+        ? globalThis.String(object.downloadToken)
+        : isSet(object.download_token)
+        ? globalThis.String(object.download_token)
+        : "",
+      downloadUrl: isSet(object.downloadUrl)
+        ? globalThis.String(object.downloadUrl)
+        : isSet(object.download_url)
+        ? globalThis.String(object.download_url)
+        : "",
       createdAt: isSet(object.createdAt)
         ? globalThis.String(object.createdAt)
         : isSet(object.created_at)
         ? globalThis.String(object.created_at)
         : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "",
-      deviceId: isSet(object.deviceId)
-        ? globalThis.Number(object.deviceId)
-        : isSet(object.device_id)
-        ? globalThis.Number(object.device_id)
-        : 0,
-      tournamentId: isSet(object.tournamentId)
-        ? globalThis.Number(object.tournamentId)
-        : isSet(object.tournament_id)
-        ? globalThis.Number(object.tournament_id)
-        : 0,
-      device: isSet(object.device) ? Device.fromJSON(object.device) : undefined,
-      tournament: isSet(object.tournament) ? Tournament.fromJSON(object.tournament) : undefined,
     };
   },
 
-  toJSON(message: DeviceTournament): unknown {
+  toJSON(message: ApkUploadResponse): unknown {
     const obj: any = {};
     if (message.id !== 0) {
       obj.id = Math.round(message.id);
     }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
-    }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
-    }
-    if (message.deviceId !== 0) {
-      obj.deviceId = Math.round(message.deviceId);
+    if (message.version !== "") {
+      obj.version = message.version;
     }
-    if (message.tournamentId !== 0) {
-      obj.tournamentId = Math.round(message.tournamentId);
+    if (message.fileName !== "") {
+      obj.fileName = message.fileName;
     }
-    if (message.device !== undefined) {
-      obj.device = Device.toJSON(message.device);
+    if (message.fileSize !== 0) {
+      obj.fileSize = Math.round(message.fileSize);
     }
-    if (message.tournament !== undefined) {
-      obj.tournament = Tournament.toJSON(message.tournament);
+    if (message.description !== "") {
+      obj.description = message.description;
     }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<DeviceTournament>, I>>(base?: I): DeviceTournament {
-    return DeviceTournament.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<DeviceTournament>, I>>(object: I): DeviceTournament {
-    const message = createBaseDeviceTournament();
-    message.id = object.id ?? 0;
-    message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
-    message.deviceId = object.deviceId ?? 0;
-    message.tournamentId = object.tournamentId ?? 0;
-    message.device = (object.device !== undefined && object.device !== null)
-      ? Device.fromPartial(object.device)
-      : undefined;
-    message.tournament = (object.tournament !== undefined && object.tournament !== null)
-      ? Tournament.fromPartial(object.tournament)
-      : undefined;
-    return message;
-  },
-};
-
-function createBaseDeviceTournamentList(): DeviceTournamentList {
-  return { deviceTournaments: [] };
-}
-
-export const DeviceTournamentList: MessageFns<DeviceTournamentList> = {
-  encode(message: DeviceTournamentList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.deviceTournaments) {
-      DeviceTournament.encode(v!, writer.uint32(10).fork()).join();
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
     }
-    return writer;
-  },
-
-  decode(input: BinaryReader | Uint8Array, length?: number): DeviceTournamentList {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeviceTournamentList();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
-            break;
-          }
-
-          message.deviceTournaments.push(DeviceTournament.decode(reader, reader.uint32()));
-          continue;
-        }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
+    if (message.versionCode !== 0) {
+      obj.versionCode = Math.round(message.versionCode);
     }
-    return message;
-  },
-
-  fromJSON(object: any): DeviceTournamentList {
-    return {
-      deviceTournaments: globalThis.Array.isArray(object?.deviceTournaments)
-        ? object.deviceTournaments.map((e: any) => DeviceTournament.fromJSON(e))
-        : globalThis.Array.isArray(object?.device_tournaments)
-        ? object.device_tournaments.map((e: any) => DeviceTournament.fromJSON(e))
-        : [],
-    };
-  },
-
-  toJSON(message: DeviceTournamentList): unknown {
-    const obj: any = {};
-    if (message.deviceTournaments?.length) {
-      obj.deviceTournaments = message.deviceTournaments.map((e) => DeviceTournament.toJSON(e));
+    if (message.minSdkVersion !== 0) {
+      obj.minSdkVersion = Math.round(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
+    }
+    if (message.downloadToken !== "") {
+      obj.downloadToken = message.downloadToken;
+    }
+    if (message.downloadUrl !== "") {
+      obj.downloadUrl = message.downloadUrl;
+    }
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DeviceTournamentList>, I>>(base?: I): DeviceTournamentList {
-    return DeviceTournamentList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ApkUploadResponse>, I>>(base?: I): ApkUploadResponse {
+    return ApkUploadResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DeviceTournamentList>, I>>(object: I): DeviceTournamentList {
-    const message = createBaseDeviceTournamentList();
-    message.deviceTournaments = object.deviceTournaments?.map((e) => DeviceTournament.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<ApkUploadResponse>, I>>(object: I): ApkUploadResponse {
+    const message = createBaseApkUploadResponse();
+    message.id = object.id ?? 0;
+    message.version = object.version ?? "";
+    message.fileName = object.fileName ?? "";
+    message.fileSize = object.fileSize ?? 0;
+    message.description = object.description ?? "";
+    message.packageName = object.packageName ?? "";
+    message.versionCode = object.versionCode ?? 0;
+    message.minSdkVersion = object.minSdkVersion ?? 0;
+    message.targetSdkVersion = object.targetSdkVersion ?? 0;
+    message.downloadToken = object.downloadToken ?? "";
+    message.downloadUrl = object.downloadUrl ?? "";
+    message.createdAt = object.createdAt ?? "";
     return message;
   },
 };
 
-function createBaseGlobalTournamentConfig(): GlobalTournamentConfig {
-  return { id: 0, createdAt: "", updatedAt: "", tournamentId: 0, tournament: undefined };
+function createBaseApkUpdateCheckResponse(): ApkUpdateCheckResponse {
+  return {
+    updateAvailable: false,
+    latestVersion: "",
+    packageName: "",
+    versionCode: 0,
+    downloadUrl: "",
+    description: "",
+    fileSize: 0,
+    minSdkVersion: 0,
+    targetSdkVersion: 0,
+  };
 }
 
-export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
-  encode(message: GlobalTournamentConfig, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
+export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
+  encode(message: ApkUpdateCheckResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.updateAvailable !== false) {
+      writer.uint32(8).bool(message.updateAvailable);
     }
-    if (message.createdAt !== "") {
-      writer.uint32(18).string(message.createdAt);
+    if (message.latestVersion !== "") {
+      writer.uint32(18).string(message.latestVersion);
     }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
+    if (message.packageName !== "") {
+      writer.uint32(26).string(message.packageName);
     }
-    if (message.tournamentId !== 0) {
-      writer.uint32(32).uint32(message.tournamentId);
+    if (message.versionCode !== 0) {
+      writer.uint32(32).int32(message.versionCode);
     }
-    if (message.tournament !== undefined) {
-      Tournament.encode(message.tournament, writer.uint32(42).fork()).join();
+    if (message.downloadUrl !== "") {
+      writer.uint32(42).string(message.downloadUrl);
+    }
+    if (message.description !== "") {
+      writer.uint32(50).string(message.description);
+    }
+    if (message.fileSize !== 0) {
+      writer.uint32(56).int64(message.fileSize);
+    }
+    if (message.minSdkVersion !== 0) {
+      writer.uint32(64).int32(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      writer.uint32(72).int32(message.targetSdkVersion);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GlobalTournamentConfig {
+  decode(input: BinaryReader | Uint8Array, length?: number): ApkUpdateCheckResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGlobalTournamentConfig();
+    const message = createBaseApkUpdateCheckResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1843,7 +12505,7 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
             break;
           }
 
-          message.id = reader.uint32();
+          message.updateAvailable = reader.bool();
           continue;
         }
         case 2: {
@@ -1851,7 +12513,7 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
             break;
           }
 
-          message.createdAt = reader.string();
+          message.latestVersion = reader.string();
           continue;
         }
         case 3: {
@@ -1859,7 +12521,7 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
             break;
           }
 
-          message.updatedAt = reader.string();
+          message.packageName = reader.string();
           continue;
         }
         case 4: {
@@ -1867,7 +12529,7 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
             break;
           }
 
-          message.tournamentId = reader.uint32();
+          message.versionCode = reader.int32();
           continue;
         }
         case 5: {
@@ -1875,7 +12537,39 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
             break;
           }
 
-          message.tournament = Tournament.decode(reader, reader.uint32());
+          message.downloadUrl = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.fileSize = longToNumber(reader.int64());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.minSdkVersion = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.targetSdkVersion = reader.int32();
           continue;
         }
       }
@@ -1887,89 +12581,138 @@ export const GlobalTournamentConfig: MessageFns<GlobalTournamentConfig> = {
     return message;
   },
 
-  fromJSON(object: any): GlobalTournamentConfig {
+  fromJSON(object: any): ApkUpdateCheckResponse {
     return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
+      updateAvailable: isSet(object.updateAvailable)
+        ? globalThis.Boolean(object.updateAvailable)
+        : isSet(object.update_available)
+        ? globalThis.Boolean(object.update_available)
+        : false,
+      latestVersion: isSet(object.latestVersion)
+        ? globalThis.String(object.latestVersion)
+        : isSet(object.latest_version)
+        ? globalThis.String(object.latest_version)
         : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
+      packageName: isSet(object.packageName)
+        ? globalThis.String(object.packageName)
+        : isSet(object.package_name)
+        ? globalThis.String(object.package_name)
         : "",
-      tournamentId: isSet(object.tournamentId)
-        ? globalThis.Number(object.tournamentId)
-        : isSet(object.tournament_id)
-        ? globalThis.Number(object.tournament_id)
+      versionCode: isSet(object.versionCode)
+        ? globalThis.Number(object.versionCode)
+        : isSet(object.version_code)
+        ? globalThis.Number(object.version_code)
+        : 0,
+      downloadUrl: isSet(object.downloadUrl)
+        ? globalThis.String(object.downloadUrl)
+        : isSet(object.download_url)
+        ? globalThis.String(object.download_url)
+        : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      fileSize: isSet(object.fileSize)
+        ? globalThis.Number(object.fileSize)
+        : isSet(object.file_size)
+        ? globalThis.Number(object.file_size)
+        : 0,
+      minSdkVersion: isSet(object.minSdkVersion)
+        ? globalThis.Number(object.minSdkVersion)
+        : isSet(object.min_sdk_version)
+        ? globalThis.Number(object.min_sdk_version)
+        : 0,
+      targetSdkVersion: isSet(object.targetSdkVersion)
+        ? globalThis.Number(object.targetSdkVersion)
+        : isSet(object.target_sdk_version)
+        ? globalThis.Number(object.target_sdk_version)
         : 0,
-      tournament: isSet(object.tournament) ? Tournament.fromJSON(object.tournament) : undefined,
     };
   },
 
-  toJSON(message: GlobalTournamentConfig): unknown {
+  toJSON(message: ApkUpdateCheckResponse): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
+    if (message.updateAvailable !== false) {
+      obj.updateAvailable = message.updateAvailable;
     }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
+    if (message.latestVersion !== "") {
+      obj.latestVersion = message.latestVersion;
     }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
+    if (message.packageName !== "") {
+      obj.packageName = message.packageName;
     }
-    if (message.tournamentId !== 0) {
-      obj.tournamentId = Math.round(message.tournamentId);
+    if (message.versionCode !== 0) {
+      obj.versionCode = Math.round(message.versionCode);
     }
-    if (message.tournament !== undefined) {
-      obj.tournament = Tournament.toJSON(message.tournament);
+    if (message.downloadUrl !== "") {
+      obj.downloadUrl = message.downloadUrl;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.fileSize !== 0) {
+      obj.fileSize = Math.round(message.fileSize);
+    }
+    if (message.minSdkVersion !== 0) {
+      obj.minSdkVersion = Math.round(message.minSdkVersion);
+    }
+    if (message.targetSdkVersion !== 0) {
+      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GlobalTournamentConfig>, I>>(base?: I): GlobalTournamentConfig {
-    return GlobalTournamentConfig.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ApkUpdateCheckResponse>, I>>(base?: I): ApkUpdateCheckResponse {
+    return ApkUpdateCheckResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GlobalTournamentConfig>, I>>(object: I): GlobalTournamentConfig {
-    const message = createBaseGlobalTournamentConfig();
-    message.id = object.id ?? 0;
-    message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
-    message.tournamentId = object.tournamentId ?? 0;
-    message.tournament = (object.tournament !== undefined && object.tournament !== null)
-      ? Tournament.fromPartial(object.tournament)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<ApkUpdateCheckResponse>, I>>(object: I): ApkUpdateCheckResponse {
+    const message = createBaseApkUpdateCheckResponse();
+    message.updateAvailable = object.updateAvailable ?? false;
+    message.latestVersion = object.latestVersion ?? "";
+    message.packageName = object.packageName ?? "";
+    message.versionCode = object.versionCode ?? 0;
+    message.downloadUrl = object.downloadUrl ?? "";
+    message.description = object.description ?? "";
+    message.fileSize = object.fileSize ?? 0;
+    message.minSdkVersion = object.minSdkVersion ?? 0;
+    message.targetSdkVersion = object.targetSdkVersion ?? 0;
     return message;
   },
 };
 
-function createBaseGlobalTournamentConfigList(): GlobalTournamentConfigList {
-  return { configs: [] };
+function createBaseApkVersion(): ApkVersion {
+  return { id: 0, url: "" };
 }
 
-export const GlobalTournamentConfigList: MessageFns<GlobalTournamentConfigList> = {
-  encode(message: GlobalTournamentConfigList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.configs) {
-      GlobalTournamentConfig.encode(v!, writer.uint32(10).fork()).join();
+export const ApkVersion: MessageFns<ApkVersion> = {
+  encode(message: ApkVersion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GlobalTournamentConfigList {
+  decode(input: BinaryReader | Uint8Array, length?: number): ApkVersion {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGlobalTournamentConfigList();
+    const message = createBaseApkVersion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.configs.push(GlobalTournamentConfig.decode(reader, reader.uint32()));
+          message.id = reader.uint32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
           continue;
         }
       }
@@ -1981,75 +12724,63 @@ export const GlobalTournamentConfigList: MessageFns<GlobalTournamentConfigList>
     return message;
   },
 
-  fromJSON(object: any): GlobalTournamentConfigList {
+  fromJSON(object: any): ApkVersion {
     return {
-      configs: globalThis.Array.isArray(object?.configs)
-        ? object.configs.map((e: any) => GlobalTournamentConfig.fromJSON(e))
-        : [],
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
     };
   },
 
-  toJSON(message: GlobalTournamentConfigList): unknown {
+  toJSON(message: ApkVersion): unknown {
     const obj: any = {};
-    if (message.configs?.length) {
-      obj.configs = message.configs.map((e) => GlobalTournamentConfig.toJSON(e));
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GlobalTournamentConfigList>, I>>(base?: I): GlobalTournamentConfigList {
-    return GlobalTournamentConfigList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ApkVersion>, I>>(base?: I): ApkVersion {
+    return ApkVersion.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GlobalTournamentConfigList>, I>>(object: I): GlobalTournamentConfigList {
-    const message = createBaseGlobalTournamentConfigList();
-    message.configs = object.configs?.map((e) => GlobalTournamentConfig.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<ApkVersion>, I>>(object: I): ApkVersion {
+    const message = createBaseApkVersion();
+    message.id = object.id ?? 0;
+    message.url = object.url ?? "";
     return message;
   },
 };
 
-function createBaseTeam(): Team {
-  return { id: 0, teamId: 0, logoUrl: "", primaryColor: "", secondaryColor: "", textColor: "", name: "" };
+function createBaseNotificationSubscriptionRequest(): NotificationSubscriptionRequest {
+  return { targetType: "", targetId: 0 };
 }
 
-export const Team: MessageFns<Team> = {
-  encode(message: Team, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
-    }
-    if (message.teamId !== 0) {
-      writer.uint32(16).int64(message.teamId);
-    }
-    if (message.logoUrl !== "") {
-      writer.uint32(26).string(message.logoUrl);
-    }
-    if (message.primaryColor !== "") {
-      writer.uint32(34).string(message.primaryColor);
-    }
-    if (message.secondaryColor !== "") {
-      writer.uint32(42).string(message.secondaryColor);
-    }
-    if (message.textColor !== "") {
-      writer.uint32(50).string(message.textColor);
+export const NotificationSubscriptionRequest: MessageFns<NotificationSubscriptionRequest> = {
+  encode(message: NotificationSubscriptionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.targetType !== "") {
+      writer.uint32(10).string(message.targetType);
     }
-    if (message.name !== "") {
-      writer.uint32(58).string(message.name);
+    if (message.targetId !== 0) {
+      writer.uint32(16).int64(message.targetId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Team {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationSubscriptionRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTeam();
+    const message = createBaseNotificationSubscriptionRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.uint32();
+          message.targetType = reader.string();
           continue;
         }
         case 2: {
@@ -2057,47 +12788,7 @@ export const Team: MessageFns<Team> = {
             break;
           }
 
-          message.teamId = longToNumber(reader.int64());
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.logoUrl = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          message.primaryColor = reader.string();
-          continue;
-        }
-        case 5: {
-          if (tag !== 42) {
-            break;
-          }
-
-          message.secondaryColor = reader.string();
-          continue;
-        }
-        case 6: {
-          if (tag !== 50) {
-            break;
-          }
-
-          message.textColor = reader.string();
-          continue;
-        }
-        case 7: {
-          if (tag !== 58) {
-            break;
-          }
-
-          message.name = reader.string();
+          message.targetId = longToNumber(reader.int64());
           continue;
         }
       }
@@ -2109,162 +12800,70 @@ export const Team: MessageFns<Team> = {
     return message;
   },
 
-  fromJSON(object: any): Team {
+  fromJSON(object: any): NotificationSubscriptionRequest {
     return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      teamId: isSet(object.teamId)
-        ? globalThis.Number(object.teamId)
-        : isSet(object.team_id)
-        ? globalThis.Number(object.team_id)
-        : 0,
-      logoUrl: isSet(object.logoUrl)
-        ? globalThis.String(object.logoUrl)
-        : isSet(object.logo_url)
-        ? globalThis.String(object.logo_url)
-        : "",
-      primaryColor: isSet(object.primaryColor)
-        ? globalThis.String(object.primaryColor)
-        : isSet(object.primary_color)
-        ? globalThis.String(object.primary_color)
-        : "",
-      secondaryColor: isSet(object.secondaryColor)
-        ? globalThis.String(object.secondaryColor)
-        : isSet(object.secondary_color)
-        ? globalThis.String(object.secondary_color)
-        : "",
-      textColor: isSet(object.textColor)
-        ? globalThis.String(object.textColor)
-        : isSet(object.text_color)
-        ? globalThis.String(object.text_color)
+      targetType: isSet(object.targetType)
+        ? globalThis.String(object.targetType)
+        : isSet(object.target_type)
+        ? globalThis.String(object.target_type)
         : "",
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      targetId: isSet(object.targetId)
+        ? globalThis.Number(object.targetId)
+        : isSet(object.target_id)
+        ? globalThis.Number(object.target_id)
+        : 0,
     };
   },
 
-  toJSON(message: Team): unknown {
+  toJSON(message: NotificationSubscriptionRequest): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
-    }
-    if (message.teamId !== 0) {
-      obj.teamId = Math.round(message.teamId);
-    }
-    if (message.logoUrl !== "") {
-      obj.logoUrl = message.logoUrl;
-    }
-    if (message.primaryColor !== "") {
-      obj.primaryColor = message.primaryColor;
-    }
-    if (message.secondaryColor !== "") {
-      obj.secondaryColor = message.secondaryColor;
-    }
-    if (message.textColor !== "") {
-      obj.textColor = message.textColor;
+    if (message.targetType !== "") {
+      obj.targetType = message.targetType;
     }
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.targetId !== 0) {
+      obj.targetId = Math.round(message.targetId);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Team>, I>>(base?: I): Team {
-    return Team.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationSubscriptionRequest>, I>>(base?: I): NotificationSubscriptionRequest {
+    return NotificationSubscriptionRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Team>, I>>(object: I): Team {
-    const message = createBaseTeam();
-    message.id = object.id ?? 0;
-    message.teamId = object.teamId ?? 0;
-    message.logoUrl = object.logoUrl ?? "";
-    message.primaryColor = object.primaryColor ?? "";
-    message.secondaryColor = object.secondaryColor ?? "";
-    message.textColor = object.textColor ?? "";
-    message.name = object.name ?? "";
+  fromPartial<I extends Exact<DeepPartial<NotificationSubscriptionRequest>, I>>(
+    object: I,
+  ): NotificationSubscriptionRequest {
+    const message = createBaseNotificationSubscriptionRequest();
+    message.targetType = object.targetType ?? "";
+    message.targetId = object.targetId ?? 0;
     return message;
   },
 };
 
-function createBaseSofaScoreEvent(): SofaScoreEvent {
-  return {
-    id: 0,
-    createdAt: "",
-    updatedAt: "",
-    sofaScoreEventId: 0,
-    sport: "",
-    homeScore: 0,
-    homeTeamId: 0,
-    awayScore: 0,
-    awayTeamId: 0,
-    scrapedAt: 0,
-    category: "",
-    startTimestamp: 0,
-    currentPeriodStartTimestamp: 0,
-    slug: "",
-    teamHome: undefined,
-    teamAway: undefined,
-    league: undefined,
-  };
-}
-
-export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
-  encode(message: SofaScoreEvent, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
-    }
-    if (message.createdAt !== "") {
-      writer.uint32(18).string(message.createdAt);
-    }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
-    }
-    if (message.sofaScoreEventId !== 0) {
-      writer.uint32(32).int64(message.sofaScoreEventId);
-    }
-    if (message.sport !== "") {
-      writer.uint32(42).string(message.sport);
-    }
-    if (message.homeScore !== 0) {
-      writer.uint32(48).int32(message.homeScore);
-    }
-    if (message.homeTeamId !== 0) {
-      writer.uint32(56).int64(message.homeTeamId);
-    }
-    if (message.awayScore !== 0) {
-      writer.uint32(64).int32(message.awayScore);
-    }
-    if (message.awayTeamId !== 0) {
-      writer.uint32(72).int64(message.awayTeamId);
-    }
-    if (message.scrapedAt !== 0) {
-      writer.uint32(80).int64(message.scrapedAt);
-    }
-    if (message.category !== "") {
-      writer.uint32(90).string(message.category);
-    }
-    if (message.startTimestamp !== 0) {
-      writer.uint32(96).int64(message.startTimestamp);
-    }
-    if (message.currentPeriodStartTimestamp !== 0) {
-      writer.uint32(104).int64(message.currentPeriodStartTimestamp);
-    }
-    if (message.slug !== "") {
-      writer.uint32(114).string(message.slug);
+function createBaseNotificationSubscription(): NotificationSubscription {
+  return { id: 0, createdAt: "", targetType: "", targetId: 0 };
+}
+
+export const NotificationSubscription: MessageFns<NotificationSubscription> = {
+  encode(message: NotificationSubscription, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
     }
-    if (message.teamHome !== undefined) {
-      Team.encode(message.teamHome, writer.uint32(122).fork()).join();
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
     }
-    if (message.teamAway !== undefined) {
-      Team.encode(message.teamAway, writer.uint32(130).fork()).join();
+    if (message.targetType !== "") {
+      writer.uint32(26).string(message.targetType);
     }
-    if (message.league !== undefined) {
-      Tournament.encode(message.league, writer.uint32(138).fork()).join();
+    if (message.targetId !== 0) {
+      writer.uint32(32).int64(message.targetId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): SofaScoreEvent {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationSubscription {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSofaScoreEvent();
+    const message = createBaseNotificationSubscription();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2289,7 +12888,7 @@ export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
             break;
           }
 
-          message.updatedAt = reader.string();
+          message.targetType = reader.string();
           continue;
         }
         case 4: {
@@ -2297,111 +12896,7 @@ export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
             break;
           }
 
-          message.sofaScoreEventId = longToNumber(reader.int64());
-          continue;
-        }
-        case 5: {
-          if (tag !== 42) {
-            break;
-          }
-
-          message.sport = reader.string();
-          continue;
-        }
-        case 6: {
-          if (tag !== 48) {
-            break;
-          }
-
-          message.homeScore = reader.int32();
-          continue;
-        }
-        case 7: {
-          if (tag !== 56) {
-            break;
-          }
-
-          message.homeTeamId = longToNumber(reader.int64());
-          continue;
-        }
-        case 8: {
-          if (tag !== 64) {
-            break;
-          }
-
-          message.awayScore = reader.int32();
-          continue;
-        }
-        case 9: {
-          if (tag !== 72) {
-            break;
-          }
-
-          message.awayTeamId = longToNumber(reader.int64());
-          continue;
-        }
-        case 10: {
-          if (tag !== 80) {
-            break;
-          }
-
-          message.scrapedAt = longToNumber(reader.int64());
-          continue;
-        }
-        case 11: {
-          if (tag !== 90) {
-            break;
-          }
-
-          message.category = reader.string();
-          continue;
-        }
-        case 12: {
-          if (tag !== 96) {
-            break;
-          }
-
-          message.startTimestamp = longToNumber(reader.int64());
-          continue;
-        }
-        case 13: {
-          if (tag !== 104) {
-            break;
-          }
-
-          message.currentPeriodStartTimestamp = longToNumber(reader.int64());
-          continue;
-        }
-        case 14: {
-          if (tag !== 114) {
-            break;
-          }
-
-          message.slug = reader.string();
-          continue;
-        }
-        case 15: {
-          if (tag !== 122) {
-            break;
-          }
-
-          message.teamHome = Team.decode(reader, reader.uint32());
-          continue;
-        }
-        case 16: {
-          if (tag !== 130) {
-            break;
-          }
-
-          message.teamAway = Team.decode(reader, reader.uint32());
-          continue;
-        }
-        case 17: {
-          if (tag !== 138) {
-            break;
-          }
-
-          message.league = Tournament.decode(reader, reader.uint32());
+          message.targetId = longToNumber(reader.int64());
           continue;
         }
       }
@@ -2413,7 +12908,7 @@ export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
     return message;
   },
 
-  fromJSON(object: any): SofaScoreEvent {
+  fromJSON(object: any): NotificationSubscription {
     return {
       id: isSet(object.id) ? globalThis.Number(object.id) : 0,
       createdAt: isSet(object.createdAt)
@@ -2421,69 +12916,20 @@ export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
         : isSet(object.created_at)
         ? globalThis.String(object.created_at)
         : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
+      targetType: isSet(object.targetType)
+        ? globalThis.String(object.targetType)
+        : isSet(object.target_type)
+        ? globalThis.String(object.target_type)
         : "",
-      sofaScoreEventId: isSet(object.sofaScoreEventId)
-        ? globalThis.Number(object.sofaScoreEventId)
-        : isSet(object.sofa_score_event_id)
-        ? globalThis.Number(object.sofa_score_event_id)
-        : 0,
-      sport: isSet(object.sport) ? globalThis.String(object.sport) : "",
-      homeScore: isSet(object.homeScore)
-        ? globalThis.Number(object.homeScore)
-        : isSet(object.home_score)
-        ? globalThis.Number(object.home_score)
-        : 0,
-      homeTeamId: isSet(object.homeTeamId)
-        ? globalThis.Number(object.homeTeamId)
-        : isSet(object.home_team_id)
-        ? globalThis.Number(object.home_team_id)
-        : 0,
-      awayScore: isSet(object.awayScore)
-        ? globalThis.Number(object.awayScore)
-        : isSet(object.away_score)
-        ? globalThis.Number(object.away_score)
-        : 0,
-      awayTeamId: isSet(object.awayTeamId)
-        ? globalThis.Number(object.awayTeamId)
-        : isSet(object.away_team_id)
-        ? globalThis.Number(object.away_team_id)
-        : 0,
-      scrapedAt: isSet(object.scrapedAt)
-        ? globalThis.Number(object.scrapedAt)
-        : isSet(object.scraped_at)
-        ? globalThis.Number(object.scraped_at)
-        : 0,
-      category: isSet(object.category) ? globalThis.String(object.category) : "",
-      startTimestamp: isSet(object.startTimestamp)
-        ? globalThis.Number(object.startTimestamp)
-        : isSet(object.start_timestamp)
-        ? globalThis.Number(object.start_timestamp)
-        : 0,
-      currentPeriodStartTimestamp: isSet(object.currentPeriodStartTimestamp)
-        ? globalThis.Number(object.currentPeriodStartTimestamp)
-        : isSet(object.current_period_start_timestamp)
-        ? globalThis.Number(object.current_period_start_timestamp)
+      targetId: isSet(object.targetId)
+        ? globalThis.Number(object.targetId)
+        : isSet(object.target_id)
+        ? globalThis.Number(object.target_id)
         : 0,
-      slug: isSet(object.slug) ? globalThis.String(object.slug) : "",
-      teamHome: isSet(object.teamHome)
-        ? Team.fromJSON(object.teamHome)
-        : isSet(object.team_home)
-        ? Team.fromJSON(object.team_home)
-        : undefined,
-      teamAway: isSet(object.teamAway)
-        ? Team.fromJSON(object.teamAway)
-        : isSet(object.team_away)
-        ? Team.fromJSON(object.team_away)
-        : undefined,
-      league: isSet(object.league) ? Tournament.fromJSON(object.league) : undefined,
     };
   },
 
-  toJSON(message: SofaScoreEvent): unknown {
+  toJSON(message: NotificationSubscription): unknown {
     const obj: any = {};
     if (message.id !== 0) {
       obj.id = Math.round(message.id);
@@ -2491,114 +12937,109 @@ export const SofaScoreEvent: MessageFns<SofaScoreEvent> = {
     if (message.createdAt !== "") {
       obj.createdAt = message.createdAt;
     }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
-    }
-    if (message.sofaScoreEventId !== 0) {
-      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
-    }
-    if (message.sport !== "") {
-      obj.sport = message.sport;
-    }
-    if (message.homeScore !== 0) {
-      obj.homeScore = Math.round(message.homeScore);
-    }
-    if (message.homeTeamId !== 0) {
-      obj.homeTeamId = Math.round(message.homeTeamId);
-    }
-    if (message.awayScore !== 0) {
-      obj.awayScore = Math.round(message.awayScore);
-    }
-    if (message.awayTeamId !== 0) {
-      obj.awayTeamId = Math.round(message.awayTeamId);
-    }
-    if (message.scrapedAt !== 0) {
-      obj.scrapedAt = Math.round(message.scrapedAt);
-    }
-    if (message.category !== "") {
-      obj.category = message.category;
-    }
-    if (message.startTimestamp !== 0) {
-      obj.startTimestamp = Math.round(message.startTimestamp);
-    }
-    if (message.currentPeriodStartTimestamp !== 0) {
-      obj.currentPeriodStartTimestamp = Math.round(message.currentPeriodStartTimestamp);
+    if (message.targetType !== "") {
+      obj.targetType = message.targetType;
     }
-    if (message.slug !== "") {
-      obj.slug = message.slug;
+    if (message.targetId !== 0) {
+      obj.targetId = Math.round(message.targetId);
     }
-    if (message.teamHome !== undefined) {
-      obj.teamHome = Team.toJSON(message.teamHome);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NotificationSubscription>, I>>(base?: I): NotificationSubscription {
+    return NotificationSubscription.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<NotificationSubscription>, I>>(object: I): NotificationSubscription {
+    const message = createBaseNotificationSubscription();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.targetType = object.targetType ?? "";
+    message.targetId = object.targetId ?? 0;
+    return message;
+  },
+};
+
+function createBaseNotificationSubscriptionList(): NotificationSubscriptionList {
+  return { subscriptions: [] };
+}
+
+export const NotificationSubscriptionList: MessageFns<NotificationSubscriptionList> = {
+  encode(message: NotificationSubscriptionList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.subscriptions) {
+      NotificationSubscription.encode(v!, writer.uint32(10).fork()).join();
     }
-    if (message.teamAway !== undefined) {
-      obj.teamAway = Team.toJSON(message.teamAway);
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationSubscriptionList {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotificationSubscriptionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.subscriptions.push(NotificationSubscription.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
     }
-    if (message.league !== undefined) {
-      obj.league = Tournament.toJSON(message.league);
+    return message;
+  },
+
+  fromJSON(object: any): NotificationSubscriptionList {
+    return {
+      subscriptions: globalThis.Array.isArray(object?.subscriptions)
+        ? object.subscriptions.map((e: any) => NotificationSubscription.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: NotificationSubscriptionList): unknown {
+    const obj: any = {};
+    if (message.subscriptions?.length) {
+      obj.subscriptions = message.subscriptions.map((e) => NotificationSubscription.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<SofaScoreEvent>, I>>(base?: I): SofaScoreEvent {
-    return SofaScoreEvent.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationSubscriptionList>, I>>(base?: I): NotificationSubscriptionList {
+    return NotificationSubscriptionList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<SofaScoreEvent>, I>>(object: I): SofaScoreEvent {
-    const message = createBaseSofaScoreEvent();
-    message.id = object.id ?? 0;
-    message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
-    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
-    message.sport = object.sport ?? "";
-    message.homeScore = object.homeScore ?? 0;
-    message.homeTeamId = object.homeTeamId ?? 0;
-    message.awayScore = object.awayScore ?? 0;
-    message.awayTeamId = object.awayTeamId ?? 0;
-    message.scrapedAt = object.scrapedAt ?? 0;
-    message.category = object.category ?? "";
-    message.startTimestamp = object.startTimestamp ?? 0;
-    message.currentPeriodStartTimestamp = object.currentPeriodStartTimestamp ?? 0;
-    message.slug = object.slug ?? "";
-    message.teamHome = (object.teamHome !== undefined && object.teamHome !== null)
-      ? Team.fromPartial(object.teamHome)
-      : undefined;
-    message.teamAway = (object.teamAway !== undefined && object.teamAway !== null)
-      ? Team.fromPartial(object.teamAway)
-      : undefined;
-    message.league = (object.league !== undefined && object.league !== null)
-      ? Tournament.fromPartial(object.league)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<NotificationSubscriptionList>, I>>(object: I): NotificationSubscriptionList {
+    const message = createBaseNotificationSubscriptionList();
+    message.subscriptions = object.subscriptions?.map((e) => NotificationSubscription.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseEventsList(): EventsList {
-  return { data: [], page: 0, limit: 0, total: 0, totalPages: 0 };
+function createBaseNotificationTemplateRequest(): NotificationTemplateRequest {
+  return { title: "", body: "" };
 }
 
-export const EventsList: MessageFns<EventsList> = {
-  encode(message: EventsList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.data) {
-      SofaScoreEvent.encode(v!, writer.uint32(10).fork()).join();
-    }
-    if (message.page !== 0) {
-      writer.uint32(16).int32(message.page);
+export const NotificationTemplateRequest: MessageFns<NotificationTemplateRequest> = {
+  encode(message: NotificationTemplateRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
     }
-    if (message.limit !== 0) {
-      writer.uint32(24).int32(message.limit);
-    }
-    if (message.total !== 0) {
-      writer.uint32(32).int64(message.total);
-    }
-    if (message.totalPages !== 0) {
-      writer.uint32(40).int32(message.totalPages);
+    if (message.body !== "") {
+      writer.uint32(18).string(message.body);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): EventsList {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationTemplateRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseEventsList();
+    const message = createBaseNotificationTemplateRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2607,39 +13048,15 @@ export const EventsList: MessageFns<EventsList> = {
             break;
           }
 
-          message.data.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          message.title = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 16) {
-            break;
-          }
-
-          message.page = reader.int32();
-          continue;
-        }
-        case 3: {
-          if (tag !== 24) {
-            break;
-          }
-
-          message.limit = reader.int32();
-          continue;
-        }
-        case 4: {
-          if (tag !== 32) {
-            break;
-          }
-
-          message.total = longToNumber(reader.int64());
-          continue;
-        }
-        case 5: {
-          if (tag !== 40) {
+          if (tag !== 18) {
             break;
           }
 
-          message.totalPages = reader.int32();
+          message.body = reader.string();
           continue;
         }
       }
@@ -2651,76 +13068,60 @@ export const EventsList: MessageFns<EventsList> = {
     return message;
   },
 
-  fromJSON(object: any): EventsList {
+  fromJSON(object: any): NotificationTemplateRequest {
     return {
-      data: globalThis.Array.isArray(object?.data) ? object.data.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
-      page: isSet(object.page) ? globalThis.Number(object.page) : 0,
-      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
-      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
-      totalPages: isSet(object.totalPages)
-        ? globalThis.Number(object.totalPages)
-        : isSet(object.total_pages)
-        ? globalThis.Number(object.total_pages)
-        : 0,
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      body: isSet(object.body) ? globalThis.String(object.body) : "",
     };
   },
 
-  toJSON(message: EventsList): unknown {
+  toJSON(message: NotificationTemplateRequest): unknown {
     const obj: any = {};
-    if (message.data?.length) {
-      obj.data = message.data.map((e) => SofaScoreEvent.toJSON(e));
-    }
-    if (message.page !== 0) {
-      obj.page = Math.round(message.page);
-    }
-    if (message.limit !== 0) {
-      obj.limit = Math.round(message.limit);
-    }
-    if (message.total !== 0) {
-      obj.total = Math.round(message.total);
+    if (message.title !== "") {
+      obj.title = message.title;
     }
-    if (message.totalPages !== 0) {
-      obj.totalPages = Math.round(message.totalPages);
+    if (message.body !== "") {
+      obj.body = message.body;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<EventsList>, I>>(base?: I): EventsList {
-    return EventsList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationTemplateRequest>, I>>(base?: I): NotificationTemplateRequest {
+    return NotificationTemplateRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<EventsList>, I>>(object: I): EventsList {
-    const message = createBaseEventsList();
-    message.data = object.data?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
-    message.page = object.page ?? 0;
-    message.limit = object.limit ?? 0;
-    message.total = object.total ?? 0;
-    message.totalPages = object.totalPages ?? 0;
+  fromPartial<I extends Exact<DeepPartial<NotificationTemplateRequest>, I>>(object: I): NotificationTemplateRequest {
+    const message = createBaseNotificationTemplateRequest();
+    message.title = object.title ?? "";
+    message.body = object.body ?? "";
     return message;
   },
 };
 
-function createBaseLogPlaybackRequest(): LogPlaybackRequest {
-  return { deviceToken: "", content: "", startedAt: 0 };
+function createBaseNotificationTemplate(): NotificationTemplate {
+  return { kind: "", title: "", body: "", updatedAt: "" };
 }
 
-export const LogPlaybackRequest: MessageFns<LogPlaybackRequest> = {
-  encode(message: LogPlaybackRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.deviceToken !== "") {
-      writer.uint32(10).string(message.deviceToken);
+export const NotificationTemplate: MessageFns<NotificationTemplate> = {
+  encode(message: NotificationTemplate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.kind !== "") {
+      writer.uint32(10).string(message.kind);
     }
-    if (message.content !== "") {
-      writer.uint32(18).string(message.content);
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
     }
-    if (message.startedAt !== 0) {
-      writer.uint32(24).int64(message.startedAt);
+    if (message.body !== "") {
+      writer.uint32(26).string(message.body);
+    }
+    if (message.updatedAt !== "") {
+      writer.uint32(34).string(message.updatedAt);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): LogPlaybackRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationTemplate {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseLogPlaybackRequest();
+    const message = createBaseNotificationTemplate();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2729,7 +13130,7 @@ export const LogPlaybackRequest: MessageFns<LogPlaybackRequest> = {
             break;
           }
 
-          message.deviceToken = reader.string();
+          message.kind = reader.string();
           continue;
         }
         case 2: {
@@ -2737,15 +13138,23 @@ export const LogPlaybackRequest: MessageFns<LogPlaybackRequest> = {
             break;
           }
 
-          message.content = reader.string();
+          message.title = reader.string();
           continue;
         }
         case 3: {
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.startedAt = longToNumber(reader.int64());
+          message.body = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.updatedAt = reader.string();
           continue;
         }
       }
@@ -2757,73 +13166,74 @@ export const LogPlaybackRequest: MessageFns<LogPlaybackRequest> = {
     return message;
   },
 
-  fromJSON(object: any): LogPlaybackRequest {
+  fromJSON(object: any): NotificationTemplate {
     return {
-      deviceToken: isSet(object.deviceToken)
-        ? globalThis.String(object.deviceToken)
-        : isSet(object.device_token)
-        ? globalThis.String(object.device_token)
+      kind: isSet(object.kind) ? globalThis.String(object.kind) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      body: isSet(object.body) ? globalThis.String(object.body) : "",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
         : "",
-      content: isSet(object.content) ? globalThis.String(object.content) : "",
-      startedAt: isSet(object.startedAt)
-        ? globalThis.Number(object.startedAt)
-        : isSet(object.started_at)
-        ? globalThis.Number(object.started_at)
-        : 0,
     };
   },
 
-  toJSON(message: LogPlaybackRequest): unknown {
+  toJSON(message: NotificationTemplate): unknown {
     const obj: any = {};
-    if (message.deviceToken !== "") {
-      obj.deviceToken = message.deviceToken;
+    if (message.kind !== "") {
+      obj.kind = message.kind;
     }
-    if (message.content !== "") {
-      obj.content = message.content;
+    if (message.title !== "") {
+      obj.title = message.title;
     }
-    if (message.startedAt !== 0) {
-      obj.startedAt = Math.round(message.startedAt);
+    if (message.body !== "") {
+      obj.body = message.body;
+    }
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<LogPlaybackRequest>, I>>(base?: I): LogPlaybackRequest {
-    return LogPlaybackRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationTemplate>, I>>(base?: I): NotificationTemplate {
+    return NotificationTemplate.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<LogPlaybackRequest>, I>>(object: I): LogPlaybackRequest {
-    const message = createBaseLogPlaybackRequest();
-    message.deviceToken = object.deviceToken ?? "";
-    message.content = object.content ?? "";
-    message.startedAt = object.startedAt ?? 0;
+  fromPartial<I extends Exact<DeepPartial<NotificationTemplate>, I>>(object: I): NotificationTemplate {
+    const message = createBaseNotificationTemplate();
+    message.kind = object.kind ?? "";
+    message.title = object.title ?? "";
+    message.body = object.body ?? "";
+    message.updatedAt = object.updatedAt ?? "";
     return message;
   },
 };
 
-function createBaseUpdatePlaybackRequest(): UpdatePlaybackRequest {
-  return { endedAt: 0 };
+function createBaseNotificationTemplateList(): NotificationTemplateList {
+  return { templates: [] };
 }
 
-export const UpdatePlaybackRequest: MessageFns<UpdatePlaybackRequest> = {
-  encode(message: UpdatePlaybackRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.endedAt !== 0) {
-      writer.uint32(8).int64(message.endedAt);
+export const NotificationTemplateList: MessageFns<NotificationTemplateList> = {
+  encode(message: NotificationTemplateList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.templates) {
+      NotificationTemplate.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): UpdatePlaybackRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationTemplateList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdatePlaybackRequest();
+    const message = createBaseNotificationTemplateList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.endedAt = longToNumber(reader.int64());
+          message.templates.push(NotificationTemplate.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -2835,68 +13245,90 @@ export const UpdatePlaybackRequest: MessageFns<UpdatePlaybackRequest> = {
     return message;
   },
 
-  fromJSON(object: any): UpdatePlaybackRequest {
+  fromJSON(object: any): NotificationTemplateList {
     return {
-      endedAt: isSet(object.endedAt)
-        ? globalThis.Number(object.endedAt)
-        : isSet(object.ended_at)
-        ? globalThis.Number(object.ended_at)
-        : 0,
+      templates: globalThis.Array.isArray(object?.templates)
+        ? object.templates.map((e: any) => NotificationTemplate.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: UpdatePlaybackRequest): unknown {
+  toJSON(message: NotificationTemplateList): unknown {
     const obj: any = {};
-    if (message.endedAt !== 0) {
-      obj.endedAt = Math.round(message.endedAt);
+    if (message.templates?.length) {
+      obj.templates = message.templates.map((e) => NotificationTemplate.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<UpdatePlaybackRequest>, I>>(base?: I): UpdatePlaybackRequest {
-    return UpdatePlaybackRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationTemplateList>, I>>(base?: I): NotificationTemplateList {
+    return NotificationTemplateList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<UpdatePlaybackRequest>, I>>(object: I): UpdatePlaybackRequest {
-    const message = createBaseUpdatePlaybackRequest();
-    message.endedAt = object.endedAt ?? 0;
+  fromPartial<I extends Exact<DeepPartial<NotificationTemplateList>, I>>(object: I): NotificationTemplateList {
+    const message = createBaseNotificationTemplateList();
+    message.templates = object.templates?.map((e) => NotificationTemplate.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBasePlaybackLog(): PlaybackLog {
-  return { id: 0, createdAt: "", updatedAt: "", deviceId: 0, content: "", startedAt: 0, endedAt: 0 };
+function createBaseNotificationLogEntry(): NotificationLogEntry {
+  return {
+    id: 0,
+    createdAt: "",
+    deviceId: 0,
+    deviceName: "",
+    sofaScoreEventId: 0,
+    kind: "",
+    title: "",
+    body: "",
+    provider: "",
+    status: "",
+    error: "",
+  };
 }
 
-export const PlaybackLog: MessageFns<PlaybackLog> = {
-  encode(message: PlaybackLog, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const NotificationLogEntry: MessageFns<NotificationLogEntry> = {
+  encode(message: NotificationLogEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.id !== 0) {
       writer.uint32(8).uint32(message.id);
     }
     if (message.createdAt !== "") {
       writer.uint32(18).string(message.createdAt);
     }
-    if (message.updatedAt !== "") {
-      writer.uint32(26).string(message.updatedAt);
-    }
     if (message.deviceId !== 0) {
-      writer.uint32(32).uint32(message.deviceId);
+      writer.uint32(24).uint32(message.deviceId);
     }
-    if (message.content !== "") {
-      writer.uint32(42).string(message.content);
+    if (message.deviceName !== "") {
+      writer.uint32(34).string(message.deviceName);
     }
-    if (message.startedAt !== 0) {
-      writer.uint32(48).int64(message.startedAt);
+    if (message.sofaScoreEventId !== 0) {
+      writer.uint32(40).int64(message.sofaScoreEventId);
     }
-    if (message.endedAt !== 0) {
-      writer.uint32(56).int64(message.endedAt);
+    if (message.kind !== "") {
+      writer.uint32(50).string(message.kind);
+    }
+    if (message.title !== "") {
+      writer.uint32(58).string(message.title);
+    }
+    if (message.body !== "") {
+      writer.uint32(66).string(message.body);
+    }
+    if (message.provider !== "") {
+      writer.uint32(74).string(message.provider);
+    }
+    if (message.status !== "") {
+      writer.uint32(82).string(message.status);
+    }
+    if (message.error !== "") {
+      writer.uint32(90).string(message.error);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): PlaybackLog {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationLogEntry {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlaybackLog();
+    const message = createBaseNotificationLogEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2917,43 +13349,75 @@ export const PlaybackLog: MessageFns<PlaybackLog> = {
           continue;
         }
         case 3: {
-          if (tag !== 26) {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.deviceId = reader.uint32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.deviceName = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.sofaScoreEventId = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.kind = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
             break;
           }
 
-          message.updatedAt = reader.string();
+          message.title = reader.string();
           continue;
         }
-        case 4: {
-          if (tag !== 32) {
+        case 8: {
+          if (tag !== 66) {
             break;
           }
 
-          message.deviceId = reader.uint32();
+          message.body = reader.string();
           continue;
         }
-        case 5: {
-          if (tag !== 42) {
+        case 9: {
+          if (tag !== 74) {
             break;
           }
 
-          message.content = reader.string();
+          message.provider = reader.string();
           continue;
         }
-        case 6: {
-          if (tag !== 48) {
+        case 10: {
+          if (tag !== 82) {
             break;
           }
 
-          message.startedAt = longToNumber(reader.int64());
+          message.status = reader.string();
           continue;
         }
-        case 7: {
-          if (tag !== 56) {
+        case 11: {
+          if (tag !== 90) {
             break;
           }
 
-          message.endedAt = longToNumber(reader.int64());
+          message.error = reader.string();
           continue;
         }
       }
@@ -2965,7 +13429,7 @@ export const PlaybackLog: MessageFns<PlaybackLog> = {
     return message;
   },
 
-  fromJSON(object: any): PlaybackLog {
+  fromJSON(object: any): NotificationLogEntry {
     return {
       id: isSet(object.id) ? globalThis.Number(object.id) : 0,
       createdAt: isSet(object.createdAt)
@@ -2973,31 +13437,31 @@ export const PlaybackLog: MessageFns<PlaybackLog> = {
         : isSet(object.created_at)
         ? globalThis.String(object.created_at)
         : "",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "",
       deviceId: isSet(object.deviceId)
         ? globalThis.Number(object.deviceId)
         : isSet(object.device_id)
         ? globalThis.Number(object.device_id)
         : 0,
-      content: isSet(object.content) ? globalThis.String(object.content) : "",
-      startedAt: isSet(object.startedAt)
-        ? globalThis.Number(object.startedAt)
-        : isSet(object.started_at)
-        ? globalThis.Number(object.started_at)
-        : 0,
-      endedAt: isSet(object.endedAt)
-        ? globalThis.Number(object.endedAt)
-        : isSet(object.ended_at)
-        ? globalThis.Number(object.ended_at)
+      deviceName: isSet(object.deviceName)
+        ? globalThis.String(object.deviceName)
+        : isSet(object.device_name)
+        ? globalThis.String(object.device_name)
+        : "",
+      sofaScoreEventId: isSet(object.sofaScoreEventId)
+        ? globalThis.Number(object.sofaScoreEventId)
+        : isSet(object.sofa_score_event_id)
+        ? globalThis.Number(object.sofa_score_event_id)
         : 0,
+      kind: isSet(object.kind) ? globalThis.String(object.kind) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      body: isSet(object.body) ? globalThis.String(object.body) : "",
+      provider: isSet(object.provider) ? globalThis.String(object.provider) : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
     };
   },
 
-  toJSON(message: PlaybackLog): unknown {
+  toJSON(message: NotificationLogEntry): unknown {
     const obj: any = {};
     if (message.id !== 0) {
       obj.id = Math.round(message.id);
@@ -3005,59 +13469,75 @@ export const PlaybackLog: MessageFns<PlaybackLog> = {
     if (message.createdAt !== "") {
       obj.createdAt = message.createdAt;
     }
-    if (message.updatedAt !== "") {
-      obj.updatedAt = message.updatedAt;
-    }
     if (message.deviceId !== 0) {
       obj.deviceId = Math.round(message.deviceId);
     }
-    if (message.content !== "") {
-      obj.content = message.content;
+    if (message.deviceName !== "") {
+      obj.deviceName = message.deviceName;
     }
-    if (message.startedAt !== 0) {
-      obj.startedAt = Math.round(message.startedAt);
+    if (message.sofaScoreEventId !== 0) {
+      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
     }
-    if (message.endedAt !== 0) {
-      obj.endedAt = Math.round(message.endedAt);
+    if (message.kind !== "") {
+      obj.kind = message.kind;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.body !== "") {
+      obj.body = message.body;
+    }
+    if (message.provider !== "") {
+      obj.provider = message.provider;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<PlaybackLog>, I>>(base?: I): PlaybackLog {
-    return PlaybackLog.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationLogEntry>, I>>(base?: I): NotificationLogEntry {
+    return NotificationLogEntry.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<PlaybackLog>, I>>(object: I): PlaybackLog {
-    const message = createBasePlaybackLog();
+  fromPartial<I extends Exact<DeepPartial<NotificationLogEntry>, I>>(object: I): NotificationLogEntry {
+    const message = createBaseNotificationLogEntry();
     message.id = object.id ?? 0;
     message.createdAt = object.createdAt ?? "";
-    message.updatedAt = object.updatedAt ?? "";
     message.deviceId = object.deviceId ?? 0;
-    message.content = object.content ?? "";
-    message.startedAt = object.startedAt ?? 0;
-    message.endedAt = object.endedAt ?? 0;
+    message.deviceName = object.deviceName ?? "";
+    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
+    message.kind = object.kind ?? "";
+    message.title = object.title ?? "";
+    message.body = object.body ?? "";
+    message.provider = object.provider ?? "";
+    message.status = object.status ?? "";
+    message.error = object.error ?? "";
     return message;
   },
 };
 
-function createBasePlaybackLogList(): PlaybackLogList {
-  return { list: [], total: 0 };
+function createBaseNotificationLogList(): NotificationLogList {
+  return { entries: [], total: 0 };
 }
 
-export const PlaybackLogList: MessageFns<PlaybackLogList> = {
-  encode(message: PlaybackLogList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.list) {
-      PlaybackLog.encode(v!, writer.uint32(10).fork()).join();
+export const NotificationLogList: MessageFns<NotificationLogList> = {
+  encode(message: NotificationLogList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.entries) {
+      NotificationLogEntry.encode(v!, writer.uint32(10).fork()).join();
     }
     if (message.total !== 0) {
-      writer.uint32(16).uint32(message.total);
+      writer.uint32(16).int64(message.total);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): PlaybackLogList {
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationLogList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlaybackLogList();
+    const message = createBaseNotificationLogList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3066,7 +13546,7 @@ export const PlaybackLogList: MessageFns<PlaybackLogList> = {
             break;
           }
 
-          message.list.push(PlaybackLog.decode(reader, reader.uint32()));
+          message.entries.push(NotificationLogEntry.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -3074,7 +13554,7 @@ export const PlaybackLogList: MessageFns<PlaybackLogList> = {
             break;
           }
 
-          message.total = reader.uint32();
+          message.total = longToNumber(reader.int64());
           continue;
         }
       }
@@ -3086,17 +13566,19 @@ export const PlaybackLogList: MessageFns<PlaybackLogList> = {
     return message;
   },
 
-  fromJSON(object: any): PlaybackLogList {
+  fromJSON(object: any): NotificationLogList {
     return {
-      list: globalThis.Array.isArray(object?.list) ? object.list.map((e: any) => PlaybackLog.fromJSON(e)) : [],
+      entries: globalThis.Array.isArray(object?.entries)
+        ? object.entries.map((e: any) => NotificationLogEntry.fromJSON(e))
+        : [],
       total: isSet(object.total) ? globalThis.Number(object.total) : 0,
     };
   },
 
-  toJSON(message: PlaybackLogList): unknown {
+  toJSON(message: NotificationLogList): unknown {
     const obj: any = {};
-    if (message.list?.length) {
-      obj.list = message.list.map((e) => PlaybackLog.toJSON(e));
+    if (message.entries?.length) {
+      obj.entries = message.entries.map((e) => NotificationLogEntry.toJSON(e));
     }
     if (message.total !== 0) {
       obj.total = Math.round(message.total);
@@ -3104,45 +13586,64 @@ export const PlaybackLogList: MessageFns<PlaybackLogList> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<PlaybackLogList>, I>>(base?: I): PlaybackLogList {
-    return PlaybackLogList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<NotificationLogList>, I>>(base?: I): NotificationLogList {
+    return NotificationLogList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<PlaybackLogList>, I>>(object: I): PlaybackLogList {
-    const message = createBasePlaybackLogList();
-    message.list = object.list?.map((e) => PlaybackLog.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<NotificationLogList>, I>>(object: I): NotificationLogList {
+    const message = createBaseNotificationLogList();
+    message.entries = object.entries?.map((e) => NotificationLogEntry.fromPartial(e)) || [];
     message.total = object.total ?? 0;
     return message;
   },
 };
 
-function createBaseEventStats(): EventStats {
-  return { sofaScoreEventId: 0, viewCount: 0 };
+function createBaseSyncResponse(): SyncResponse {
+  return { cursor: "", hasMore: false, events: [], teams: [], tournaments: [], deletedEventIds: [], tournamentIds: [] };
 }
 
-export const EventStats: MessageFns<EventStats> = {
-  encode(message: EventStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.sofaScoreEventId !== 0) {
-      writer.uint32(8).int64(message.sofaScoreEventId);
+export const SyncResponse: MessageFns<SyncResponse> = {
+  encode(message: SyncResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.cursor !== "") {
+      writer.uint32(10).string(message.cursor);
     }
-    if (message.viewCount !== 0) {
-      writer.uint32(16).int64(message.viewCount);
+    if (message.hasMore !== false) {
+      writer.uint32(16).bool(message.hasMore);
+    }
+    for (const v of message.events) {
+      SofaScoreEvent.encode(v!, writer.uint32(26).fork()).join();
+    }
+    for (const v of message.teams) {
+      Team.encode(v!, writer.uint32(34).fork()).join();
     }
+    for (const v of message.tournaments) {
+      Tournament.encode(v!, writer.uint32(42).fork()).join();
+    }
+    writer.uint32(50).fork();
+    for (const v of message.deletedEventIds) {
+      writer.int64(v);
+    }
+    writer.join();
+    writer.uint32(58).fork();
+    for (const v of message.tournamentIds) {
+      writer.uint32(v);
+    }
+    writer.join();
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): EventStats {
+  decode(input: BinaryReader | Uint8Array, length?: number): SyncResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseEventStats();
+    const message = createBaseSyncResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.sofaScoreEventId = longToNumber(reader.int64());
+          message.cursor = reader.string();
           continue;
         }
         case 2: {
@@ -3150,9 +13651,69 @@ export const EventStats: MessageFns<EventStats> = {
             break;
           }
 
-          message.viewCount = longToNumber(reader.int64());
+          message.hasMore = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.events.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.teams.push(Team.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.tournaments.push(Tournament.decode(reader, reader.uint32()));
           continue;
         }
+        case 6: {
+          if (tag === 48) {
+            message.deletedEventIds.push(longToNumber(reader.int64()));
+
+            continue;
+          }
+
+          if (tag === 50) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.deletedEventIds.push(longToNumber(reader.int64()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 7: {
+          if (tag === 56) {
+            message.tournamentIds.push(reader.uint32());
+
+            continue;
+          }
+
+          if (tag === 58) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.tournamentIds.push(reader.uint32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3162,68 +13723,143 @@ export const EventStats: MessageFns<EventStats> = {
     return message;
   },
 
-  fromJSON(object: any): EventStats {
+  fromJSON(object: any): SyncResponse {
     return {
-      sofaScoreEventId: isSet(object.sofaScoreEventId)
-        ? globalThis.Number(object.sofaScoreEventId)
-        : isSet(object.sofa_score_event_id)
-        ? globalThis.Number(object.sofa_score_event_id)
-        : 0,
-      viewCount: isSet(object.viewCount)
-        ? globalThis.Number(object.viewCount)
-        : isSet(object.view_count)
-        ? globalThis.Number(object.view_count)
-        : 0,
+      cursor: isSet(object.cursor) ? globalThis.String(object.cursor) : "",
+      hasMore: isSet(object.hasMore)
+        ? globalThis.Boolean(object.hasMore)
+        : isSet(object.has_more)
+        ? globalThis.Boolean(object.has_more)
+        : false,
+      events: globalThis.Array.isArray(object?.events) ? object.events.map((e: any) => SofaScoreEvent.fromJSON(e)) : [],
+      teams: globalThis.Array.isArray(object?.teams) ? object.teams.map((e: any) => Team.fromJSON(e)) : [],
+      tournaments: globalThis.Array.isArray(object?.tournaments)
+        ? object.tournaments.map((e: any) => Tournament.fromJSON(e))
+        : [],
+      deletedEventIds: globalThis.Array.isArray(object?.deletedEventIds)
+        ? object.deletedEventIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.deleted_event_ids)
+        ? object.deleted_event_ids.map((e: any) => globalThis.Number(e))
+        : [],
+      tournamentIds: globalThis.Array.isArray(object?.tournamentIds)
+        ? object.tournamentIds.map((e: any) => globalThis.Number(e))
+        : globalThis.Array.isArray(object?.tournament_ids)
+        ? object.tournament_ids.map((e: any) => globalThis.Number(e))
+        : [],
     };
   },
 
-  toJSON(message: EventStats): unknown {
+  toJSON(message: SyncResponse): unknown {
     const obj: any = {};
-    if (message.sofaScoreEventId !== 0) {
-      obj.sofaScoreEventId = Math.round(message.sofaScoreEventId);
+    if (message.cursor !== "") {
+      obj.cursor = message.cursor;
     }
-    if (message.viewCount !== 0) {
-      obj.viewCount = Math.round(message.viewCount);
+    if (message.hasMore !== false) {
+      obj.hasMore = message.hasMore;
+    }
+    if (message.events?.length) {
+      obj.events = message.events.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.teams?.length) {
+      obj.teams = message.teams.map((e) => Team.toJSON(e));
+    }
+    if (message.tournaments?.length) {
+      obj.tournaments = message.tournaments.map((e) => Tournament.toJSON(e));
+    }
+    if (message.deletedEventIds?.length) {
+      obj.deletedEventIds = message.deletedEventIds.map((e) => Math.round(e));
+    }
+    if (message.tournamentIds?.length) {
+      obj.tournamentIds = message.tournamentIds.map((e) => Math.round(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<EventStats>, I>>(base?: I): EventStats {
-    return EventStats.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<SyncResponse>, I>>(base?: I): SyncResponse {
+    return SyncResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<EventStats>, I>>(object: I): EventStats {
-    const message = createBaseEventStats();
-    message.sofaScoreEventId = object.sofaScoreEventId ?? 0;
-    message.viewCount = object.viewCount ?? 0;
+  fromPartial<I extends Exact<DeepPartial<SyncResponse>, I>>(object: I): SyncResponse {
+    const message = createBaseSyncResponse();
+    message.cursor = object.cursor ?? "";
+    message.hasMore = object.hasMore ?? false;
+    message.events = object.events?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.teams = object.teams?.map((e) => Team.fromPartial(e)) || [];
+    message.tournaments = object.tournaments?.map((e) => Tournament.fromPartial(e)) || [];
+    message.deletedEventIds = object.deletedEventIds?.map((e) => e) || [];
+    message.tournamentIds = object.tournamentIds?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseTopEventsResponse(): TopEventsResponse {
-  return { stats: [] };
+function createBaseFeedSettingsRequest(): FeedSettingsRequest {
+  return { maxItems: 0, lookAheadHours: 0, recentHours: 0, ordering: "", sports: [] };
 }
 
-export const TopEventsResponse: MessageFns<TopEventsResponse> = {
-  encode(message: TopEventsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.stats) {
-      EventStats.encode(v!, writer.uint32(10).fork()).join();
+export const FeedSettingsRequest: MessageFns<FeedSettingsRequest> = {
+  encode(message: FeedSettingsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.maxItems !== 0) {
+      writer.uint32(8).int32(message.maxItems);
+    }
+    if (message.lookAheadHours !== 0) {
+      writer.uint32(16).int32(message.lookAheadHours);
+    }
+    if (message.recentHours !== 0) {
+      writer.uint32(24).int32(message.recentHours);
+    }
+    if (message.ordering !== "") {
+      writer.uint32(34).string(message.ordering);
+    }
+    for (const v of message.sports) {
+      writer.uint32(42).string(v!);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): TopEventsResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): FeedSettingsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTopEventsResponse();
+    const message = createBaseFeedSettingsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxItems = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.lookAheadHours = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.recentHours = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
             break;
           }
 
-          message.stats.push(EventStats.decode(reader, reader.uint32()));
+          message.ordering = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sports.push(reader.string());
           continue;
         }
       }
@@ -3235,104 +13871,120 @@ export const TopEventsResponse: MessageFns<TopEventsResponse> = {
     return message;
   },
 
-  fromJSON(object: any): TopEventsResponse {
+  fromJSON(object: any): FeedSettingsRequest {
     return {
-      stats: globalThis.Array.isArray(object?.stats) ? object.stats.map((e: any) => EventStats.fromJSON(e)) : [],
+      maxItems: isSet(object.maxItems)
+        ? globalThis.Number(object.maxItems)
+        : isSet(object.max_items)
+        ? globalThis.Number(object.max_items)
+        : 0,
+      lookAheadHours: isSet(object.lookAheadHours)
+        ? globalThis.Number(object.lookAheadHours)
+        : isSet(object.look_ahead_hours)
+        ? globalThis.Number(object.look_ahead_hours)
+        : 0,
+      recentHours: isSet(object.recentHours)
+        ? globalThis.Number(object.recentHours)
+        : isSet(object.recent_hours)
+        ? globalThis.Number(object.recent_hours)
+        : 0,
+      ordering: isSet(object.ordering) ? globalThis.String(object.ordering) : "",
+      sports: globalThis.Array.isArray(object?.sports) ? object.sports.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
-  toJSON(message: TopEventsResponse): unknown {
+  toJSON(message: FeedSettingsRequest): unknown {
     const obj: any = {};
-    if (message.stats?.length) {
-      obj.stats = message.stats.map((e) => EventStats.toJSON(e));
+    if (message.maxItems !== 0) {
+      obj.maxItems = Math.round(message.maxItems);
+    }
+    if (message.lookAheadHours !== 0) {
+      obj.lookAheadHours = Math.round(message.lookAheadHours);
+    }
+    if (message.recentHours !== 0) {
+      obj.recentHours = Math.round(message.recentHours);
+    }
+    if (message.ordering !== "") {
+      obj.ordering = message.ordering;
+    }
+    if (message.sports?.length) {
+      obj.sports = message.sports;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TopEventsResponse>, I>>(base?: I): TopEventsResponse {
-    return TopEventsResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FeedSettingsRequest>, I>>(base?: I): FeedSettingsRequest {
+    return FeedSettingsRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<TopEventsResponse>, I>>(object: I): TopEventsResponse {
-    const message = createBaseTopEventsResponse();
-    message.stats = object.stats?.map((e) => EventStats.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<FeedSettingsRequest>, I>>(object: I): FeedSettingsRequest {
+    const message = createBaseFeedSettingsRequest();
+    message.maxItems = object.maxItems ?? 0;
+    message.lookAheadHours = object.lookAheadHours ?? 0;
+    message.recentHours = object.recentHours ?? 0;
+    message.ordering = object.ordering ?? "";
+    message.sports = object.sports?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseApkInfo(): ApkInfo {
+function createBaseFeedSettings(): FeedSettings {
   return {
     id: 0,
-    version: "",
-    fileName: "",
-    fileSize: 0,
-    description: "",
-    packageName: "",
-    versionCode: 0,
-    minSdkVersion: 0,
-    targetSdkVersion: 0,
-    downloadToken: "",
-    downloadUrl: "",
     createdAt: "",
-    isActive: false,
-    downloads: 0,
-    panelUrl: "",
+    updatedAt: "",
+    deviceId: 0,
+    packageName: "",
+    maxItems: 0,
+    lookAheadHours: 0,
+    recentHours: 0,
+    ordering: "",
+    sports: [],
+    device: undefined,
   };
 }
 
-export const ApkInfo: MessageFns<ApkInfo> = {
-  encode(message: ApkInfo, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const FeedSettings: MessageFns<FeedSettings> = {
+  encode(message: FeedSettings, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.id !== 0) {
       writer.uint32(8).uint32(message.id);
     }
-    if (message.version !== "") {
-      writer.uint32(18).string(message.version);
-    }
-    if (message.fileName !== "") {
-      writer.uint32(26).string(message.fileName);
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
     }
-    if (message.fileSize !== 0) {
-      writer.uint32(32).int64(message.fileSize);
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
     }
-    if (message.description !== "") {
-      writer.uint32(42).string(message.description);
+    if (message.deviceId !== 0) {
+      writer.uint32(32).uint32(message.deviceId);
     }
     if (message.packageName !== "") {
-      writer.uint32(50).string(message.packageName);
-    }
-    if (message.versionCode !== 0) {
-      writer.uint32(56).int32(message.versionCode);
+      writer.uint32(42).string(message.packageName);
     }
-    if (message.minSdkVersion !== 0) {
-      writer.uint32(64).int32(message.minSdkVersion);
-    }
-    if (message.targetSdkVersion !== 0) {
-      writer.uint32(72).int32(message.targetSdkVersion);
-    }
-    if (message.downloadToken !== "") {
-      writer.uint32(82).string(message.downloadToken);
+    if (message.maxItems !== 0) {
+      writer.uint32(48).int32(message.maxItems);
     }
-    if (message.downloadUrl !== "") {
-      writer.uint32(90).string(message.downloadUrl);
+    if (message.lookAheadHours !== 0) {
+      writer.uint32(56).int32(message.lookAheadHours);
     }
-    if (message.createdAt !== "") {
-      writer.uint32(98).string(message.createdAt);
+    if (message.recentHours !== 0) {
+      writer.uint32(64).int32(message.recentHours);
     }
-    if (message.isActive !== false) {
-      writer.uint32(104).bool(message.isActive);
+    if (message.ordering !== "") {
+      writer.uint32(74).string(message.ordering);
     }
-    if (message.downloads !== 0) {
-      writer.uint32(112).int32(message.downloads);
+    for (const v of message.sports) {
+      writer.uint32(82).string(v!);
     }
-    if (message.panelUrl !== "") {
-      writer.uint32(122).string(message.panelUrl);
+    if (message.device !== undefined) {
+      Device.encode(message.device, writer.uint32(90).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ApkInfo {
+  decode(input: BinaryReader | Uint8Array, length?: number): FeedSettings {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseApkInfo();
+    const message = createBaseFeedSettings();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3349,7 +14001,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.version = reader.string();
+          message.createdAt = reader.string();
           continue;
         }
         case 3: {
@@ -3357,7 +14009,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.fileName = reader.string();
+          message.updatedAt = reader.string();
           continue;
         }
         case 4: {
@@ -3365,7 +14017,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.fileSize = longToNumber(reader.int64());
+          message.deviceId = reader.uint32();
           continue;
         }
         case 5: {
@@ -3373,15 +14025,15 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.description = reader.string();
+          message.packageName = reader.string();
           continue;
         }
         case 6: {
-          if (tag !== 50) {
+          if (tag !== 48) {
             break;
           }
 
-          message.packageName = reader.string();
+          message.maxItems = reader.int32();
           continue;
         }
         case 7: {
@@ -3389,7 +14041,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.versionCode = reader.int32();
+          message.lookAheadHours = reader.int32();
           continue;
         }
         case 8: {
@@ -3397,15 +14049,15 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.minSdkVersion = reader.int32();
+          message.recentHours = reader.int32();
           continue;
         }
         case 9: {
-          if (tag !== 72) {
+          if (tag !== 74) {
             break;
           }
 
-          message.targetSdkVersion = reader.int32();
+          message.ordering = reader.string();
           continue;
         }
         case 10: {
@@ -3413,7 +14065,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.downloadToken = reader.string();
+          message.sports.push(reader.string());
           continue;
         }
         case 11: {
@@ -3421,39 +14073,7 @@ export const ApkInfo: MessageFns<ApkInfo> = {
             break;
           }
 
-          message.downloadUrl = reader.string();
-          continue;
-        }
-        case 12: {
-          if (tag !== 98) {
-            break;
-          }
-
-          message.createdAt = reader.string();
-          continue;
-        }
-        case 13: {
-          if (tag !== 104) {
-            break;
-          }
-
-          message.isActive = reader.bool();
-          continue;
-        }
-        case 14: {
-          if (tag !== 112) {
-            break;
-          }
-
-          message.downloads = reader.int32();
-          continue;
-        }
-        case 15: {
-          if (tag !== 122) {
-            break;
-          }
-
-          message.panelUrl = reader.string();
+          message.device = Device.decode(reader, reader.uint32());
           continue;
         }
       }
@@ -3465,160 +14085,126 @@ export const ApkInfo: MessageFns<ApkInfo> = {
     return message;
   },
 
-  fromJSON(object: any): ApkInfo {
+  fromJSON(object: any): FeedSettings {
     return {
       id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      fileName: isSet(object.fileName)
-        ? globalThis.String(object.fileName)
-        : isSet(object.file_name)
-        ? globalThis.String(object.file_name)
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
         : "",
-      fileSize: isSet(object.fileSize)
-        ? globalThis.Number(object.fileSize)
-        : isSet(object.file_size)
-        ? globalThis.Number(object.file_size)
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "",
+      deviceId: isSet(object.deviceId)
+        ? globalThis.Number(object.deviceId)
+        : isSet(object.device_id)
+        ? globalThis.Number(object.device_id)
         : 0,
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
       packageName: isSet(object.packageName)
         ? globalThis.String(object.packageName)
         : isSet(object.package_name)
         ? globalThis.String(object.package_name)
         : "",
-      versionCode: isSet(object.versionCode)
-        ? globalThis.Number(object.versionCode)
-        : isSet(object.version_code)
-        ? globalThis.Number(object.version_code)
+      maxItems: isSet(object.maxItems)
+        ? globalThis.Number(object.maxItems)
+        : isSet(object.max_items)
+        ? globalThis.Number(object.max_items)
         : 0,
-      minSdkVersion: isSet(object.minSdkVersion)
-        ? globalThis.Number(object.minSdkVersion)
-        : isSet(object.min_sdk_version)
-        ? globalThis.Number(object.min_sdk_version)
+      lookAheadHours: isSet(object.lookAheadHours)
+        ? globalThis.Number(object.lookAheadHours)
+        : isSet(object.look_ahead_hours)
+        ? globalThis.Number(object.look_ahead_hours)
         : 0,
-      targetSdkVersion: isSet(object.targetSdkVersion)
-        ? globalThis.Number(object.targetSdkVersion)
-        : isSet(object.target_sdk_version)
-        ? globalThis.Number(object.target_sdk_version)
+      recentHours: isSet(object.recentHours)
+        ? globalThis.Number(object.recentHours)
+        : isSet(object.recent_hours)
+        ? globalThis.Number(object.recent_hours)
         : 0,
-      downloadToken: isSet(object.downloadToken)
-        ? globalThis.String(object.downloadToken)
-        : isSet(object.download_token)
-        ? globalThis.String(object.download_token)
-        : "",
-      downloadUrl: isSet(object.downloadUrl)
-        ? globalThis.String(object.downloadUrl)
-        : isSet(object.download_url)
-        ? globalThis.String(object.download_url)
-        : "",
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
-        : "",
-      isActive: isSet(object.isActive)
-        ? globalThis.Boolean(object.isActive)
-        : isSet(object.is_active)
-        ? globalThis.Boolean(object.is_active)
-        : false,
-      downloads: isSet(object.downloads) ? globalThis.Number(object.downloads) : 0,
-      panelUrl: isSet(object.panelUrl)
-        ? globalThis.String(object.panelUrl)
-        : isSet(object.panel_url)
-        ? globalThis.String(object.panel_url)
-        : "",
+      ordering: isSet(object.ordering) ? globalThis.String(object.ordering) : "",
+      sports: globalThis.Array.isArray(object?.sports) ? object.sports.map((e: any) => globalThis.String(e)) : [],
+      device: isSet(object.device) ? Device.fromJSON(object.device) : undefined,
     };
   },
 
-  toJSON(message: ApkInfo): unknown {
+  toJSON(message: FeedSettings): unknown {
     const obj: any = {};
     if (message.id !== 0) {
       obj.id = Math.round(message.id);
     }
-    if (message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.fileName !== "") {
-      obj.fileName = message.fileName;
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
     }
-    if (message.fileSize !== 0) {
-      obj.fileSize = Math.round(message.fileSize);
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
     }
-    if (message.description !== "") {
-      obj.description = message.description;
+    if (message.deviceId !== 0) {
+      obj.deviceId = Math.round(message.deviceId);
     }
     if (message.packageName !== "") {
       obj.packageName = message.packageName;
     }
-    if (message.versionCode !== 0) {
-      obj.versionCode = Math.round(message.versionCode);
-    }
-    if (message.minSdkVersion !== 0) {
-      obj.minSdkVersion = Math.round(message.minSdkVersion);
-    }
-    if (message.targetSdkVersion !== 0) {
-      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
-    }
-    if (message.downloadToken !== "") {
-      obj.downloadToken = message.downloadToken;
-    }
-    if (message.downloadUrl !== "") {
-      obj.downloadUrl = message.downloadUrl;
+    if (message.maxItems !== 0) {
+      obj.maxItems = Math.round(message.maxItems);
     }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
+    if (message.lookAheadHours !== 0) {
+      obj.lookAheadHours = Math.round(message.lookAheadHours);
     }
-    if (message.isActive !== false) {
-      obj.isActive = message.isActive;
+    if (message.recentHours !== 0) {
+      obj.recentHours = Math.round(message.recentHours);
     }
-    if (message.downloads !== 0) {
-      obj.downloads = Math.round(message.downloads);
+    if (message.ordering !== "") {
+      obj.ordering = message.ordering;
     }
-    if (message.panelUrl !== "") {
-      obj.panelUrl = message.panelUrl;
+    if (message.sports?.length) {
+      obj.sports = message.sports;
     }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<ApkInfo>, I>>(base?: I): ApkInfo {
-    return ApkInfo.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<ApkInfo>, I>>(object: I): ApkInfo {
-    const message = createBaseApkInfo();
-    message.id = object.id ?? 0;
-    message.version = object.version ?? "";
-    message.fileName = object.fileName ?? "";
-    message.fileSize = object.fileSize ?? 0;
-    message.description = object.description ?? "";
-    message.packageName = object.packageName ?? "";
-    message.versionCode = object.versionCode ?? 0;
-    message.minSdkVersion = object.minSdkVersion ?? 0;
-    message.targetSdkVersion = object.targetSdkVersion ?? 0;
-    message.downloadToken = object.downloadToken ?? "";
-    message.downloadUrl = object.downloadUrl ?? "";
+    if (message.device !== undefined) {
+      obj.device = Device.toJSON(message.device);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FeedSettings>, I>>(base?: I): FeedSettings {
+    return FeedSettings.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FeedSettings>, I>>(object: I): FeedSettings {
+    const message = createBaseFeedSettings();
+    message.id = object.id ?? 0;
     message.createdAt = object.createdAt ?? "";
-    message.isActive = object.isActive ?? false;
-    message.downloads = object.downloads ?? 0;
-    message.panelUrl = object.panelUrl ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.deviceId = object.deviceId ?? 0;
+    message.packageName = object.packageName ?? "";
+    message.maxItems = object.maxItems ?? 0;
+    message.lookAheadHours = object.lookAheadHours ?? 0;
+    message.recentHours = object.recentHours ?? 0;
+    message.ordering = object.ordering ?? "";
+    message.sports = object.sports?.map((e) => e) || [];
+    message.device = (object.device !== undefined && object.device !== null)
+      ? Device.fromPartial(object.device)
+      : undefined;
     return message;
   },
 };
 
-function createBaseApkList(): ApkList {
-  return { versions: [] };
+function createBaseFeedSettingsList(): FeedSettingsList {
+  return { settings: [] };
 }
 
-export const ApkList: MessageFns<ApkList> = {
-  encode(message: ApkList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.versions) {
-      ApkInfo.encode(v!, writer.uint32(10).fork()).join();
+export const FeedSettingsList: MessageFns<FeedSettingsList> = {
+  encode(message: FeedSettingsList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.settings) {
+      FeedSettings.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ApkList {
+  decode(input: BinaryReader | Uint8Array, length?: number): FeedSettingsList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseApkList();
+    const message = createBaseFeedSettingsList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3627,7 +14213,7 @@ export const ApkList: MessageFns<ApkList> = {
             break;
           }
 
-          message.versions.push(ApkInfo.decode(reader, reader.uint32()));
+          message.settings.push(FeedSettings.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -3639,109 +14225,123 @@ export const ApkList: MessageFns<ApkList> = {
     return message;
   },
 
-  fromJSON(object: any): ApkList {
+  fromJSON(object: any): FeedSettingsList {
     return {
-      versions: globalThis.Array.isArray(object?.versions) ? object.versions.map((e: any) => ApkInfo.fromJSON(e)) : [],
+      settings: globalThis.Array.isArray(object?.settings)
+        ? object.settings.map((e: any) => FeedSettings.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ApkList): unknown {
+  toJSON(message: FeedSettingsList): unknown {
     const obj: any = {};
-    if (message.versions?.length) {
-      obj.versions = message.versions.map((e) => ApkInfo.toJSON(e));
+    if (message.settings?.length) {
+      obj.settings = message.settings.map((e) => FeedSettings.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ApkList>, I>>(base?: I): ApkList {
-    return ApkList.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FeedSettingsList>, I>>(base?: I): FeedSettingsList {
+    return FeedSettingsList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ApkList>, I>>(object: I): ApkList {
-    const message = createBaseApkList();
-    message.versions = object.versions?.map((e) => ApkInfo.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<FeedSettingsList>, I>>(object: I): FeedSettingsList {
+    const message = createBaseFeedSettingsList();
+    message.settings = object.settings?.map((e) => FeedSettings.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseApkUploadResponse(): ApkUploadResponse {
+function createBaseEventDetail(): EventDetail {
   return {
-    id: 0,
-    version: "",
-    fileName: "",
-    fileSize: 0,
-    description: "",
-    packageName: "",
-    versionCode: 0,
-    minSdkVersion: 0,
-    targetSdkVersion: 0,
-    downloadToken: "",
-    downloadUrl: "",
-    createdAt: "",
+    event: undefined,
+    round: 0,
+    roundName: "",
+    season: "",
+    venue: "",
+    venueCity: "",
+    referee: "",
+    homeLastEvents: [],
+    homeForm: "",
+    awayLastEvents: [],
+    awayForm: "",
+    headToHead: [],
+    homeWins: 0,
+    awayWins: 0,
+    draws: 0,
   };
 }
 
-export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
-  encode(message: ApkUploadResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
+export const EventDetail: MessageFns<EventDetail> = {
+  encode(message: EventDetail, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.event !== undefined) {
+      SofaScoreEvent.encode(message.event, writer.uint32(10).fork()).join();
     }
-    if (message.version !== "") {
-      writer.uint32(18).string(message.version);
+    if (message.round !== 0) {
+      writer.uint32(16).int32(message.round);
     }
-    if (message.fileName !== "") {
-      writer.uint32(26).string(message.fileName);
+    if (message.roundName !== "") {
+      writer.uint32(26).string(message.roundName);
     }
-    if (message.fileSize !== 0) {
-      writer.uint32(32).int64(message.fileSize);
+    if (message.season !== "") {
+      writer.uint32(34).string(message.season);
     }
-    if (message.description !== "") {
-      writer.uint32(42).string(message.description);
+    if (message.venue !== "") {
+      writer.uint32(42).string(message.venue);
     }
-    if (message.packageName !== "") {
-      writer.uint32(50).string(message.packageName);
+    if (message.venueCity !== "") {
+      writer.uint32(50).string(message.venueCity);
     }
-    if (message.versionCode !== 0) {
-      writer.uint32(56).int32(message.versionCode);
+    if (message.referee !== "") {
+      writer.uint32(58).string(message.referee);
     }
-    if (message.minSdkVersion !== 0) {
-      writer.uint32(64).int32(message.minSdkVersion);
+    for (const v of message.homeLastEvents) {
+      SofaScoreEvent.encode(v!, writer.uint32(66).fork()).join();
     }
-    if (message.targetSdkVersion !== 0) {
-      writer.uint32(72).int32(message.targetSdkVersion);
+    if (message.homeForm !== "") {
+      writer.uint32(74).string(message.homeForm);
     }
-    if (message.downloadToken !== "") {
-      writer.uint32(82).string(message.downloadToken);
+    for (const v of message.awayLastEvents) {
+      SofaScoreEvent.encode(v!, writer.uint32(82).fork()).join();
     }
-    if (message.downloadUrl !== "") {
-      writer.uint32(90).string(message.downloadUrl);
+    if (message.awayForm !== "") {
+      writer.uint32(90).string(message.awayForm);
     }
-    if (message.createdAt !== "") {
-      writer.uint32(98).string(message.createdAt);
+    for (const v of message.headToHead) {
+      SofaScoreEvent.encode(v!, writer.uint32(98).fork()).join();
+    }
+    if (message.homeWins !== 0) {
+      writer.uint32(104).int32(message.homeWins);
+    }
+    if (message.awayWins !== 0) {
+      writer.uint32(112).int32(message.awayWins);
+    }
+    if (message.draws !== 0) {
+      writer.uint32(120).int32(message.draws);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ApkUploadResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): EventDetail {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseApkUploadResponse();
+    const message = createBaseEventDetail();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.uint32();
+          message.event = SofaScoreEvent.decode(reader, reader.uint32());
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.version = reader.string();
+          message.round = reader.int32();
           continue;
         }
         case 3: {
@@ -3749,15 +14349,15 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.fileName = reader.string();
+          message.roundName = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.fileSize = longToNumber(reader.int64());
+          message.season = reader.string();
           continue;
         }
         case 5: {
@@ -3765,7 +14365,7 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.description = reader.string();
+          message.venue = reader.string();
           continue;
         }
         case 6: {
@@ -3773,31 +14373,31 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.packageName = reader.string();
+          message.venueCity = reader.string();
           continue;
         }
         case 7: {
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.versionCode = reader.int32();
+          message.referee = reader.string();
           continue;
         }
         case 8: {
-          if (tag !== 64) {
+          if (tag !== 66) {
             break;
           }
 
-          message.minSdkVersion = reader.int32();
+          message.homeLastEvents.push(SofaScoreEvent.decode(reader, reader.uint32()));
           continue;
         }
         case 9: {
-          if (tag !== 72) {
+          if (tag !== 74) {
             break;
           }
 
-          message.targetSdkVersion = reader.int32();
+          message.homeForm = reader.string();
           continue;
         }
         case 10: {
@@ -3805,7 +14405,7 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.downloadToken = reader.string();
+          message.awayLastEvents.push(SofaScoreEvent.decode(reader, reader.uint32()));
           continue;
         }
         case 11: {
@@ -3813,7 +14413,7 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.downloadUrl = reader.string();
+          message.awayForm = reader.string();
           continue;
         }
         case 12: {
@@ -3821,7 +14421,31 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
             break;
           }
 
-          message.createdAt = reader.string();
+          message.headToHead.push(SofaScoreEvent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.homeWins = reader.int32();
+          continue;
+        }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.awayWins = reader.int32();
+          continue;
+        }
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
+
+          message.draws = reader.int32();
           continue;
         }
       }
@@ -3833,171 +14457,291 @@ export const ApkUploadResponse: MessageFns<ApkUploadResponse> = {
     return message;
   },
 
-  fromJSON(object: any): ApkUploadResponse {
+  fromJSON(object: any): EventDetail {
     return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      fileName: isSet(object.fileName)
-        ? globalThis.String(object.fileName)
-        : isSet(object.file_name)
-        ? globalThis.String(object.file_name)
+      event: isSet(object.event) ? SofaScoreEvent.fromJSON(object.event) : undefined,
+      round: isSet(object.round) ? globalThis.Number(object.round) : 0,
+      roundName: isSet(object.roundName)
+        ? globalThis.String(object.roundName)
+        : isSet(object.round_name)
+        ? globalThis.String(object.round_name)
         : "",
-      fileSize: isSet(object.fileSize)
-        ? globalThis.Number(object.fileSize)
-        : isSet(object.file_size)
-        ? globalThis.Number(object.file_size)
-        : 0,
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-      packageName: isSet(object.packageName)
-        ? globalThis.String(object.packageName)
-        : isSet(object.package_name)
-        ? globalThis.String(object.package_name)
+      season: isSet(object.season) ? globalThis.String(object.season) : "",
+      venue: isSet(object.venue) ? globalThis.String(object.venue) : "",
+      venueCity: isSet(object.venueCity)
+        ? globalThis.String(object.venueCity)
+        : isSet(object.venue_city)
+        ? globalThis.String(object.venue_city)
         : "",
-      versionCode: isSet(object.versionCode)
-        ? globalThis.Number(object.versionCode)
-        : isSet(object.version_code)
-        ? globalThis.Number(object.version_code)
-        : 0,
-      minSdkVersion: isSet(object.minSdkVersion)
-        ? globalThis.Number(object.minSdkVersion)
-        : isSet(object.min_sdk_version)
-        ? globalThis.Number(object.min_sdk_version)
-        : 0,
-      targetSdkVersion: isSet(object.targetSdkVersion)
-        ? globalThis.Number(object.targetSdkVersion)
-        : isSet(object.target_sdk_version)
-        ? globalThis.Number(object.target_sdk_version)
-        : 0,
-      downloadToken: isSet(object.downloadToken)
-        ? globalThis.String(object.downloadToken)
-        : isSet(object.download_token)
-        ? globalThis.String(object.download_token)
+      referee: isSet(object.referee) ? globalThis.String(object.referee) : "",
+      homeLastEvents: globalThis.Array.isArray(object?.homeLastEvents)
+        ? object.homeLastEvents.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : globalThis.Array.isArray(object?.home_last_events)
+        ? object.home_last_events.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : [],
+      homeForm: isSet(object.homeForm)
+        ? globalThis.String(object.homeForm)
+        : isSet(object.home_form)
+        ? globalThis.String(object.home_form)
         : "",
-      downloadUrl: isSet(object.downloadUrl)
-        ? globalThis.String(object.downloadUrl)
-        : isSet(object.download_url)
-        ? globalThis.String(object.download_url)
+      awayLastEvents: globalThis.Array.isArray(object?.awayLastEvents)
+        ? object.awayLastEvents.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : globalThis.Array.isArray(object?.away_last_events)
+        ? object.away_last_events.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : [],
+      awayForm: isSet(object.awayForm)
+        ? globalThis.String(object.awayForm)
+        : isSet(object.away_form)
+        ? globalThis.String(object.away_form)
         : "",
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
+      headToHead: globalThis.Array.isArray(object?.headToHead)
+        ? object.headToHead.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : globalThis.Array.isArray(object?.head_to_head)
+        ? object.head_to_head.map((e: any) => SofaScoreEvent.fromJSON(e))
+        : [],
+      homeWins: isSet(object.homeWins)
+        ? globalThis.Number(object.homeWins)
+        : isSet(object.home_wins)
+        ? globalThis.Number(object.home_wins)
+        : 0,
+      awayWins: isSet(object.awayWins)
+        ? globalThis.Number(object.awayWins)
+        : isSet(object.away_wins)
+        ? globalThis.Number(object.away_wins)
+        : 0,
+      draws: isSet(object.draws) ? globalThis.Number(object.draws) : 0,
+    };
+  },
+
+  toJSON(message: EventDetail): unknown {
+    const obj: any = {};
+    if (message.event !== undefined) {
+      obj.event = SofaScoreEvent.toJSON(message.event);
+    }
+    if (message.round !== 0) {
+      obj.round = Math.round(message.round);
+    }
+    if (message.roundName !== "") {
+      obj.roundName = message.roundName;
+    }
+    if (message.season !== "") {
+      obj.season = message.season;
+    }
+    if (message.venue !== "") {
+      obj.venue = message.venue;
+    }
+    if (message.venueCity !== "") {
+      obj.venueCity = message.venueCity;
+    }
+    if (message.referee !== "") {
+      obj.referee = message.referee;
+    }
+    if (message.homeLastEvents?.length) {
+      obj.homeLastEvents = message.homeLastEvents.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.homeForm !== "") {
+      obj.homeForm = message.homeForm;
+    }
+    if (message.awayLastEvents?.length) {
+      obj.awayLastEvents = message.awayLastEvents.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.awayForm !== "") {
+      obj.awayForm = message.awayForm;
+    }
+    if (message.headToHead?.length) {
+      obj.headToHead = message.headToHead.map((e) => SofaScoreEvent.toJSON(e));
+    }
+    if (message.homeWins !== 0) {
+      obj.homeWins = Math.round(message.homeWins);
+    }
+    if (message.awayWins !== 0) {
+      obj.awayWins = Math.round(message.awayWins);
+    }
+    if (message.draws !== 0) {
+      obj.draws = Math.round(message.draws);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EventDetail>, I>>(base?: I): EventDetail {
+    return EventDetail.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EventDetail>, I>>(object: I): EventDetail {
+    const message = createBaseEventDetail();
+    message.event = (object.event !== undefined && object.event !== null)
+      ? SofaScoreEvent.fromPartial(object.event)
+      : undefined;
+    message.round = object.round ?? 0;
+    message.roundName = object.roundName ?? "";
+    message.season = object.season ?? "";
+    message.venue = object.venue ?? "";
+    message.venueCity = object.venueCity ?? "";
+    message.referee = object.referee ?? "";
+    message.homeLastEvents = object.homeLastEvents?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.homeForm = object.homeForm ?? "";
+    message.awayLastEvents = object.awayLastEvents?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.awayForm = object.awayForm ?? "";
+    message.headToHead = object.headToHead?.map((e) => SofaScoreEvent.fromPartial(e)) || [];
+    message.homeWins = object.homeWins ?? 0;
+    message.awayWins = object.awayWins ?? 0;
+    message.draws = object.draws ?? 0;
+    return message;
+  },
+};
+
+function createBaseTranslationRequest(): TranslationRequest {
+  return { entityType: "", entityKey: "", locale: "", name: "" };
+}
+
+export const TranslationRequest: MessageFns<TranslationRequest> = {
+  encode(message: TranslationRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.entityType !== "") {
+      writer.uint32(10).string(message.entityType);
+    }
+    if (message.entityKey !== "") {
+      writer.uint32(18).string(message.entityKey);
+    }
+    if (message.locale !== "") {
+      writer.uint32(26).string(message.locale);
+    }
+    if (message.name !== "") {
+      writer.uint32(34).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TranslationRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTranslationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.entityType = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.entityKey = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.locale = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TranslationRequest {
+    return {
+      entityType: isSet(object.entityType)
+        ? globalThis.String(object.entityType)
+        : isSet(object.entity_type)
+        ? globalThis.String(object.entity_type)
+        : "",
+      entityKey: isSet(object.entityKey)
+        ? globalThis.String(object.entityKey)
+        : isSet(object.entity_key)
+        ? globalThis.String(object.entity_key)
         : "",
+      locale: isSet(object.locale) ? globalThis.String(object.locale) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
     };
   },
 
-  toJSON(message: ApkUploadResponse): unknown {
+  toJSON(message: TranslationRequest): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
-    }
-    if (message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.fileName !== "") {
-      obj.fileName = message.fileName;
-    }
-    if (message.fileSize !== 0) {
-      obj.fileSize = Math.round(message.fileSize);
-    }
-    if (message.description !== "") {
-      obj.description = message.description;
-    }
-    if (message.packageName !== "") {
-      obj.packageName = message.packageName;
-    }
-    if (message.versionCode !== 0) {
-      obj.versionCode = Math.round(message.versionCode);
+    if (message.entityType !== "") {
+      obj.entityType = message.entityType;
     }
-    if (message.minSdkVersion !== 0) {
-      obj.minSdkVersion = Math.round(message.minSdkVersion);
-    }
-    if (message.targetSdkVersion !== 0) {
-      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
-    }
-    if (message.downloadToken !== "") {
-      obj.downloadToken = message.downloadToken;
+    if (message.entityKey !== "") {
+      obj.entityKey = message.entityKey;
     }
-    if (message.downloadUrl !== "") {
-      obj.downloadUrl = message.downloadUrl;
+    if (message.locale !== "") {
+      obj.locale = message.locale;
     }
-    if (message.createdAt !== "") {
-      obj.createdAt = message.createdAt;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ApkUploadResponse>, I>>(base?: I): ApkUploadResponse {
-    return ApkUploadResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<TranslationRequest>, I>>(base?: I): TranslationRequest {
+    return TranslationRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ApkUploadResponse>, I>>(object: I): ApkUploadResponse {
-    const message = createBaseApkUploadResponse();
-    message.id = object.id ?? 0;
-    message.version = object.version ?? "";
-    message.fileName = object.fileName ?? "";
-    message.fileSize = object.fileSize ?? 0;
-    message.description = object.description ?? "";
-    message.packageName = object.packageName ?? "";
-    message.versionCode = object.versionCode ?? 0;
-    message.minSdkVersion = object.minSdkVersion ?? 0;
-    message.targetSdkVersion = object.targetSdkVersion ?? 0;
-    message.downloadToken = object.downloadToken ?? "";
-    message.downloadUrl = object.downloadUrl ?? "";
-    message.createdAt = object.createdAt ?? "";
+  fromPartial<I extends Exact<DeepPartial<TranslationRequest>, I>>(object: I): TranslationRequest {
+    const message = createBaseTranslationRequest();
+    message.entityType = object.entityType ?? "";
+    message.entityKey = object.entityKey ?? "";
+    message.locale = object.locale ?? "";
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseApkUpdateCheckResponse(): ApkUpdateCheckResponse {
-  return {
-    updateAvailable: false,
-    latestVersion: "",
-    packageName: "",
-    versionCode: 0,
-    downloadUrl: "",
-    description: "",
-    fileSize: 0,
-    minSdkVersion: 0,
-    targetSdkVersion: 0,
-  };
+function createBaseTranslation(): Translation {
+  return { id: 0, createdAt: "", updatedAt: "", entityType: "", entityKey: "", locale: "", name: "", source: "" };
 }
 
-export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
-  encode(message: ApkUpdateCheckResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.updateAvailable !== false) {
-      writer.uint32(8).bool(message.updateAvailable);
-    }
-    if (message.latestVersion !== "") {
-      writer.uint32(18).string(message.latestVersion);
+export const Translation: MessageFns<Translation> = {
+  encode(message: Translation, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
     }
-    if (message.packageName !== "") {
-      writer.uint32(26).string(message.packageName);
+    if (message.createdAt !== "") {
+      writer.uint32(18).string(message.createdAt);
     }
-    if (message.versionCode !== 0) {
-      writer.uint32(32).int32(message.versionCode);
+    if (message.updatedAt !== "") {
+      writer.uint32(26).string(message.updatedAt);
     }
-    if (message.downloadUrl !== "") {
-      writer.uint32(42).string(message.downloadUrl);
+    if (message.entityType !== "") {
+      writer.uint32(34).string(message.entityType);
     }
-    if (message.description !== "") {
-      writer.uint32(50).string(message.description);
+    if (message.entityKey !== "") {
+      writer.uint32(42).string(message.entityKey);
     }
-    if (message.fileSize !== 0) {
-      writer.uint32(56).int64(message.fileSize);
+    if (message.locale !== "") {
+      writer.uint32(50).string(message.locale);
     }
-    if (message.minSdkVersion !== 0) {
-      writer.uint32(64).int32(message.minSdkVersion);
+    if (message.name !== "") {
+      writer.uint32(58).string(message.name);
     }
-    if (message.targetSdkVersion !== 0) {
-      writer.uint32(72).int32(message.targetSdkVersion);
+    if (message.source !== "") {
+      writer.uint32(66).string(message.source);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ApkUpdateCheckResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): Translation {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseApkUpdateCheckResponse();
+    const message = createBaseTranslation();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4006,7 +14750,7 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
             break;
           }
 
-          message.updateAvailable = reader.bool();
+          message.id = reader.uint32();
           continue;
         }
         case 2: {
@@ -4014,7 +14758,7 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
             break;
           }
 
-          message.latestVersion = reader.string();
+          message.createdAt = reader.string();
           continue;
         }
         case 3: {
@@ -4022,15 +14766,15 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
             break;
           }
 
-          message.packageName = reader.string();
+          message.updatedAt = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.versionCode = reader.int32();
+          message.entityType = reader.string();
           continue;
         }
         case 5: {
@@ -4038,7 +14782,7 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
             break;
           }
 
-          message.downloadUrl = reader.string();
+          message.entityKey = reader.string();
           continue;
         }
         case 6: {
@@ -4046,31 +14790,23 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
             break;
           }
 
-          message.description = reader.string();
+          message.locale = reader.string();
           continue;
         }
         case 7: {
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.fileSize = longToNumber(reader.int64());
+          message.name = reader.string();
           continue;
         }
         case 8: {
-          if (tag !== 64) {
-            break;
-          }
-
-          message.minSdkVersion = reader.int32();
-          continue;
-        }
-        case 9: {
-          if (tag !== 72) {
+          if (tag !== 66) {
             break;
           }
 
-          message.targetSdkVersion = reader.int32();
+          message.source = reader.string();
           continue;
         }
       }
@@ -4082,138 +14818,117 @@ export const ApkUpdateCheckResponse: MessageFns<ApkUpdateCheckResponse> = {
     return message;
   },
 
-  fromJSON(object: any): ApkUpdateCheckResponse {
+  fromJSON(object: any): Translation {
     return {
-      updateAvailable: isSet(object.updateAvailable)
-        ? globalThis.Boolean(object.updateAvailable)
-        : isSet(object.update_available)
-        ? globalThis.Boolean(object.update_available)
-        : false,
-      latestVersion: isSet(object.latestVersion)
-        ? globalThis.String(object.latestVersion)
-        : isSet(object.latest_version)
-        ? globalThis.String(object.latest_version)
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
         : "",
-      packageName: isSet(object.packageName)
-        ? globalThis.String(object.packageName)
-        : isSet(object.package_name)
-        ? globalThis.String(object.package_name)
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
         : "",
-      versionCode: isSet(object.versionCode)
-        ? globalThis.Number(object.versionCode)
-        : isSet(object.version_code)
-        ? globalThis.Number(object.version_code)
-        : 0,
-      downloadUrl: isSet(object.downloadUrl)
-        ? globalThis.String(object.downloadUrl)
-        : isSet(object.download_url)
-        ? globalThis.String(object.download_url)
+      entityType: isSet(object.entityType)
+        ? globalThis.String(object.entityType)
+        : isSet(object.entity_type)
+        ? globalThis.String(object.entity_type)
         : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-      fileSize: isSet(object.fileSize)
-        ? globalThis.Number(object.fileSize)
-        : isSet(object.file_size)
-        ? globalThis.Number(object.file_size)
-        : 0,
-      minSdkVersion: isSet(object.minSdkVersion)
-        ? globalThis.Number(object.minSdkVersion)
-        : isSet(object.min_sdk_version)
-        ? globalThis.Number(object.min_sdk_version)
-        : 0,
-      targetSdkVersion: isSet(object.targetSdkVersion)
-        ? globalThis.Number(object.targetSdkVersion)
-        : isSet(object.target_sdk_version)
-        ? globalThis.Number(object.target_sdk_version)
-        : 0,
+      entityKey: isSet(object.entityKey)
+        ? globalThis.String(object.entityKey)
+        : isSet(object.entity_key)
+        ? globalThis.String(object.entity_key)
+        : "",
+      locale: isSet(object.locale) ? globalThis.String(object.locale) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
     };
   },
 
-  toJSON(message: ApkUpdateCheckResponse): unknown {
+  toJSON(message: Translation): unknown {
     const obj: any = {};
-    if (message.updateAvailable !== false) {
-      obj.updateAvailable = message.updateAvailable;
-    }
-    if (message.latestVersion !== "") {
-      obj.latestVersion = message.latestVersion;
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
     }
-    if (message.packageName !== "") {
-      obj.packageName = message.packageName;
+    if (message.createdAt !== "") {
+      obj.createdAt = message.createdAt;
     }
-    if (message.versionCode !== 0) {
-      obj.versionCode = Math.round(message.versionCode);
+    if (message.updatedAt !== "") {
+      obj.updatedAt = message.updatedAt;
     }
-    if (message.downloadUrl !== "") {
-      obj.downloadUrl = message.downloadUrl;
+    if (message.entityType !== "") {
+      obj.entityType = message.entityType;
     }
-    if (message.description !== "") {
-      obj.description = message.description;
+    if (message.entityKey !== "") {
+      obj.entityKey = message.entityKey;
     }
-    if (message.fileSize !== 0) {
-      obj.fileSize = Math.round(message.fileSize);
+    if (message.locale !== "") {
+      obj.locale = message.locale;
     }
-    if (message.minSdkVersion !== 0) {
-      obj.minSdkVersion = Math.round(message.minSdkVersion);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.targetSdkVersion !== 0) {
-      obj.targetSdkVersion = Math.round(message.targetSdkVersion);
+    if (message.source !== "") {
+      obj.source = message.source;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ApkUpdateCheckResponse>, I>>(base?: I): ApkUpdateCheckResponse {
-    return ApkUpdateCheckResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Translation>, I>>(base?: I): Translation {
+    return Translation.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ApkUpdateCheckResponse>, I>>(object: I): ApkUpdateCheckResponse {
-    const message = createBaseApkUpdateCheckResponse();
-    message.updateAvailable = object.updateAvailable ?? false;
-    message.latestVersion = object.latestVersion ?? "";
-    message.packageName = object.packageName ?? "";
-    message.versionCode = object.versionCode ?? 0;
-    message.downloadUrl = object.downloadUrl ?? "";
-    message.description = object.description ?? "";
-    message.fileSize = object.fileSize ?? 0;
-    message.minSdkVersion = object.minSdkVersion ?? 0;
-    message.targetSdkVersion = object.targetSdkVersion ?? 0;
+  fromPartial<I extends Exact<DeepPartial<Translation>, I>>(object: I): Translation {
+    const message = createBaseTranslation();
+    message.id = object.id ?? 0;
+    message.createdAt = object.createdAt ?? "";
+    message.updatedAt = object.updatedAt ?? "";
+    message.entityType = object.entityType ?? "";
+    message.entityKey = object.entityKey ?? "";
+    message.locale = object.locale ?? "";
+    message.name = object.name ?? "";
+    message.source = object.source ?? "";
     return message;
   },
 };
 
-function createBaseApkVersion(): ApkVersion {
-  return { id: 0, url: "" };
+function createBaseTranslationList(): TranslationList {
+  return { translations: [], total: 0 };
 }
 
-export const ApkVersion: MessageFns<ApkVersion> = {
-  encode(message: ApkVersion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).uint32(message.id);
+export const TranslationList: MessageFns<TranslationList> = {
+  encode(message: TranslationList, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.translations) {
+      Translation.encode(v!, writer.uint32(10).fork()).join();
     }
-    if (message.url !== "") {
-      writer.uint32(18).string(message.url);
+    if (message.total !== 0) {
+      writer.uint32(16).int64(message.total);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ApkVersion {
+  decode(input: BinaryReader | Uint8Array, length?: number): TranslationList {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseApkVersion();
+    const message = createBaseTranslationList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.uint32();
+          message.translations.push(Translation.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.url = reader.string();
+          message.total = longToNumber(reader.int64());
           continue;
         }
       }
@@ -4225,31 +14940,33 @@ export const ApkVersion: MessageFns<ApkVersion> = {
     return message;
   },
 
-  fromJSON(object: any): ApkVersion {
+  fromJSON(object: any): TranslationList {
     return {
-      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
-      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      translations: globalThis.Array.isArray(object?.translations)
+        ? object.translations.map((e: any) => Translation.fromJSON(e))
+        : [],
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
     };
   },
 
-  toJSON(message: ApkVersion): unknown {
+  toJSON(message: TranslationList): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
+    if (message.translations?.length) {
+      obj.translations = message.translations.map((e) => Translation.toJSON(e));
     }
-    if (message.url !== "") {
-      obj.url = message.url;
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ApkVersion>, I>>(base?: I): ApkVersion {
-    return ApkVersion.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<TranslationList>, I>>(base?: I): TranslationList {
+    return TranslationList.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ApkVersion>, I>>(object: I): ApkVersion {
-    const message = createBaseApkVersion();
-    message.id = object.id ?? 0;
-    message.url = object.url ?? "";
+  fromPartial<I extends Exact<DeepPartial<TranslationList>, I>>(object: I): TranslationList {
+    const message = createBaseTranslationList();
+    message.translations = object.translations?.map((e) => Translation.fromPartial(e)) || [];
+    message.total = object.total ?? 0;
     return message;
   },
 };